@@ -0,0 +1,217 @@
+/*
+Command recording prints protocol recordings created with socket.WithRecorder.
+
+Entries are printed in the order they were recorded. Command responses are
+paired with their request, so they can be filtered by domain or method and
+show the time the command took.
+
+	recording [flags] [file ...]
+
+If no file is given the recording is read from STDIN.
+
+	-domain Page    only print messages of the Page domain
+	-method Page.navigate
+	                only print messages of the Page.navigate method
+	-id 12          only print the command with ID 12 and its response
+	-session ABC    only print messages of the flattened session ABC
+	-pairs          print one line per command with its request and response
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+timeFormat is the format of the printed entry times.
+*/
+const timeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+var (
+	domain  = flag.String("domain", "", "only print messages of this protocol domain")
+	method  = flag.String("method", "", "only print messages of this method")
+	id      = flag.Int("id", 0, "only print the command with this ID and its response")
+	session = flag.String("session", "", "only print messages of this flattened session")
+	pairs   = flag.Bool("pairs", false, "print one line per command with its request and response")
+)
+
+/*
+message holds the parts of a recorded message that are printed.
+*/
+type message struct {
+	Error  json.RawMessage `json:"error"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+}
+
+/*
+command is a command request paired with its response.
+*/
+type command struct {
+	request  *socket.RecordEntry
+	response *socket.RecordEntry
+}
+
+func main() {
+	flag.Parse()
+
+	var readers []io.Reader
+	for _, path := range flag.Args() {
+		file, err := os.Open(path)
+		if nil != err {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		readers = append(readers, file)
+	}
+	if 0 == len(readers) {
+		readers = append(readers, os.Stdin)
+	}
+
+	if err := printRecording(os.Stdout, socket.NewRecordReader(io.MultiReader(readers...))); nil != err {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+/*
+commandKey returns the key used to pair a command request and response.
+*/
+func commandKey(entry *socket.RecordEntry) string {
+	return fmt.Sprintf("%d/%s/%d", entry.Socket, entry.SessionID, entry.ID)
+}
+
+/*
+details returns the parameters of a request or event, or the result or error
+of a response.
+*/
+func details(entry *socket.RecordEntry) string {
+	msg := &message{}
+	json.Unmarshal(entry.Message, msg)
+	switch {
+	case len(msg.Error) > 0 && "null" != string(msg.Error):
+		return "error " + string(msg.Error)
+	case len(msg.Result) > 0:
+		return string(msg.Result)
+	}
+	return string(msg.Params)
+}
+
+/*
+format returns the printed line for an entry. The method of a response is
+taken from its request.
+*/
+func format(entry *socket.RecordEntry, cmd *command) string {
+	line := fmt.Sprintf("%s socket=%d", entry.Time.Format(timeFormat), entry.Socket)
+	if "" != entry.SessionID {
+		line += " session=" + entry.SessionID
+	}
+
+	arrow := "<-"
+	if socket.RecordSend == entry.Direction {
+		arrow = "->"
+	}
+	line += " " + arrow
+	if entry.ID > 0 {
+		line += fmt.Sprintf(" #%d", entry.ID)
+	}
+	if "" != entry.Method {
+		line += " " + entry.Method
+	} else if nil != cmd && nil != cmd.request {
+		line += fmt.Sprintf(" %s %s", cmd.request.Method, entry.Time.Sub(cmd.request.Time))
+	}
+	return line + " " + details(entry)
+}
+
+/*
+formatPair returns the printed line for a command and its response.
+*/
+func formatPair(cmd *command) string {
+	line := fmt.Sprintf("%s socket=%d", cmd.request.Time.Format(timeFormat), cmd.request.Socket)
+	if "" != cmd.request.SessionID {
+		line += " session=" + cmd.request.SessionID
+	}
+	line += fmt.Sprintf(" #%d %s %s", cmd.request.ID, cmd.request.Method, details(cmd.request))
+	if nil == cmd.response {
+		return line + " => no response"
+	}
+	return line + fmt.Sprintf(" => %s %s", cmd.response.Time.Sub(cmd.request.Time), details(cmd.response))
+}
+
+/*
+match returns whether an entry passes the filters. Responses are matched
+using the method of their request.
+*/
+func match(entry *socket.RecordEntry, cmd *command) bool {
+	if "" != *session && *session != entry.SessionID {
+		return false
+	}
+	if 0 != *id && *id != entry.ID {
+		return false
+	}
+	filtered := entry
+	if "" == entry.Method && nil != cmd && nil != cmd.request {
+		filtered = cmd.request
+	}
+	if "" != *domain && *domain != filtered.Domain() {
+		return false
+	}
+	if "" != *method && *method != filtered.Method {
+		return false
+	}
+	return true
+}
+
+/*
+printRecording reads the recording and prints the entries that pass the
+filters.
+*/
+func printRecording(writer io.Writer, reader *socket.RecordReader) error {
+	commands := make(map[string]*command)
+	var order []*command
+
+	for {
+		entry, err := reader.Next()
+		if io.EOF == err {
+			break
+		}
+		if nil != err {
+			return err
+		}
+
+		var cmd *command
+		if entry.ID > 0 {
+			key := commandKey(entry)
+			if socket.RecordSend == entry.Direction {
+				cmd = &command{request: entry}
+				commands[key] = cmd
+				order = append(order, cmd)
+			} else if cmd = commands[key]; nil != cmd {
+				cmd.response = entry
+			}
+		}
+
+		if !match(entry, cmd) {
+			continue
+		}
+		if !*pairs {
+			fmt.Fprintln(writer, format(entry, cmd))
+		}
+	}
+
+	if *pairs {
+		for _, cmd := range order {
+			if match(cmd.request, cmd) {
+				fmt.Fprintln(writer, formatPair(cmd))
+			}
+		}
+	}
+	return nil
+}
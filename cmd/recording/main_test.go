@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+var start = time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+
+/*
+entry returns a record entry recorded the specified number of milliseconds
+after start.
+*/
+func entry(ms int, direction socket.RecordDirection, sessionID string, message string) *socket.RecordEntry {
+	header := struct {
+		ID     int    `json:"id"`
+		Method string `json:"method"`
+	}{}
+	json.Unmarshal([]byte(message), &header)
+	return &socket.RecordEntry{
+		Direction: direction,
+		ID:        header.ID,
+		Message:   json.RawMessage(message),
+		Method:    header.Method,
+		SessionID: sessionID,
+		Socket:    1,
+		Time:      start.Add(time.Duration(ms) * time.Millisecond),
+	}
+}
+
+/*
+recording returns a recording of a Page.navigate command, a Page event, a
+DOM.getDocument command that failed and a Runtime.evaluate command in a
+session that has no response.
+*/
+func recording(t *testing.T) []byte {
+	buffer := &bytes.Buffer{}
+	for _, entry := range []*socket.RecordEntry{
+		entry(0, socket.RecordSend, "", `{"id":1,"method":"Page.navigate","params":{"url":"https://example.com"}}`),
+		entry(5, socket.RecordReceive, "", `{"method":"Page.frameNavigated","params":{"frame":{}}}`),
+		entry(12, socket.RecordReceive, "", `{"id":1,"result":{"frameId":"F1"}}`),
+		entry(20, socket.RecordSend, "", `{"id":2,"method":"DOM.getDocument","params":{}}`),
+		entry(21, socket.RecordReceive, "", `{"id":2,"error":{"code":-32000,"message":"failed"}}`),
+		entry(30, socket.RecordSend, "S1", `{"id":1,"method":"Runtime.evaluate","params":{"expression":"1"},"sessionId":"S1"}`),
+	} {
+		line, err := json.Marshal(entry)
+		if nil != err {
+			t.Fatalf("Expected nil, received error: %v", err)
+		}
+		buffer.Write(append(line, '\n'))
+	}
+	return buffer.Bytes()
+}
+
+/*
+run prints the recording with the provided filters and returns the printed
+lines.
+*/
+func run(t *testing.T, filterDomain, filterMethod string, filterID int, filterSession string, filterPairs bool) []string {
+	*domain, *method, *id, *session, *pairs = filterDomain, filterMethod, filterID, filterSession, filterPairs
+	defer func() {
+		*domain, *method, *id, *session, *pairs = "", "", 0, "", false
+	}()
+
+	output := &bytes.Buffer{}
+	if err := printRecording(output, socket.NewRecordReader(bytes.NewReader(recording(t)))); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 0 == output.Len() {
+		return nil
+	}
+	return strings.Split(strings.TrimRight(output.String(), "\n"), "\n")
+}
+
+func TestFormat(t *testing.T) {
+	request := entry(0, socket.RecordSend, "", `{"id":1,"method":"Page.navigate","params":{"url":"https://example.com"}}`)
+	response := entry(12, socket.RecordReceive, "", `{"id":1,"result":{"frameId":"F1"}}`)
+	cmd := &command{request: request, response: response}
+
+	expected := `2018-01-02T03:04:05.000Z socket=1 -> #1 Page.navigate {"url":"https://example.com"}`
+	if line := format(request, cmd); expected != line {
+		t.Errorf("Expected '%s', received '%s'", expected, line)
+	}
+	expected = `2018-01-02T03:04:05.012Z socket=1 <- #1 Page.navigate 12ms {"frameId":"F1"}`
+	if line := format(response, cmd); expected != line {
+		t.Errorf("Expected '%s', received '%s'", expected, line)
+	}
+
+	event := entry(5, socket.RecordReceive, "S1", `{"method":"Page.frameNavigated","params":{"frame":{}}}`)
+	expected = `2018-01-02T03:04:05.005Z socket=1 session=S1 <- Page.frameNavigated {"frame":{}}`
+	if line := format(event, nil); expected != line {
+		t.Errorf("Expected '%s', received '%s'", expected, line)
+	}
+
+	failed := entry(21, socket.RecordReceive, "", `{"id":2,"error":{"code":-32000,"message":"failed"}}`)
+	expected = `2018-01-02T03:04:05.021Z socket=1 <- #2 error {"code":-32000,"message":"failed"}`
+	if line := format(failed, nil); expected != line {
+		t.Errorf("Expected '%s', received '%s'", expected, line)
+	}
+}
+
+func TestFormatPair(t *testing.T) {
+	cmd := &command{
+		request:  entry(20, socket.RecordSend, "", `{"id":2,"method":"DOM.getDocument","params":{}}`),
+		response: entry(21, socket.RecordReceive, "", `{"id":2,"error":{"code":-32000,"message":"failed"}}`),
+	}
+	expected := `2018-01-02T03:04:05.020Z socket=1 #2 DOM.getDocument {} => 1ms error {"code":-32000,"message":"failed"}`
+	if line := formatPair(cmd); expected != line {
+		t.Errorf("Expected '%s', received '%s'", expected, line)
+	}
+
+	cmd = &command{
+		request: entry(30, socket.RecordSend, "S1", `{"id":1,"method":"Runtime.evaluate","params":{"expression":"1"}}`),
+	}
+	expected = `2018-01-02T03:04:05.030Z socket=1 session=S1 #1 Runtime.evaluate {"expression":"1"} => no response`
+	if line := formatPair(cmd); expected != line {
+		t.Errorf("Expected '%s', received '%s'", expected, line)
+	}
+}
+
+func TestPrintRecording(t *testing.T) {
+	if lines := run(t, "", "", 0, "", false); 6 != len(lines) {
+		t.Errorf("Expected 6 lines, received %d: %v", len(lines), lines)
+	}
+
+	// Responses are filtered with the method of their request.
+	lines := run(t, "Page", "", 0, "", false)
+	if 3 != len(lines) || !strings.Contains(lines[2], "<- #1 Page.navigate 12ms") {
+		t.Errorf("Expected the Page command, response and event, received %v", lines)
+	}
+	lines = run(t, "", "DOM.getDocument", 0, "", false)
+	if 2 != len(lines) || !strings.Contains(lines[0], "-> #2 DOM.getDocument") || !strings.Contains(lines[1], "<- #2 DOM.getDocument 1ms error") {
+		t.Errorf("Expected the DOM.getDocument command and response, received %v", lines)
+	}
+	if lines = run(t, "Network", "", 0, "", false); 0 != len(lines) {
+		t.Errorf("Expected no lines, received %v", lines)
+	}
+
+	// The ID of the command in the session is the same as the ID of the
+	// Page.navigate command, the session filter tells them apart.
+	if lines = run(t, "", "", 1, "", false); 3 != len(lines) {
+		t.Errorf("Expected 3 lines, received %v", lines)
+	}
+	lines = run(t, "", "", 1, "S1", false)
+	if 1 != len(lines) || !strings.Contains(lines[0], "session=S1 -> #1 Runtime.evaluate") {
+		t.Errorf("Expected the session command, received %v", lines)
+	}
+}
+
+func TestPrintRecordingPairs(t *testing.T) {
+	lines := run(t, "", "", 0, "", true)
+	if 3 != len(lines) {
+		t.Fatalf("Expected 3 lines, received %d: %v", len(lines), lines)
+	}
+	if !strings.Contains(lines[0], `#1 Page.navigate {"url":"https://example.com"} => 12ms {"frameId":"F1"}`) {
+		t.Errorf("Expected the Page.navigate pair, received '%s'", lines[0])
+	}
+	if !strings.Contains(lines[2], "#1 Runtime.evaluate") || !strings.HasSuffix(lines[2], "=> no response") {
+		t.Errorf("Expected the Runtime.evaluate pair without response, received '%s'", lines[2])
+	}
+
+	lines = run(t, "DOM", "", 0, "", true)
+	if 1 != len(lines) || !strings.Contains(lines[0], "#2 DOM.getDocument") {
+		t.Errorf("Expected the DOM.getDocument pair, received %v", lines)
+	}
+}
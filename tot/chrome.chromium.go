@@ -8,9 +8,11 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/tot/socket"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -40,20 +42,53 @@ type Chrome struct {
 	// flags stores CLI arguments for the Chromium binary.
 	flags ChromiumFlags
 
-	// Optional. binary is the path to the Chromium binary. Defaults to
-	// '/usr/bin/google-chrome'.
+	// Optional. binary is the path to the Chromium binary. Discovered with
+	// FindBinary by default.
 	binary string
 
+	// browser is the socket connected to the browser target, see Browser.
+	browser *socket.Socket
+
+	// browserURL is the browser websocket URL passed to Connect, if any.
+	browserURL *url.URL
+
+	// connected is true if the instance was created with Connect and doesn't
+	// own the browser process.
+	connected bool
+
+	// dataDir is the user data directory created by Launch, removed by
+	// Close.
+	dataDir string
+
+	// Optional. logger is the structured logger for the Chromium instance
+	// and its tabs. Defaults to the logrus standard logger.
+	logger socket.Logger
+
 	// Optional. port is the port number the developer tools endpoints will
 	// listen on. Defaults to 9222.
 	//port int
 
+	// Optional. minVersion is the oldest Chromium major version Launch
+	// accepts. Defaults to 73, a negative value disables the version check.
+	minVersion int
+
+	// Optional. socketOptions are used to configure the socket connection of
+	// each new tab.
+	socketOptions []socket.Option
+
 	// tabs is a list of the currently open tabs.
 	tabs []*Tab
 
+	// tabsMux protects tabs and watching.
+	tabsMux sync.Mutex
+
 	// version contains Chromium version information.
 	version *Version
 
+	// watching is true while the tabs are kept in sync with the browser
+	// targets, see WatchTabs.
+	watching bool
+
 	// Optional. workdir is the path to the Chromium working directory. Defaults
 	// to '/tmp/headless-chrome'.
 	workdir string
@@ -76,6 +111,19 @@ type Chrome struct {
 
 	// process is a pointer to the os.Process struct containing the process PID.
 	process *os.Process
+
+	// exited is closed once the Chromium process has exited.
+	exited chan struct{}
+
+	// processErr is the error returned waiting for the Chromium process.
+	processErr error
+
+	// processState is the state of the Chromium process once it has exited.
+	processState *os.ProcessState
+
+	// Optional. startupTimeout is how long Launch waits for the remote
+	// debugging endpoint. Defaults to 10 seconds.
+	startupTimeout time.Duration
 }
 
 /*
@@ -101,36 +149,95 @@ func (chrome *Chrome) Flags() ChromiumFlags {
 /*
 Binary implements Chromium.
 
-Default value is '/usr/bin/google-chrome' for use with the mkenney/chromium-headless
-Docker image.
+If no binary was specified it is discovered with FindBinary. Default value is
+'/usr/bin/google-chrome' for use with the mkenney/chromium-headless Docker
+image if no binary is found.
 */
 func (chrome *Chrome) Binary() string {
 	if "" == chrome.binary {
-		chrome.binary = "/usr/bin/google-chrome"
+		binary, err := FindBinary()
+		if nil != err {
+			chrome.Logger().Warnf("%v", err)
+			binary = "/usr/bin/google-chrome"
+		}
+		chrome.binary = binary
 	}
 	return chrome.binary
 }
 
+/*
+Browser implements Chromium.
+*/
+func (chrome *Chrome) Browser() (*socket.Socket, error) {
+	if nil != chrome.browser {
+		return chrome.browser, nil
+	}
+	if chrome.Flags().Has("remote-debugging-pipe") {
+		return nil, errs.New(0, "chromium has not been launched")
+	}
+
+	if nil != chrome.browserURL {
+		chrome.browser = socket.New(chrome.browserURL, chrome.browserOptions()...)
+		return chrome.browser, nil
+	}
+
+	version, err := chrome.Version()
+	if nil != err {
+		return nil, errs.Wrap(err, 0, "could not find the browser websocket URL")
+	}
+	websocketURL, err := url.Parse(version.WebSocketDebuggerURL)
+	if nil != err {
+		return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid websocket URL '%s'", version.WebSocketDebuggerURL))
+	}
+	chrome.browser = socket.New(websocketURL, chrome.browserOptions()...)
+	return chrome.browser, nil
+}
+
+/*
+browserOptions returns the options used to configure the browser socket.
+*/
+func (chrome *Chrome) browserOptions(options ...socket.Option) []socket.Option {
+	options = append(
+		[]socket.Option{socket.WithLogger(chrome.Logger().WithFields(socket.Fields{"target": "browser"}))},
+		options...,
+	)
+	return append(options, chrome.socketOptions...)
+}
+
 /*
 Close implements Chromium.
+
+If the instance was created with Connect the browser and tab sockets are
+disconnected, the tabs are left open and the browser keeps running.
 */
 func (chrome *Chrome) Close() error {
+	if chrome.connected {
+		chrome.detach()
+		return nil
+	}
 	if chrome.process != nil {
 		for _, tab := range chrome.Tabs() {
 			tab.Close()
 		}
-		if err := chrome.process.Signal(os.Interrupt); err != nil {
-			return errs.Wrap(err, 0, "chrome process interrupt failed")
+		if nil != chrome.browser {
+			chrome.browser.Disconnect()
+			chrome.browser = nil
 		}
-		ps, err := chrome.process.Wait()
-		if err != nil {
-			return errs.Wrap(err, 0, "error waiting for process exit, result unknown")
+		select {
+		case <-chrome.exited:
+		default:
+			if err := chrome.process.Signal(os.Interrupt); err != nil {
+				return errs.Wrap(err, 0, "chrome process interrupt failed")
+			}
 		}
-		log.Infof("Chromium exited: %s", ps.String())
-	}
-	if chrome.stdOUTFile != nil {
-		chrome.stdOUTFile.Close()
+		<-chrome.exited
+		chrome.removeDataDir()
+		if chrome.processErr != nil {
+			return errs.Wrap(chrome.processErr, 0, "error waiting for process exit, result unknown")
+		}
+		chrome.Logger().Infof("Chromium exited: %s", chrome.processState.String())
 	}
+	chrome.closeSTDOUT()
 	return nil
 }
 
@@ -173,6 +280,17 @@ func (chrome *Chrome) GetTab(tabID string) (Tabber, error) {
 	return tab, err
 }
 
+/*
+flagList returns the CLI arguments to the Chromium binary. Flags that can't be
+read are logged with the Chromium logger.
+*/
+func (chrome *Chrome) flagList() []string {
+	if flags, ok := chrome.Flags().(Flags); ok {
+		return flags.list(chrome.Logger())
+	}
+	return chrome.Flags().List()
+}
+
 /*
 Launch implements Chromium.
 
@@ -183,20 +301,44 @@ they aren't included in the Flags definition:
 	remote-debugging-address = "0.0.0.0"
 	remote-debugging-port = 9222
 	port = 9222
-	user-data-dir = os.TempDir() + chrome.Workdir()
+	user-data-dir = os.TempDir()
 	chrome.workdir = "headless-chrome"
 	chrome.output = "/dev/stdout"
+
+If remote-debugging-port is set to 0 Chromium selects a free port. The port is
+read back from the "DevTools listening on" line written to STDERR or from the
+DevToolsActivePort file in the user data directory and stored in the port flag,
+so Query and the tab sockets use it. Unless a user-data-dir is specified a
+private temporary directory is used in that case, so parallel instances don't
+read each other's DevToolsActivePort file. It is removed by Close.
+
+The binary is run with --version first and rejected with a VersionError if it
+is older than the minimum version, or with an error if it doesn't report its
+version, unless the version check is disabled, see SetMinVersion.
+
+Launch returns as soon as the remote debugging endpoint responds, waiting up to
+the startup timeout, see SetStartupTimeout. If Chromium exits or the endpoint
+isn't available in time a LaunchError is returned with the exit status and the
+last lines written to STDERR.
+
+If the remote-debugging-pipe flag is set the debugging port is not opened and
+the browser is controlled over a pipe instead, see Browser. The developer
+tools HTTP endpoints are not available in that mode, NewTab creates targets
+with the browser socket and each tab uses a flattened session over the pipe.
 */
 func (chrome *Chrome) Launch() error {
 	var err error
 
 	// Default values for required parameters
-	chrome.Address()
-	chrome.DebuggingAddress()
-	chrome.DebuggingPort()
-	chrome.Port()
-	if !chrome.Flags().Has("user-data-dir") {
-		chrome.Flags().Set("user-data-dir", os.TempDir())
+	pipe := chrome.Flags().Has("remote-debugging-pipe")
+	if !pipe {
+		chrome.Address()
+		chrome.DebuggingAddress()
+		chrome.DebuggingPort()
+		chrome.Port()
+	}
+	if err = chrome.checkVersion(); nil != err {
+		return err
 	}
 
 	if err = os.MkdirAll(chrome.Workdir(), 0700); err != nil {
@@ -229,36 +371,101 @@ func (chrome *Chrome) Launch() error {
 		}
 	}
 
-	log.Infof("Starting process: %s %s", chrome.Binary(), chrome.Flags())
+	// Chromium reports the port it selected when remote-debugging-port is 0.
+	discover := !pipe && 0 == chrome.DebuggingPort()
+	if err = chrome.setUserDataDir(discover); nil != err {
+		chrome.closeSTDOUT()
+		return err
+	}
+	if discover {
+		os.Remove(filepath.Join(chrome.userDataDir(), devToolsActivePort))
+	}
+
+	chrome.Logger().Infof("Starting process: %s %s", chrome.Binary(), chrome.Flags())
 	var procAttributes os.ProcAttr
 	procAttributes.Dir = chrome.Workdir()
 	procAttributes.Files = []*os.File{nil, chrome.stdOUTFile, chrome.stdERRFile}
+
+	// STDERR is watched for the remote debugging endpoint.
+	stdERRWriter, stderr, err := chrome.watchSTDERR()
+	if nil != err {
+		chrome.removeDataDir()
+		chrome.closeSTDOUT()
+		return err
+	}
+	procAttributes.Files[2] = stdERRWriter
+	childFiles := []*os.File{stdERRWriter}
+
+	// Chromium reads commands from fd 3 and writes messages to fd 4.
+	var conn *socket.ChromePipe
+	if pipe {
+		var pipeFiles []*os.File
+		if conn, pipeFiles, err = openPipe(); nil != err {
+			stdERRWriter.Close()
+			chrome.closeSTDOUT()
+			return err
+		}
+		procAttributes.Files = append(procAttributes.Files, pipeFiles...)
+		childFiles = append(childFiles, pipeFiles...)
+	}
+
+	// The first argument is the program name.
 	chrome.process, err = os.StartProcess(
 		chrome.Binary(),
-		chrome.Flags().List(),
+		append([]string{chrome.Binary()}, chrome.flagList()...),
 		&procAttributes,
 	)
+	// The files are inherited by the process, closing them here lets the
+	// watchers see when it exits.
+	for _, file := range childFiles {
+		file.Close()
+	}
 	if nil != err {
-		chrome.stdOUTFile.Close()
+		if nil != conn {
+			conn.Close()
+		}
+		chrome.removeDataDir()
+		chrome.closeSTDOUT()
 		return errs.Wrap(err, 0, "error starting chrome")
 	}
 
-	// Wait up to 10 seconds for Chromium to start
-	for i := 0; i < 10; i++ {
-		time.Sleep(time.Second)
-		if _, err = chrome.Version(); nil == err {
-			break
-		}
+	chrome.waitProcess()
+
+	if pipe {
+		pipeURL := &url.URL{Scheme: "pipe", Host: "browser"}
+		chrome.browser = socket.New(pipeURL, chrome.browserOptions(socket.WithWebSocketer(conn))...)
 	}
-	if err != nil {
-		log.Error("Chromium took too long to start")
+	if err = chrome.waitForStartup(stderr, pipe, discover); err != nil {
+		chrome.Logger().Errorf("%v", err)
 		chrome.Close()
-		return errs.Wrap(err, 0, "chromium took too long to start")
+		return err
 	}
 
 	return nil
 }
 
+/*
+Logger implements Chromium.
+*/
+func (chrome *Chrome) Logger() socket.Logger {
+	if nil == chrome.logger {
+		chrome.logger = socket.NewLogrusLogger(log.StandardLogger())
+	}
+	return chrome.logger
+}
+
+/*
+MinVersion implements Chromium.
+
+Default value is 73, the first version with flattened sessions.
+*/
+func (chrome *Chrome) MinVersion() int {
+	if 0 == chrome.minVersion {
+		return defaultMinVersion
+	}
+	return chrome.minVersion
+}
+
 /*
 Port implements Chromium.
 
@@ -291,7 +498,7 @@ func (chrome *Chrome) Query(
 	}
 	defer resp.Body.Close()
 
-	log.Debugf("chrome:/%s %s", path, resp.Status)
+	chrome.Logger().Debugf("chrome:/%s %s", path, resp.Status)
 	if 200 != resp.StatusCode {
 		return nil, errs.New(0, resp.Status)
 	}
@@ -311,14 +518,54 @@ func (chrome *Chrome) Query(
 RemoveTab implements Chromium.
 */
 func (chrome *Chrome) RemoveTab(tab *Tab) {
-	tabs := make([]*Tab, 0)
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
 	for k, t := range chrome.tabs {
 		if t == tab {
-			chrome.tabs = append(chrome.tabs[:k], chrome.tabs[k+1:]...)
+			chrome.tabs = append(chrome.tabs[:k:k], chrome.tabs[k+1:]...)
 			break
 		}
 	}
-	chrome.tabs = tabs
+}
+
+/*
+SetLogger implements Chromium.
+*/
+func (chrome *Chrome) SetLogger(logger socket.Logger) {
+	chrome.logger = logger
+}
+
+/*
+SetMinVersion implements Chromium.
+*/
+func (chrome *Chrome) SetMinVersion(version int) {
+	chrome.minVersion = version
+}
+
+/*
+SetSocketOptions implements Chromium.
+*/
+func (chrome *Chrome) SetSocketOptions(options ...socket.Option) {
+	chrome.socketOptions = options
+}
+
+/*
+SetStartupTimeout implements Chromium.
+*/
+func (chrome *Chrome) SetStartupTimeout(timeout time.Duration) {
+	chrome.startupTimeout = timeout
+}
+
+/*
+StartupTimeout implements Chromium.
+
+Default value is 10 seconds.
+*/
+func (chrome *Chrome) StartupTimeout() time.Duration {
+	if chrome.startupTimeout <= 0 {
+		return defaultStartupTimeout
+	}
+	return chrome.startupTimeout
 }
 
 /*
@@ -339,13 +586,21 @@ func (chrome *Chrome) STDOUT() string {
 Tabs implements Chromium.
 */
 func (chrome *Chrome) Tabs() []*Tab {
-	return chrome.tabs
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
+	return append([]*Tab(nil), chrome.tabs...)
 }
 
 /*
 Version implements Chromium.
+
+When controlled over the remote debugging pipe the version is requested from
+the browser and WebSocketDebuggerURL is empty.
 */
 func (chrome *Chrome) Version() (*Version, error) {
+	if nil == chrome.version && chrome.Flags().Has("remote-debugging-pipe") {
+		return chrome.pipeVersion()
+	}
 	if nil == chrome.version {
 		if _, err := chrome.Query(
 			"/json/version",
@@ -0,0 +1,241 @@
+package chrome
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+defaultMinVersion is the oldest Chromium major version Launch accepts unless a
+minimum version is set, see SetMinVersion. The tabs controlled over the remote
+debugging pipe and Socket.AttachToTarget use flattened sessions, which older
+versions don't support.
+*/
+const defaultMinVersion = 73
+
+/*
+binaryNames are the executable names FindBinary looks up in PATH, in order of
+preference.
+*/
+var binaryNames = []string{
+	"google-chrome-stable",
+	"google-chrome",
+	"chromium",
+	"chromium-browser",
+	"headless_shell",
+}
+
+/*
+binaryLocations are the common install locations FindBinary checks for each
+operating system, in order of preference.
+*/
+var binaryLocations = map[string][]string{
+	"darwin": {
+		"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+		"/Applications/Chromium.app/Contents/MacOS/Chromium",
+		"/Applications/Google Chrome Canary.app/Contents/MacOS/Google Chrome Canary",
+	},
+	"linux": {
+		"/usr/bin/google-chrome",
+		"/opt/google/chrome/chrome",
+		"/usr/bin/chromium",
+		"/usr/bin/chromium-browser",
+		"/snap/bin/chromium",
+		"/headless-shell/headless-shell",
+	},
+	"windows": {
+		`C:\Program Files\Google\Chrome\Application\chrome.exe`,
+		`C:\Program Files (x86)\Google\Chrome\Application\chrome.exe`,
+		`C:\Program Files\Chromium\Application\chrome.exe`,
+	},
+}
+
+/*
+binaryVersionTimeout is how long BinaryVersion waits for the binary to print
+its version. Some builds, such as headless_shell, ignore --version and keep
+running.
+*/
+var binaryVersionTimeout = 5 * time.Second
+
+/*
+binaryVersion is the result of running a binary with the --version flag.
+*/
+type binaryVersion struct {
+	version string
+	err     error
+}
+
+/*
+binaryVersions caches the result of BinaryVersion for each binary.
+*/
+var binaryVersions = make(map[string]binaryVersion)
+var binaryVersionsMux = &sync.Mutex{}
+
+/*
+versionPattern matches the version number in the --version output, for
+example 'Chromium 120.0.6099.109 built on Debian'.
+*/
+var versionPattern = regexp.MustCompile(`\d+(\.\d+){1,3}`)
+
+/*
+VersionError is returned by Launch when the Chromium binary is older than the
+minimum version, see SetMinVersion.
+*/
+type VersionError struct {
+	// Binary is the path to the Chromium binary.
+	Binary string
+
+	// MinVersion is the oldest Chromium major version accepted.
+	MinVersion int
+
+	// Version is the version reported by the binary.
+	Version string
+}
+
+/*
+Error implements the error interface.
+*/
+func (err *VersionError) Error() string {
+	return fmt.Sprintf(
+		"%s version %s is not supported, Chromium %d or later is required",
+		err.Binary,
+		err.Version,
+		err.MinVersion,
+	)
+}
+
+/*
+BinaryVersion runs a Chromium binary with the --version flag and returns the
+version it reports, for example '120.0.6099.109'. The version is cached by
+path. Failures are not cached, so a binary that timed out is checked again on
+the next call.
+*/
+func BinaryVersion(binary string) (string, error) {
+	binaryVersionsMux.Lock()
+	result, ok := binaryVersions[binary]
+	binaryVersionsMux.Unlock()
+	if ok {
+		return result.version, result.err
+	}
+
+	result.version, result.err = readBinaryVersion(binary)
+	if nil != result.err {
+		return "", result.err
+	}
+	binaryVersionsMux.Lock()
+	binaryVersions[binary] = result
+	binaryVersionsMux.Unlock()
+	return result.version, nil
+}
+
+/*
+readBinaryVersion runs a binary with the --version flag and parses the
+version from the output. The process is killed if it doesn't exit within
+binaryVersionTimeout.
+*/
+func readBinaryVersion(binary string) (string, error) {
+	// The output is read from a pipe that can be closed on timeout, child
+	// processes may keep the write end open.
+	reader, writer, err := os.Pipe()
+	if nil != err {
+		return "", errs.Wrap(err, 0, "could not create output pipe")
+	}
+	cmd := exec.Command(binary, "--version")
+	cmd.Stdout = writer
+	err = cmd.Start()
+	writer.Close()
+	if nil != err {
+		reader.Close()
+		return "", errs.Wrap(err, 0, fmt.Sprintf("'%s --version' failed", binary))
+	}
+
+	outputs := make(chan []byte, 1)
+	go func() {
+		output, _ := ioutil.ReadAll(reader)
+		cmd.Wait()
+		outputs <- output
+	}()
+	var output []byte
+	select {
+	case output = <-outputs:
+		reader.Close()
+	case <-time.After(binaryVersionTimeout):
+		cmd.Process.Kill()
+		reader.Close()
+		return "", errs.New(0, fmt.Sprintf("'%s --version' did not exit within %s", binary, binaryVersionTimeout))
+	}
+
+	version := versionPattern.FindString(string(output))
+	if "" == version {
+		return "", errs.New(0, fmt.Sprintf("no version found in '%s --version' output: %s", binary, strings.TrimSpace(string(output))))
+	}
+	return version, nil
+}
+
+/*
+FindBinary returns the path to a Chromium binary. The CHROME_PATH environment
+variable is used if it is set, otherwise the first binary found in PATH or in
+a common install location.
+*/
+func FindBinary() (string, error) {
+	if binary := os.Getenv("CHROME_PATH"); "" != binary {
+		if !isExecutable(binary) {
+			return "", errs.New(0, fmt.Sprintf("CHROME_PATH '%s' is not an executable file", binary))
+		}
+		return binary, nil
+	}
+	for _, name := range binaryNames {
+		if binary, err := exec.LookPath(name); nil == err {
+			return binary, nil
+		}
+	}
+	for _, binary := range binaryLocations[runtime.GOOS] {
+		if isExecutable(binary) {
+			return binary, nil
+		}
+	}
+	return "", errs.New(0, "no Chromium binary found, set CHROME_PATH")
+}
+
+/*
+checkVersion rejects the Chromium binary if it is older than the minimum
+version or doesn't report its version. The check is skipped if the minimum
+version is negative.
+*/
+func (chrome *Chrome) checkVersion() error {
+	minVersion := chrome.MinVersion()
+	if minVersion < 0 {
+		return nil
+	}
+	version, err := BinaryVersion(chrome.Binary())
+	if nil != err {
+		return errs.Wrap(err, 0, "could not determine the Chromium version, see SetMinVersion to skip the version check")
+	}
+	major, _ := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if major < minVersion {
+		return &VersionError{Binary: chrome.Binary(), MinVersion: minVersion, Version: version}
+	}
+	chrome.Logger().Debugf("%s version %s", chrome.Binary(), version)
+	return nil
+}
+
+/*
+isExecutable returns whether path is an executable file.
+*/
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if nil != err || info.IsDir() {
+		return false
+	}
+	return "windows" == runtime.GOOS || 0 != info.Mode()&0111
+}
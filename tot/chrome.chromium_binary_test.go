@@ -0,0 +1,209 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+hideBinaries clears CHROME_PATH, PATH and the install locations so FindBinary
+only finds the binaries a test provides. The returned function restores them.
+*/
+func hideBinaries(t *testing.T) func() {
+	chromePath := os.Getenv("CHROME_PATH")
+	path := os.Getenv("PATH")
+	locations := binaryLocations
+	os.Setenv("CHROME_PATH", "")
+	os.Setenv("PATH", "")
+	binaryLocations = map[string][]string{}
+	return func() {
+		os.Setenv("CHROME_PATH", chromePath)
+		os.Setenv("PATH", path)
+		binaryLocations = locations
+	}
+}
+
+func TestFindBinary(t *testing.T) {
+	defer hideBinaries(t)()
+	dir, err := ioutil.TempDir("", "TestFindBinary")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := FindBinary(); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+
+	binary := fakeChromium(t, dir, "")
+	os.Setenv("PATH", dir)
+	if found, err := FindBinary(); nil != err || binary != found {
+		t.Errorf("Expected '%s', received '%s' (%v)", binary, found, err)
+	}
+
+	os.Setenv("CHROME_PATH", filepath.Join(dir, "missing"))
+	if _, err := FindBinary(); nil == err {
+		t.Errorf("Expected error for a missing CHROME_PATH binary, received nil")
+	}
+
+	other := filepath.Join(dir, "chrome")
+	if err := os.Rename(binary, other); nil != err {
+		t.Fatal(err)
+	}
+	os.Setenv("CHROME_PATH", other)
+	if found, err := FindBinary(); nil != err || other != found {
+		t.Errorf("Expected '%s', received '%s' (%v)", other, found, err)
+	}
+
+	os.Setenv("CHROME_PATH", "")
+	os.Setenv("PATH", "")
+	binaryLocations = map[string][]string{"linux": {other}, "darwin": {other}}
+	if found, err := FindBinary(); nil != err || other != found {
+		t.Errorf("Expected '%s', received '%s' (%v)", other, found, err)
+	}
+
+	chrome := New(&Flags{}, "", "", "", "")
+	if other != chrome.Binary() {
+		t.Errorf("Expected '%s', received '%s'", other, chrome.Binary())
+	}
+}
+
+func TestBinaryVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestBinaryVersion")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	version, err := BinaryVersion(fakeChromium(t, dir, ""))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "120.0.6099.109" != version {
+		t.Errorf("Expected '120.0.6099.109', received '%s'", version)
+	}
+
+	if _, err := BinaryVersion(filepath.Join(dir, "missing")); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+}
+
+func TestBinaryVersionTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestBinaryVersionTimeout")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The binary ignores --version and a child process keeps the output
+	// open.
+	binary := fakeChromium(t, dir, "")
+	if err := ioutil.WriteFile(binary, []byte("#!/bin/sh\nsleep 5\n"), 0700); nil != err {
+		t.Fatal(err)
+	}
+	timeout := binaryVersionTimeout
+	binaryVersionTimeout = 200 * time.Millisecond
+	defer func() { binaryVersionTimeout = timeout }()
+
+	start := time.Now()
+	if _, err := BinaryVersion(binary); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected BinaryVersion to give up after the timeout, returned after %s", elapsed)
+	}
+
+	// Failures are not cached.
+	binaryVersionsMux.Lock()
+	_, ok := binaryVersions[binary]
+	binaryVersionsMux.Unlock()
+	if ok {
+		t.Errorf("Expected the failure not to be cached")
+	}
+}
+
+func TestChromiumLaunchVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestChromiumLaunchVersion")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	binary := fakeChromium(t, dir, "")
+	script := "#!/bin/sh\necho 'Chromium 60.0.3112.113'\n"
+	if err := ioutil.WriteFile(binary, []byte(script), 0700); nil != err {
+		t.Fatal(err)
+	}
+	chrome := New(
+		&Flags{"user-data-dir": dir},
+		binary,
+		dir,
+		"",
+		filepath.Join(dir, "stderr.log"),
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	err = chrome.Launch()
+	versionErr, ok := err.(*VersionError)
+	if !ok {
+		t.Fatalf("Expected *VersionError, received %T: %v", err, err)
+	}
+	if "60.0.3112.113" != versionErr.Version {
+		t.Errorf("Expected '60.0.3112.113', received '%s'", versionErr.Version)
+	}
+	if defaultMinVersion != versionErr.MinVersion {
+		t.Errorf("Expected %d, received %d", defaultMinVersion, versionErr.MinVersion)
+	}
+	if nil != chrome.process {
+		t.Errorf("Expected no process to be started")
+	}
+
+	chrome.SetMinVersion(60)
+	if err := chrome.checkVersion(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	chrome.SetMinVersion(61)
+	if _, ok := chrome.checkVersion().(*VersionError); !ok {
+		t.Errorf("Expected *VersionError")
+	}
+}
+
+func TestChromiumLaunchUnknownVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestChromiumLaunchUnknownVersion")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	binary := fakeChromium(t, dir, "")
+	if err := ioutil.WriteFile(binary, []byte("#!/bin/sh\necho 'headless shell'\n"), 0700); nil != err {
+		t.Fatal(err)
+	}
+	chrome := New(
+		&Flags{"user-data-dir": dir},
+		binary,
+		dir,
+		"",
+		filepath.Join(dir, "stderr.log"),
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	err = chrome.Launch()
+	if nil == err {
+		t.Fatalf("Expected error, received nil")
+	}
+	if _, ok := err.(*VersionError); ok {
+		t.Errorf("Expected the version lookup error, received %v", err)
+	}
+	if nil != chrome.process {
+		t.Errorf("Expected no process to be started")
+	}
+
+	chrome.SetMinVersion(-1)
+	if err := chrome.checkVersion(); nil != err {
+		t.Errorf("Expected the version check to be skipped, received error: %v", err)
+	}
+}
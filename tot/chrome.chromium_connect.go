@@ -0,0 +1,105 @@
+package chrome
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+Connect returns a Chromium instance connected to an already running browser,
+for example Chromium running in another container. address is the host and
+port of the remote debugging endpoint, such as 'chromium:9222' or
+'http://chromium:9222', or the browser websocket URL, such as
+'ws://chromium:9222/devtools/browser/<id>'. The port defaults to 9222.
+
+The open tabs are discovered with the /json/list endpoint, see Tabs. Any
+options are used to configure the socket connection of the browser and each
+tab, see SetSocketOptions.
+
+The browser process isn't owned by the returned instance, Close disconnects
+from the browser and the tabs without closing them.
+*/
+func Connect(address string, options ...socket.Option) (*Chrome, error) {
+	endpoint, err := parseEndpoint(address)
+	if nil != err {
+		return nil, err
+	}
+	port := 9222
+	if "" != endpoint.Port() {
+		if port, err = strconv.Atoi(endpoint.Port()); nil != err {
+			return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid port in '%s'", address))
+		}
+	}
+
+	chrome := New(
+		&Flags{
+			"addr": endpoint.Hostname(),
+			"port": port,
+		},
+		"",
+		"",
+		"",
+		"",
+	)
+	chrome.connected = true
+	chrome.socketOptions = options
+	if "ws" == endpoint.Scheme || "wss" == endpoint.Scheme {
+		chrome.browserURL = endpoint
+	}
+
+	if _, err := chrome.Version(); nil != err {
+		return nil, errs.Wrap(err, 0, fmt.Sprintf("no browser found at '%s'", address))
+	}
+	if err := chrome.SyncTabs(); nil != err {
+		return nil, err
+	}
+	return chrome, nil
+}
+
+/*
+parseEndpoint parses the address of a running browser. Addresses without a
+scheme are taken as 'host:port'.
+*/
+func parseEndpoint(address string) (*url.URL, error) {
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	endpoint, err := url.Parse(address)
+	if nil != err {
+		return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid browser address '%s'", address))
+	}
+	if "" == endpoint.Hostname() {
+		return nil, errs.New(0, fmt.Sprintf("invalid browser address '%s'", address))
+	}
+	return endpoint, nil
+}
+
+/*
+detach stops the browser and tab sockets without closing the targets.
+*/
+func (chrome *Chrome) detach() {
+	var wg sync.WaitGroup
+	stop := func(socket socket.Socketer) {
+		defer wg.Done()
+		socket.Stop()
+	}
+	for _, tab := range chrome.Tabs() {
+		wg.Add(1)
+		go stop(tab.Socket())
+	}
+	if nil != chrome.browser {
+		wg.Add(1)
+		go stop(chrome.browser)
+		chrome.browser = nil
+	}
+	wg.Wait()
+	chrome.tabsMux.Lock()
+	chrome.tabs = nil
+	chrome.tabsMux.Unlock()
+}
@@ -0,0 +1,79 @@
+package chrome
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/chrometest"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func TestChromiumConnect(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	first := server.NewTarget("https://example.com/first")
+	server.NewTarget("https://example.com/second")
+
+	chrome, err := Connect(
+		fmt.Sprintf("%s:%d", server.Address(), server.Port()),
+		socket.WithLogger(socket.NewNopLogger()),
+	)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 2 != len(chrome.Tabs()) {
+		t.Fatalf("Expected 2 tabs, received %d", len(chrome.Tabs()))
+	}
+	tab, err := chrome.GetTab(first.ID())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "https://example.com/first" != tab.URL().String() {
+		t.Errorf("Expected 'https://example.com/first', received '%s'", tab.URL())
+	}
+	if result := <-tab.Protocol().Page().Navigate(&page.NavigateParams{URL: "https://example.com/next"}); nil != result.Err {
+		t.Errorf("Expected nil, received error: %v", result.Err)
+	}
+
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if 2 != len(server.Targets()) {
+		t.Errorf("Expected the targets to be left open, received %d targets", len(server.Targets()))
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected 0 tabs, received %d", len(chrome.Tabs()))
+	}
+}
+
+func TestChromiumConnectWebSocket(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+
+	chrome, err := Connect(server.Browser().WebSocketDebuggerURL(), socket.WithLogger(socket.NewNopLogger()))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+	if server.Port() != chrome.Port() {
+		t.Errorf("Expected %d, received %d", server.Port(), chrome.Port())
+	}
+	browser, err := chrome.Browser()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if server.Browser().WebSocketDebuggerURL() != browser.URL().String() {
+		t.Errorf("Expected '%s', received '%s'", server.Browser().WebSocketDebuggerURL(), browser.URL())
+	}
+	if result := <-browser.Browser().GetVersion(); nil != result.Err {
+		t.Errorf("Expected nil, received error: %v", result.Err)
+	}
+
+	if _, err := Connect("http://"); nil == err {
+		t.Errorf("Expected error for an invalid address, received nil")
+	}
+	if _, err := Connect("127.0.0.1:1"); nil == err {
+		t.Errorf("Expected error without a browser, received nil")
+	}
+}
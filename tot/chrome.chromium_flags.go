@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/tot/socket"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -40,6 +41,14 @@ func (flags Flags) Has(arg string) bool {
 List implements ChromiumFlags
 */
 func (flags Flags) List() []string {
+	return flags.list(socket.NewLogrusLogger(log.StandardLogger()))
+}
+
+/*
+list returns the flags formatted as CLI arguments. A flag that can't be read
+is logged and skipped.
+*/
+func (flags Flags) list(logger socket.Logger) []string {
 	var list []string
 
 	orderedFlags := []string{}
@@ -51,7 +60,8 @@ func (flags Flags) List() []string {
 	for _, arg := range orderedFlags {
 		val, err := flags.Get(arg)
 		if nil != err {
-			log.Error(err)
+			logger.Errorf("skipping flag '%s': %v", arg, err)
+			continue
 		}
 		switch val.(type) {
 		case int:
@@ -0,0 +1,264 @@
+package chrome
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+defaultStartupTimeout is how long Launch waits for the remote debugging
+endpoint unless a startup timeout is set, see SetStartupTimeout.
+*/
+const defaultStartupTimeout = 10 * time.Second
+
+/*
+startupPollInterval is how often Launch checks the DevToolsActivePort file and
+the version endpoint while waiting for Chromium to start.
+*/
+const startupPollInterval = 100 * time.Millisecond
+
+/*
+stderrTailLines is the number of STDERR lines kept to report launch failures.
+*/
+const stderrTailLines = 20
+
+/*
+LaunchError is returned by Launch when Chromium exited or didn't open the
+remote debugging endpoint before the startup timeout.
+*/
+type LaunchError struct {
+	// Err is the reason the launch failed.
+	Err error
+
+	// State is the state of the Chromium process if it exited during
+	// startup, nil otherwise.
+	State *os.ProcessState
+
+	// Stderr holds the last lines Chromium wrote to STDERR.
+	Stderr []string
+}
+
+/*
+Error implements the error interface.
+*/
+func (err *LaunchError) Error() string {
+	msg := "chromium failed to start"
+	if nil != err.State {
+		msg = fmt.Sprintf("chromium exited during startup: %s", err.State)
+	} else if nil != err.Err {
+		msg = fmt.Sprintf("%s: %v", msg, err.Err)
+	}
+	if len(err.Stderr) > 0 {
+		msg = fmt.Sprintf("%s\n%s", msg, strings.Join(err.Stderr, "\n"))
+	}
+	return msg
+}
+
+/*
+Unwrap returns the underlying cause.
+*/
+func (err *LaunchError) Unwrap() error {
+	return err.Err
+}
+
+/*
+stderrWatcher copies the STDERR output of the Chromium process and keeps the
+last lines to report launch failures.
+*/
+type stderrWatcher struct {
+	// done is closed once the output has been read.
+	done chan struct{}
+
+	// listening receives the websocket URL from the "DevTools listening on"
+	// line.
+	listening chan string
+
+	mux  sync.Mutex
+	tail []string
+}
+
+/*
+Tail returns the last lines written to STDERR.
+*/
+func (watcher *stderrWatcher) Tail() []string {
+	watcher.mux.Lock()
+	defer watcher.mux.Unlock()
+	return append([]string{}, watcher.tail...)
+}
+
+/*
+watch copies the output read from reader to output line by line.
+*/
+func (watcher *stderrWatcher) watch(reader io.ReadCloser, output io.Writer) {
+	defer close(watcher.done)
+	defer reader.Close()
+	buffer := bufio.NewReader(reader)
+	found := false
+	for {
+		line, err := buffer.ReadString('\n')
+		if len(line) > 0 {
+			io.WriteString(output, line)
+			if match := devToolsListening.FindStringSubmatch(line); !found && nil != match {
+				watcher.listening <- match[1]
+				found = true
+			}
+			watcher.mux.Lock()
+			watcher.tail = append(watcher.tail, strings.TrimRight(line, "\r\n"))
+			if len(watcher.tail) > stderrTailLines {
+				watcher.tail = watcher.tail[len(watcher.tail)-stderrTailLines:]
+			}
+			watcher.mux.Unlock()
+		}
+		if nil != err {
+			return
+		}
+	}
+}
+
+/*
+watchSTDERR returns a file to use as the STDERR of the Chromium process. The
+output is copied to the STDERR file of the Chromium instance by the returned
+watcher. The file should be closed once the process has started.
+*/
+func (chrome *Chrome) watchSTDERR() (*os.File, *stderrWatcher, error) {
+	reader, writer, err := os.Pipe()
+	if nil != err {
+		return nil, nil, errs.Wrap(err, 0, "could not create error output pipe")
+	}
+	watcher := &stderrWatcher{
+		done:      make(chan struct{}),
+		listening: make(chan string, 1),
+	}
+	go watcher.watch(reader, chrome.stdERRFile)
+	return writer, watcher, nil
+}
+
+/*
+waitProcess waits for the Chromium process to exit in the background. The
+exited channel is closed once it has.
+*/
+func (chrome *Chrome) waitProcess() {
+	exited := make(chan struct{})
+	process := chrome.process
+	chrome.exited = exited
+	go func() {
+		chrome.processState, chrome.processErr = process.Wait()
+		close(exited)
+	}()
+}
+
+/*
+closeSTDOUT closes the STDOUT file unless it is the system STDOUT.
+*/
+func (chrome *Chrome) closeSTDOUT() {
+	if nil != chrome.stdOUTFile && os.Stdout != chrome.stdOUTFile {
+		chrome.stdOUTFile.Close()
+	}
+}
+
+/*
+exitError returns the LaunchError for a Chromium process that exited during
+startup, once the remaining STDERR output has been read.
+*/
+func (chrome *Chrome) exitError(err error, stderr *stderrWatcher) *LaunchError {
+	// Child processes may keep STDERR open.
+	select {
+	case <-stderr.done:
+	case <-time.After(500 * time.Millisecond):
+	}
+	return &LaunchError{Err: err, State: chrome.processState, Stderr: stderr.Tail()}
+}
+
+/*
+waitForStartup waits until the remote debugging endpoint of the Chromium
+process responds, the process exits or the startup timeout expires.
+
+Chromium writes the websocket URL of the endpoint to STDERR once it listens,
+the version endpoint is queried as soon as it does. If discover is true the
+port is taken from that URL or from the DevToolsActivePort file. The version
+endpoint and the DevToolsActivePort file are also polled in case the line
+isn't written.
+*/
+func (chrome *Chrome) waitForStartup(stderr *stderrWatcher, pipe, discover bool) error {
+	timeout := chrome.StartupTimeout()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(startupPollInterval)
+	defer ticker.Stop()
+
+	// Over the pipe the version is requested from the browser socket.
+	versions := make(chan error, 1)
+	if pipe {
+		go func() {
+			_, err := chrome.Version()
+			versions <- err
+		}()
+	}
+
+	portFile := filepath.Join(chrome.userDataDir(), devToolsActivePort)
+	known := !pipe && !discover
+	for {
+		select {
+		case err := <-versions:
+			if nil == err {
+				return nil
+			}
+			// A closed pipe usually means the process exited.
+			select {
+			case <-chrome.exited:
+				return chrome.exitError(err, stderr)
+			case <-time.After(500 * time.Millisecond):
+				return &LaunchError{Err: err, Stderr: stderr.Tail()}
+			}
+
+		case <-chrome.exited:
+			return chrome.exitError(chrome.processErr, stderr)
+
+		case websocketURL := <-stderr.listening:
+			if pipe {
+				continue
+			}
+			if discover {
+				port, err := parseWebSocketPort(websocketURL)
+				if nil != err {
+					return &LaunchError{Err: err, Stderr: stderr.Tail()}
+				}
+				chrome.setDiscoveredPort(port)
+				known = true
+			}
+			if _, err := chrome.Version(); nil == err {
+				return nil
+			}
+
+		case <-ticker.C:
+			if pipe {
+				continue
+			}
+			if !known {
+				port, err := readDevToolsActivePort(portFile)
+				if nil != err {
+					continue
+				}
+				chrome.setDiscoveredPort(port)
+				known = true
+			}
+			if _, err := chrome.Version(); nil == err {
+				return nil
+			}
+
+		case <-deadline.C:
+			return &LaunchError{
+				Err:    fmt.Errorf("remote debugging endpoint not available within %s", timeout),
+				Stderr: stderr.Tail(),
+			}
+		}
+	}
+}
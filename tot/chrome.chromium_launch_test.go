@@ -0,0 +1,153 @@
+package chrome
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/chrometest"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func TestChromiumLaunchReady(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "TestChromiumLaunchReady")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	chrome := New(
+		&Flags{
+			"addr":                  server.Address(),
+			"port":                  server.Port(),
+			"remote-debugging-port": server.Port(),
+			"user-data-dir":         dir,
+		},
+		fakeChromium(t, dir, "echo 'DevTools listening on ws://127.0.0.1/devtools/browser/browser' >&2"),
+		dir,
+		"",
+		filepath.Join(dir, "stderr.log"),
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	start := time.Now()
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+	if elapsed := time.Since(start); elapsed >= defaultStartupTimeout {
+		t.Errorf("Expected Launch to return when the endpoint is ready, returned after %s", elapsed)
+	}
+
+	output, _ := ioutil.ReadFile(filepath.Join(dir, "stderr.log"))
+	if !strings.Contains(string(output), "DevTools listening on") {
+		t.Errorf("Expected the error output to be copied to the STDERR file, received '%s'", output)
+	}
+}
+
+func TestChromiumLaunchExit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestChromiumLaunchExit")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	chrome := New(
+		&Flags{"user-data-dir": dir},
+		fakeChromium(t, dir, "echo 'first line' >&2\necho 'cannot open display' >&2\nexit 3"),
+		dir,
+		"",
+		filepath.Join(dir, "stderr.log"),
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	start := time.Now()
+	err = chrome.Launch()
+	if elapsed := time.Since(start); elapsed >= defaultStartupTimeout {
+		t.Errorf("Expected Launch to fail when the process exits, failed after %s", elapsed)
+	}
+	var launchErr *LaunchError
+	if !errors.As(err, &launchErr) {
+		t.Fatalf("Expected LaunchError, received '%v'", err)
+	}
+	if nil == launchErr.State || 3 != launchErr.State.ExitCode() {
+		t.Errorf("Expected exit status 3, received %v", launchErr.State)
+	}
+	if 2 != len(launchErr.Stderr) || "cannot open display" != launchErr.Stderr[1] {
+		t.Errorf("Expected the STDERR tail, received %v", launchErr.Stderr)
+	}
+	if !strings.Contains(err.Error(), "exit status 3") || !strings.Contains(err.Error(), "cannot open display") {
+		t.Errorf("Expected the exit status and STDERR tail in the message, received '%s'", err)
+	}
+}
+
+func TestChromiumLaunchArgs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestChromiumLaunchArgs")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	args := filepath.Join(dir, "args")
+	chrome := New(
+		&Flags{
+			"remote-debugging-pipe": true,
+			"user-data-dir":         dir,
+		},
+		fakeChromium(t, dir, "echo \"$@\" > '"+args+"'\nexit 1"),
+		dir,
+		"",
+		filepath.Join(dir, "stderr.log"),
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	if err := chrome.Launch(); nil == err {
+		t.Fatalf("Expected error, received nil")
+	}
+	output, _ := ioutil.ReadFile(args)
+	if !strings.Contains(string(output), "--remote-debugging-pipe") {
+		t.Errorf("Expected --remote-debugging-pipe in the arguments, received '%s'", output)
+	}
+	if !strings.Contains(string(output), "--user-data-dir="+dir) {
+		t.Errorf("Expected --user-data-dir in the arguments, received '%s'", output)
+	}
+}
+
+func TestChromiumLaunchTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestChromiumLaunchTimeout")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	chrome := New(
+		&Flags{
+			"port":                  1,
+			"remote-debugging-port": 1,
+			"user-data-dir":         dir,
+		},
+		fakeChromium(t, dir, "echo 'starting' >&2"),
+		dir,
+		"",
+		filepath.Join(dir, "stderr.log"),
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	chrome.SetStartupTimeout(300 * time.Millisecond)
+	if 300*time.Millisecond != chrome.StartupTimeout() {
+		t.Errorf("Expected 300ms, received %s", chrome.StartupTimeout())
+	}
+	err = chrome.Launch()
+	var launchErr *LaunchError
+	if !errors.As(err, &launchErr) {
+		t.Fatalf("Expected LaunchError, received '%v'", err)
+	}
+	if nil != launchErr.State {
+		t.Errorf("Expected the process to be running at the timeout, received %v", launchErr.State)
+	}
+	if 1 != len(launchErr.Stderr) || "starting" != launchErr.Stderr[0] {
+		t.Errorf("Expected the STDERR tail, received %v", launchErr.Stderr)
+	}
+}
@@ -0,0 +1,54 @@
+package chrome
+
+import (
+	"context"
+	"os"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+openPipe creates the remote debugging pipe. It returns the connection used by
+the browser socket and the files to pass to the Chromium process as file
+descriptors 3 and 4, which should be closed once the process has started.
+*/
+func openPipe() (*socket.ChromePipe, []*os.File, error) {
+	commandReader, commandWriter, err := os.Pipe()
+	if nil != err {
+		return nil, nil, errs.Wrap(err, 0, "could not create command pipe")
+	}
+	messageReader, messageWriter, err := os.Pipe()
+	if nil != err {
+		commandReader.Close()
+		commandWriter.Close()
+		return nil, nil, errs.Wrap(err, 0, "could not create message pipe")
+	}
+	return socket.NewPipe(messageReader, commandWriter), []*os.File{commandReader, messageWriter}, nil
+}
+
+/*
+pipeVersion requests the version information from the browser over the
+remote debugging pipe. Chromium is given up to the startup timeout to respond.
+*/
+func (chrome *Chrome) pipeVersion() (*Version, error) {
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, 0, "version query failed")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), chrome.StartupTimeout())
+	defer cancel()
+	result := <-browser.Browser().GetVersionContext(ctx)
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, 0, "version query failed")
+	}
+
+	chrome.version = &Version{
+		Browser:         result.Product,
+		ProtocolVersion: result.ProtocolVersion,
+		UserAgent:       result.UserAgent,
+		V8Version:       result.JSVersion,
+	}
+	return chrome.version, nil
+}
@@ -0,0 +1,120 @@
+package chrome
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+devToolsActivePort is the name of the file Chromium writes to the user data
+directory once the remote debugging port is open. The first line is the port
+number and the second line is the path of the browser websocket.
+*/
+const devToolsActivePort = "DevToolsActivePort"
+
+/*
+devToolsListening matches the line Chromium writes to STDERR once the remote
+debugging port is open.
+*/
+var devToolsListening = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
+
+/*
+parseWebSocketPort returns the port of a DevTools websocket URL.
+*/
+func parseWebSocketPort(websocketURL string) (int, error) {
+	parsed, err := url.Parse(websocketURL)
+	if nil != err {
+		return 0, errs.Wrap(err, 0, fmt.Sprintf("invalid websocket URL '%s'", websocketURL))
+	}
+	port, err := strconv.Atoi(parsed.Port())
+	if nil != err {
+		return 0, errs.Wrap(err, 0, fmt.Sprintf("invalid port in websocket URL '%s'", websocketURL))
+	}
+	return port, nil
+}
+
+/*
+readDevToolsActivePort returns the port number stored in a DevToolsActivePort
+file.
+*/
+func readDevToolsActivePort(path string) (int, error) {
+	content, err := ioutil.ReadFile(path)
+	if nil != err {
+		return 0, err
+	}
+	lines := strings.SplitN(string(content), "\n", 2)
+	if len(lines) < 2 {
+		// Chromium hasn't finished writing the file yet.
+		return 0, errs.New(0, fmt.Sprintf("incomplete file '%s'", path))
+	}
+	port, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if nil != err || port <= 0 {
+		return 0, errs.New(0, fmt.Sprintf("invalid port in '%s'", path))
+	}
+	return port, nil
+}
+
+/*
+setDiscoveredPort stores the remote debugging port selected by Chromium in the
+port flag, so Query and the tab sockets use it.
+*/
+func (chrome *Chrome) setDiscoveredPort(port int) {
+	chrome.Logger().Infof("Chromium selected remote debugging port %d", port)
+	chrome.Flags().Set("port", port)
+}
+
+/*
+removeDataDir removes the user data directory created by Launch, if any.
+*/
+func (chrome *Chrome) removeDataDir() {
+	if "" == chrome.dataDir {
+		return
+	}
+	if err := os.RemoveAll(chrome.dataDir); nil != err {
+		chrome.Logger().Warnf("could not remove user data directory '%s': %v", chrome.dataDir, err)
+	}
+}
+
+/*
+setUserDataDir sets the user data directory unless one was specified. When
+the port is discovered a private directory is created so parallel instances
+don't share the DevToolsActivePort file, otherwise the system temporary
+directory is used.
+*/
+func (chrome *Chrome) setUserDataDir(discover bool) error {
+	// A directory created by a previous launch has been removed by Close.
+	if chrome.Flags().Has("user-data-dir") && chrome.userDataDir() != chrome.dataDir {
+		return nil
+	}
+	chrome.dataDir = ""
+	if !discover {
+		chrome.Flags().Set("user-data-dir", os.TempDir())
+		return nil
+	}
+	dir, err := ioutil.TempDir("", "go-chrome")
+	if nil != err {
+		return errs.Wrap(err, 0, "cannot create user data directory")
+	}
+	chrome.dataDir = dir
+	chrome.Flags().Set("user-data-dir", dir)
+	return nil
+}
+
+/*
+userDataDir returns the user data directory Chromium is launched with.
+*/
+func (chrome *Chrome) userDataDir() string {
+	value, err := chrome.Flags().Get("user-data-dir")
+	if nil != err {
+		return os.TempDir()
+	}
+	dir, _ := value.(string)
+	return dir
+}
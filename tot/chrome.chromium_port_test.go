@@ -0,0 +1,183 @@
+package chrome
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/chrometest"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+fakeChromium writes a shell script to dir that reports a supported version
+for --version, otherwise runs script and then sleeps like a running browser.
+*/
+func fakeChromium(t *testing.T, dir, script string) string {
+	if "windows" == runtime.GOOS {
+		t.Skip("requires a shell")
+	}
+	binary := filepath.Join(dir, "chromium")
+	if err := ioutil.WriteFile(binary, []byte(fakeVersion+script+"\nexec sleep 30\n"), 0700); nil != err {
+		t.Fatal(err)
+	}
+	return binary
+}
+
+const fakeVersion = `#!/bin/sh
+if [ "--version" = "$1" ]; then
+	echo 'Chromium 120.0.6099.109 built on Debian'
+	exit 0
+fi
+`
+
+func TestChromiumDevToolsActivePort(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestChromiumDevToolsActivePort")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	port, err := parseWebSocketPort("ws://127.0.0.1:41234/devtools/browser/abc")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 41234 != port {
+		t.Errorf("Expected 41234, received %d", port)
+	}
+	if _, err := parseWebSocketPort("ws://127.0.0.1/devtools/browser/abc"); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+
+	portFile := filepath.Join(dir, devToolsActivePort)
+	if _, err := readDevToolsActivePort(portFile); nil == err {
+		t.Errorf("Expected error for a missing file, received nil")
+	}
+	ioutil.WriteFile(portFile, []byte("41235"), 0600)
+	if _, err := readDevToolsActivePort(portFile); nil == err {
+		t.Errorf("Expected error for an incomplete file, received nil")
+	}
+	ioutil.WriteFile(portFile, []byte("41235\n/devtools/browser/abc"), 0600)
+	port, err = readDevToolsActivePort(portFile)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 41235 != port {
+		t.Errorf("Expected 41235, received %d", port)
+	}
+}
+
+func TestChromiumLaunchFreePort(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+
+	for name, script := range map[string]string{
+		"stderr":             "echo 'DevTools listening on ws://127.0.0.1:%d/devtools/browser/browser' >&2",
+		"DevToolsActivePort": "printf '%%s\\n/devtools/browser/browser' %d > \"$DIR/DevToolsActivePort\"",
+	} {
+		dir, err := ioutil.TempDir("", "TestChromiumLaunchFreePort")
+		if nil != err {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		// The fake binary reports the port of the test server like Chromium does.
+		binary := fakeChromium(t, dir, fmt.Sprintf("DIR='%s'\n"+script, dir, server.Port()))
+		chrome := New(
+			&Flags{
+				"addr":                  server.Address(),
+				"remote-debugging-port": 0,
+				"user-data-dir":         dir,
+			},
+			binary,
+			dir,
+			"",
+			filepath.Join(dir, "stderr.log"),
+		)
+		chrome.SetLogger(socket.NewNopLogger())
+		if err := chrome.Launch(); nil != err {
+			t.Fatalf("%s: expected nil, received error: %v", name, err)
+		}
+		if server.Port() != chrome.Port() {
+			t.Errorf("%s: expected %d, received %d", name, server.Port(), chrome.Port())
+		}
+		if 0 != chrome.DebuggingPort() {
+			t.Errorf("%s: expected 0, received %d", name, chrome.DebuggingPort())
+		}
+		if err := chrome.Close(); nil != err {
+			t.Errorf("%s: expected nil, received error: %v", name, err)
+		}
+	}
+}
+
+func TestChromiumLaunchFreePortDataDir(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "TestChromiumLaunchFreePortDataDir")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The fake binary only reports the port in the DevToolsActivePort file of
+	// its user data directory.
+	binary := fakeChromium(t, dir, fmt.Sprintf(`for arg; do
+	case "$arg" in --user-data-dir=*) DIR="${arg#--user-data-dir=}";; esac
+done
+printf '%%s\n/devtools/browser/browser' %d > "$DIR/DevToolsActivePort"`, server.Port()))
+
+	dataDirs := make([]string, 0)
+	for a := 0; a < 2; a++ {
+		chrome := New(
+			&Flags{
+				"addr":                  server.Address(),
+				"remote-debugging-port": 0,
+			},
+			binary,
+			dir,
+			"",
+			filepath.Join(dir, "stderr.log"),
+		)
+		chrome.SetLogger(socket.NewNopLogger())
+		if err := chrome.Launch(); nil != err {
+			t.Fatalf("Expected nil, received error: %v", err)
+		}
+		defer chrome.Close()
+		dataDir := chrome.userDataDir()
+		if os.TempDir() == dataDir {
+			t.Errorf("Expected a private user data directory, received '%s'", dataDir)
+		}
+		if server.Port() != chrome.Port() {
+			t.Errorf("Expected %d, received %d", server.Port(), chrome.Port())
+		}
+		dataDirs = append(dataDirs, dataDir)
+	}
+	if dataDirs[0] == dataDirs[1] {
+		t.Errorf("Expected a user data directory per instance, received '%s' twice", dataDirs[0])
+	}
+
+	chrome := New(
+		&Flags{
+			"addr":                  server.Address(),
+			"remote-debugging-port": 0,
+		},
+		binary,
+		dir,
+		"",
+		filepath.Join(dir, "stderr.log"),
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	dataDir := chrome.userDataDir()
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if _, err := os.Stat(dataDir); !os.IsNotExist(err) {
+		t.Errorf("Expected the user data directory to be removed, received %v", err)
+	}
+}
@@ -0,0 +1,218 @@
+package chrome
+
+import (
+	"fmt"
+	"net/url"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+SyncTabs implements Chromium.
+
+A tab is added for each existing target that isn't in Tabs yet, such as pages
+opened by Chromium at startup, by window.open or by another client, and the
+tabs of targets that no longer exist are removed. The title and URL in the
+data of the other tabs are updated.
+
+Targets are listed with the /json/list endpoint, or with the browser socket
+when controlled over the remote debugging pipe. Over a websocket, targets
+another client is attached to have no websocket URL and are skipped.
+*/
+func (chrome *Chrome) SyncTabs() error {
+	// Tabs added while the targets are listed are kept.
+	tabs := chrome.Tabs()
+	targets, err := chrome.listTargets()
+	if nil != err {
+		return err
+	}
+
+	listed := make(map[string]bool, len(targets))
+	for _, data := range targets {
+		listed[data.ID] = true
+		if tab := chrome.tab(data.ID); nil != tab {
+			tab.updateData(data.Title, data.URL)
+			continue
+		}
+		if _, adoptErr := chrome.adoptTab(data); nil != adoptErr && nil == err {
+			err = adoptErr
+		}
+	}
+	for _, tab := range tabs {
+		if !listed[tab.Data().ID] {
+			chrome.dropTab(tab)
+		}
+	}
+	return err
+}
+
+/*
+WatchTabs implements Chromium.
+
+Target discovery is enabled on the browser socket and the tabs are synced
+each time a Target.targetCreated, Target.targetDestroyed or
+Target.targetInfoChanged event is received, see SyncTabs. Watching ends when
+the browser socket stops listening.
+*/
+func (chrome *Chrome) WatchTabs() error {
+	browser, err := chrome.Browser()
+	if nil != err {
+		return errs.Wrap(err, 0, "browser socket not available")
+	}
+	chrome.tabsMux.Lock()
+	if chrome.watching {
+		chrome.tabsMux.Unlock()
+		return nil
+	}
+	chrome.watching = true
+	chrome.tabsMux.Unlock()
+
+	// Events are handled concurrently, each one requests a sync of the
+	// targets instead so the order doesn't matter. Requests are coalesced.
+	requests := make(chan struct{}, 1)
+	request := func() {
+		select {
+		case requests <- struct{}{}:
+		default:
+		}
+	}
+	subscriptions := []*socket.Subscription{
+		browser.Target().OnTargetCreated(func(event *target.CreatedEvent) {
+			request()
+		}),
+		browser.Target().OnTargetDestroyed(func(event *target.DestroyedEvent) {
+			if tab := chrome.tab(string(event.ID)); nil != tab {
+				chrome.dropTab(tab)
+			}
+			request()
+		}),
+		browser.Target().OnTargetInfoChanged(func(event *target.InfoChangedEvent) {
+			request()
+		}),
+	}
+
+	result := <-browser.Target().SetDiscoverTargets(&target.SetDiscoverTargetsParams{Discover: true})
+	if nil != result.Err {
+		for _, subscription := range subscriptions {
+			subscription.Unsubscribe()
+		}
+		chrome.tabsMux.Lock()
+		chrome.watching = false
+		chrome.tabsMux.Unlock()
+		return errs.Wrap(result.Err, 0, "could not enable target discovery")
+	}
+	go chrome.watchTabs(browser, requests)
+	return chrome.SyncTabs()
+}
+
+/*
+adoptTab adds a tab for an existing target.
+*/
+func (chrome *Chrome) adoptTab(data *TabData) (*Tab, error) {
+	targetURL, err := url.Parse(data.URL)
+	if nil != err {
+		return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid URL '%s' for target %s", data.URL, data.ID))
+	}
+	if chrome.Flags().Has("remote-debugging-pipe") {
+		return chrome.newSessionTabFor(targetURL, data)
+	}
+	if "" == data.WebSocketDebuggerURL {
+		chrome.Logger().Debugf("target %s is attached to another client", data.ID)
+		return nil, nil
+	}
+	return chrome.newTab(targetURL, data)
+}
+
+/*
+addTab adds a tab unless a tab for the same target exists, and returns the tab
+for the target.
+*/
+func (chrome *Chrome) addTab(tab *Tab) *Tab {
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
+	for _, existing := range chrome.tabs {
+		if existing.Data().ID == tab.Data().ID {
+			return existing
+		}
+	}
+	chrome.tabs = append(chrome.tabs, tab)
+	return tab
+}
+
+/*
+dropTab removes the tab of a target that no longer exists and stops its
+socket.
+*/
+func (chrome *Chrome) dropTab(tab *Tab) {
+	chrome.RemoveTab(tab)
+	go tab.Socket().Stop()
+}
+
+/*
+listTargets returns the data of the existing targets.
+*/
+func (chrome *Chrome) listTargets() ([]*TabData, error) {
+	targets := make([]*TabData, 0)
+	if !chrome.Flags().Has("remote-debugging-pipe") {
+		if _, err := chrome.Query("/json/list", url.Values{}, &targets); nil != err {
+			return nil, errs.Wrap(err, 0, "/json/list query failed")
+		}
+		return targets, nil
+	}
+
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, 0, "browser socket not available")
+	}
+	result := <-browser.Target().GetTargets(nil)
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, 0, "could not list targets")
+	}
+	for _, info := range result.Infos {
+		if "browser" == info.Type {
+			continue
+		}
+		targets = append(targets, &TabData{
+			ID:    string(info.ID),
+			Title: info.Title,
+			Type:  info.Type,
+			URL:   info.URL,
+		})
+	}
+	return targets, nil
+}
+
+/*
+tab returns the tab of a target, nil if there is none.
+*/
+func (chrome *Chrome) tab(targetID string) *Tab {
+	for _, tab := range chrome.Tabs() {
+		if tab.Data().ID == targetID {
+			return tab
+		}
+	}
+	return nil
+}
+
+/*
+watchTabs syncs the tabs for each request until the browser socket stops
+listening.
+*/
+func (chrome *Chrome) watchTabs(browser *socket.Socket, requests <-chan struct{}) {
+	done := browser.Done()
+	for {
+		select {
+		case <-done:
+			chrome.tabsMux.Lock()
+			chrome.watching = false
+			chrome.tabsMux.Unlock()
+			return
+		case <-requests:
+			if err := chrome.SyncTabs(); nil != err {
+				chrome.Logger().Warnf("could not sync tabs: %v", err)
+			}
+		}
+	}
+}
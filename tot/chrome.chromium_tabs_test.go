@@ -0,0 +1,203 @@
+package chrome
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/chrometest"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+waitForTabs waits until the Chromium instance has the expected number of tabs.
+*/
+func waitForTabs(t *testing.T, chrome *Chrome, expected int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if expected == len(chrome.Tabs()) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d tabs, received %d", expected, len(chrome.Tabs()))
+}
+
+func TestChromiumSyncTabs(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	server.NewTarget("about:blank")
+	worker := server.NewTargetType("service_worker", "https://example.com/sw.js")
+	frame := server.NewTargetType("iframe", "https://example.com/frame")
+
+	chrome := New(
+		&Flags{
+			"addr": server.Address(),
+			"port": server.Port(),
+		},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	if _, err := chrome.NewTab("https://example.com"); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if err := chrome.SyncTabs(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 4 != len(chrome.Tabs()) {
+		t.Fatalf("Expected 4 tabs, received %d", len(chrome.Tabs()))
+	}
+	tab, err := chrome.GetTab(frame.ID())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "iframe" != tab.Type() {
+		t.Errorf("Expected 'iframe', received '%s'", tab.Type())
+	}
+	tab, err = chrome.GetTab(worker.ID())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "service_worker" != tab.Type() {
+		t.Errorf("Expected 'service_worker', received '%s'", tab.Type())
+	}
+
+	server.CloseTarget(worker.ID())
+	if err := chrome.SyncTabs(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 3 != len(chrome.Tabs()) {
+		t.Errorf("Expected 3 tabs, received %d", len(chrome.Tabs()))
+	}
+	if _, err := chrome.GetTab(worker.ID()); nil == err {
+		t.Errorf("Expected the closed target to be removed")
+	}
+}
+
+func TestChromiumWatchTabs(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	server.NewTarget("about:blank")
+
+	chrome := New(
+		&Flags{
+			"addr": server.Address(),
+			"port": server.Port(),
+		},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	if err := chrome.WatchTabs(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	waitForTabs(t, chrome, 1)
+
+	opened := server.NewTarget("https://example.com/popup")
+	waitForTabs(t, chrome, 2)
+	if _, err := chrome.GetTab(opened.ID()); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+
+	// A tab created by the client while watching isn't added twice.
+	if _, err := chrome.NewTab("https://example.com"); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	waitForTabs(t, chrome, 3)
+
+	server.CloseTarget(opened.ID())
+	waitForTabs(t, chrome, 2)
+}
+
+func TestChromiumWatchTabsError(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	server.Browser().Handle("Target.setDiscoverTargets", func(target *chrometest.Target, params json.RawMessage) (interface{}, error) {
+		return nil, errors.New("discovery not available")
+	})
+
+	chrome := New(
+		&Flags{
+			"addr": server.Address(),
+			"port": server.Port(),
+		},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	if err := chrome.WatchTabs(); nil == err {
+		t.Fatalf("Expected error, received nil")
+	}
+	if chrome.watching {
+		t.Errorf("Expected watching to be reset after the error")
+	}
+
+	// Watching can be retried once discovery is available.
+	server.Browser().Handle("Target.setDiscoverTargets", nil)
+	if err := chrome.WatchTabs(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	server.NewTarget("https://example.com/popup")
+	waitForTabs(t, chrome, 1)
+}
+
+func TestTabUpdateData(t *testing.T) {
+	tab := &Tab{data: &TabData{ID: "target", Title: "before", URL: "about:blank"}}
+	data := tab.Data()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for a := 0; a < 100; a++ {
+			_ = tab.Data().Title
+		}
+	}()
+	tab.updateData("after", "https://example.com")
+	<-done
+
+	if "before" != data.Title || "about:blank" != data.URL {
+		t.Errorf("Expected the previous data to be unchanged, received %v", data)
+	}
+	if "target" != tab.Data().ID || "after" != tab.Data().Title || "https://example.com" != tab.Data().URL {
+		t.Errorf("Expected the updated data, received %v", tab.Data())
+	}
+}
+
+func TestChromiumSyncTabsPipe(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	page := server.NewTarget("https://example.com")
+
+	chrome := New(
+		&Flags{"remote-debugging-pipe": nil},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	chrome.SetLogger(socket.NewNopLogger())
+	browserURL, _ := url.Parse(server.Browser().WebSocketDebuggerURL())
+	chrome.browser = socket.New(browserURL, chrome.browserOptions()...)
+	defer chrome.browser.Stop()
+
+	if err := chrome.SyncTabs(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	tab, err := chrome.GetTab(page.ID())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "page" != tab.Type() || "https://example.com" != tab.URL().String() {
+		t.Errorf("Expected the page target, received %v", tab.Data())
+	}
+}
@@ -1,13 +1,21 @@
 package chrome
 
 import (
+	"context"
+	"encoding/json"
 	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/chrometest"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/socket"
 )
 
 func TestChromiumNew(t *testing.T) {
+	defer hideBinaries(t)()
 	chrome := New(
 		&Flags{},
 		"", //"path/to/chrome",
@@ -139,3 +147,113 @@ func TestChromiumVersion(t *testing.T) {
 		t.Errorf("Expected nil, received %v", version)
 	}
 }
+
+func TestChromiumSetSocketOptions(t *testing.T) {
+	chrome := New(
+		&Flags{},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	chrome.SetSocketOptions(socket.WithReconnect(&socket.ReconnectPolicy{}))
+	if 1 != len(chrome.socketOptions) {
+		t.Errorf("Expected 1 socket option, received %d", len(chrome.socketOptions))
+	}
+}
+
+func TestChromiumSetLogger(t *testing.T) {
+	chrome := New(
+		&Flags{},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	if nil == chrome.Logger() {
+		t.Errorf("Expected default logger, received nil")
+	}
+	logger := socket.NewNopLogger()
+	chrome.SetLogger(logger)
+	if logger != chrome.Logger() {
+		t.Errorf("Expected logger to be set")
+	}
+}
+
+func TestChromiumPipe(t *testing.T) {
+	chrome := New(
+		&Flags{"remote-debugging-pipe": nil},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	if _, err := chrome.Browser(); nil == err {
+		t.Errorf("Expected error before launch, received nil")
+	}
+	if _, err := chrome.Version(); nil == err {
+		t.Errorf("Expected error before launch, received nil")
+	}
+	if _, err := chrome.NewTab("about:blank"); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+	if "--remote-debugging-pipe" != chrome.Flags().String() {
+		t.Errorf("Expected '--remote-debugging-pipe', received '%s'", chrome.Flags().String())
+	}
+}
+
+func TestChromiumServer(t *testing.T) {
+	server := chrometest.NewServer()
+	defer server.Close()
+	server.Handle("Page.navigate", func(target *chrometest.Target, params json.RawMessage) (interface{}, error) {
+		target.Emit("Page.loadEventFired", map[string]interface{}{"timestamp": 1})
+		return map[string]string{"frameId": "frame-1"}, nil
+	})
+
+	chrome := New(
+		&Flags{
+			"addr": server.Address(),
+			"port": server.Port(),
+		},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	version, err := chrome.Version()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if server.Browser().WebSocketDebuggerURL() != version.WebSocketDebuggerURL {
+		t.Errorf("Expected '%s', received '%s'", server.Browser().WebSocketDebuggerURL(), version.WebSocketDebuggerURL)
+	}
+
+	tab, err := chrome.NewTab("https://example.com")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 1 != len(server.Targets()) || "https://example.com" != server.Targets()[0].URL() {
+		t.Fatalf("Expected a target for the tab, received %v", server.Targets())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	loaded := tab.Page().WaitForLoadEventFired(ctx, nil)
+	result := <-tab.Page().Navigate(&page.NavigateParams{URL: "https://example.com/next"})
+	if nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+	if "frame-1" != string(result.FrameID) {
+		t.Errorf("Expected 'frame-1', received '%s'", result.FrameID)
+	}
+	if event := <-loaded; nil != event.Err {
+		t.Errorf("Expected nil, received error: %v", event.Err)
+	}
+
+	if _, err := tab.Close(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 0 != len(server.Targets()) {
+		t.Errorf("Expected 0 targets, received %d", len(server.Targets()))
+	}
+}
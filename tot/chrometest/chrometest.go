@@ -0,0 +1,59 @@
+/*
+Package chrometest provides an in-process fake of the Chromium DevTools
+endpoints for integration tests.
+
+A Server serves the HTTP endpoints used by Chrome.Query (/json/version,
+/json/list, /json/new and /json/close/{id}) and a websocket for the browser
+and each target, so Chrome, Tab and Socket can be tested end to end over real
+connections without launching a browser:
+
+	server := chrometest.NewServer()
+	defer server.Close()
+
+	server.Handle("Page.navigate", func(target *chrometest.Target, params json.RawMessage) (interface{}, error) {
+		target.Emit("Page.loadEventFired", map[string]interface{}{"timestamp": 1})
+		return map[string]string{"frameId": "frame-1"}, nil
+	})
+
+	browser := chrome.New(&chrome.Flags{
+		"addr": server.Address(),
+		"port": server.Port(),
+	}, "", "", "", "")
+	tab, err := browser.NewTab("https://example.com")
+	...
+
+Commands without a handler receive an empty result. The browser target
+implements the Target domain commands needed to create, close and attach to
+targets with flattened sessions. Browser connections that enable target
+discovery receive Target.targetCreated and Target.targetDestroyed.
+*/
+package chrometest
+
+import (
+	"encoding/json"
+)
+
+/*
+Command is a command received by a target.
+*/
+type Command struct {
+	// ID is the command ID.
+	ID int `json:"id"`
+
+	// Method is the command method, for example "Page.navigate".
+	Method string `json:"method"`
+
+	// Params are the JSON encoded command parameters.
+	Params json.RawMessage `json:"params"`
+
+	// SessionID is the flattened session the command was sent to, if any.
+	SessionID string `json:"sessionId,omitempty"`
+}
+
+/*
+HandlerFunc scripts the response to a command. The returned result is encoded
+as the command result. If an error is returned the command fails instead, a
+*socket.Error is sent as is and any other error is sent with the message of
+the error.
+*/
+type HandlerFunc func(target *Target, params json.RawMessage) (interface{}, error)
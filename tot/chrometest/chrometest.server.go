@@ -0,0 +1,464 @@
+package chrometest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+NewServer starts and returns a fake DevTools server listening on a random
+local port. It should be closed when the test is done.
+*/
+func NewServer() *Server {
+	server := &Server{
+		handlers: make(map[string]HandlerFunc),
+		mux:      &sync.Mutex{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+	}
+	server.browser = server.newTarget("browser", "browser", "")
+	server.httpServer = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+/*
+Server is an in-process fake of the Chromium DevTools endpoints.
+*/
+type Server struct {
+	browser    *Target
+	discover   bool
+	handlers   map[string]HandlerFunc
+	httpServer *httptest.Server
+	mux        *sync.Mutex
+	nextID     int
+	targets    []*Target
+	upgrader   websocket.Upgrader
+}
+
+/*
+Address returns the host the server listens on.
+*/
+func (server *Server) Address() string {
+	host, _, _ := net.SplitHostPort(server.httpServer.Listener.Addr().String())
+	return host
+}
+
+/*
+Browser returns the browser target, the target of the websocket URL returned
+by /json/version.
+*/
+func (server *Server) Browser() *Target {
+	return server.browser
+}
+
+/*
+Close closes all websocket connections and stops the server.
+*/
+func (server *Server) Close() {
+	server.browser.closeConns()
+	for _, tgt := range server.Targets() {
+		tgt.closeConns()
+	}
+	server.httpServer.Close()
+}
+
+/*
+CloseTarget closes a target. Its websocket connections are closed and the
+browser connections attached to it receive Target.detachedFromTarget.
+*/
+func (server *Server) CloseTarget(targetID string) error {
+	server.mux.Lock()
+	var closed *Target
+	for k, tgt := range server.targets {
+		if tgt.ID() == targetID {
+			closed = tgt
+			server.targets = append(server.targets[:k], server.targets[k+1:]...)
+			break
+		}
+	}
+	server.mux.Unlock()
+
+	if nil == closed {
+		return fmt.Errorf("no target with given id found: %s", targetID)
+	}
+	closed.detach()
+	closed.closeConns()
+	server.emitTargetEvent("Target.targetDestroyed", map[string]string{"targetId": targetID})
+	return nil
+}
+
+/*
+Handle sets the handler for a command method on all targets. Handlers set on
+a target take precedence.
+*/
+func (server *Server) Handle(method string, handler HandlerFunc) {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	server.handlers[method] = handler
+}
+
+/*
+NewTarget adds a page target with the specified URL, as if it was opened in
+the browser.
+*/
+func (server *Server) NewTarget(uri string) *Target {
+	return server.NewTargetType("page", uri)
+}
+
+/*
+NewTargetType adds a target of the specified type, such as 'iframe' or
+'service_worker', with the specified URL. Browser connections that enabled
+target discovery receive Target.targetCreated.
+*/
+func (server *Server) NewTargetType(kind, uri string) *Target {
+	server.mux.Lock()
+	server.nextID++
+	id := fmt.Sprintf("target-%d", server.nextID)
+	server.mux.Unlock()
+
+	if "" == uri {
+		uri = "about:blank"
+	}
+	tgt := server.newTarget(id, kind, uri)
+	server.mux.Lock()
+	server.targets = append(server.targets, tgt)
+	server.mux.Unlock()
+	server.emitTargetEvent("Target.targetCreated", map[string]interface{}{"targetInfo": tgt.info()})
+	return tgt
+}
+
+/*
+Port returns the port the server listens on.
+*/
+func (server *Server) Port() int {
+	_, port, _ := net.SplitHostPort(server.httpServer.Listener.Addr().String())
+	value, _ := strconv.Atoi(port)
+	return value
+}
+
+/*
+Target returns the target with the specified ID.
+*/
+func (server *Server) Target(targetID string) (*Target, bool) {
+	for _, tgt := range server.Targets() {
+		if tgt.ID() == targetID {
+			return tgt, true
+		}
+	}
+	return nil, false
+}
+
+/*
+Targets returns the open targets.
+*/
+func (server *Server) Targets() []*Target {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	return append([]*Target{}, server.targets...)
+}
+
+/*
+URL returns the base URL of the server, for example "http://127.0.0.1:41234".
+*/
+func (server *Server) URL() string {
+	return server.httpServer.URL
+}
+
+/*
+builtinFunc implements a command of the browser, client is the connection the
+command was received on.
+*/
+type builtinFunc func(tgt *Target, client *conn, params json.RawMessage) (interface{}, error)
+
+/*
+builtin returns the built-in implementation of a command method, if any.
+*/
+func (server *Server) builtin(method string) builtinFunc {
+	switch method {
+	case "Browser.getVersion":
+		return server.getVersion
+	case "Target.attachToTarget":
+		return server.attachToTarget
+	case "Target.closeTarget":
+		return server.closeTarget
+	case "Target.createTarget":
+		return server.createTarget
+	case "Target.detachFromTarget":
+		return server.detachFromTarget
+	case "Target.getTargets":
+		return server.getTargets
+	case "Target.setDiscoverTargets":
+		return server.setDiscoverTargets
+	}
+	return nil
+}
+
+/*
+emitTargetEvent sends a target lifecycle event to the browser connections once
+target discovery is enabled.
+*/
+func (server *Server) emitTargetEvent(method string, params interface{}) {
+	server.mux.Lock()
+	discover := server.discover
+	server.mux.Unlock()
+	if discover {
+		server.browser.Emit(method, params)
+	}
+}
+
+/*
+handler returns the handler set on the server for a command method, if any.
+*/
+func (server *Server) handler(method string) HandlerFunc {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	return server.handlers[method]
+}
+
+/*
+newTarget returns a new target served by the server.
+*/
+func (server *Server) newTarget(id, kind, uri string) *Target {
+	return &Target{
+		handlers: make(map[string]HandlerFunc),
+		id:       id,
+		kind:     kind,
+		mux:      &sync.Mutex{},
+		server:   server,
+		sessions: make(map[string]*conn),
+		url:      uri,
+	}
+}
+
+/*
+serveHTTP serves the DevTools HTTP endpoints and target websockets.
+*/
+func (server *Server) serveHTTP(writer http.ResponseWriter, request *http.Request) {
+	path := request.URL.Path
+	switch {
+	case "/json/version" == path:
+		writeJSON(writer, map[string]string{
+			"Browser":              "HeadlessChrome/0.0.0.0",
+			"Protocol-Version":     "1.3",
+			"User-Agent":           "Mozilla/5.0 (chrometest) HeadlessChrome/0.0.0.0",
+			"V8-Version":           "0.0.0.0",
+			"WebKit-Version":       "537.36",
+			"webSocketDebuggerUrl": server.browser.WebSocketDebuggerURL(),
+		})
+
+	case "/json" == path || "/json/list" == path:
+		list := make([]map[string]string, 0)
+		for _, tgt := range server.Targets() {
+			list = append(list, tgt.data())
+		}
+		writeJSON(writer, list)
+
+	case "/json/new" == path:
+		uri, err := url.QueryUnescape(request.URL.RawQuery)
+		if nil != err {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(writer, server.NewTarget(uri).data())
+
+	case strings.HasPrefix(path, "/json/activate/"):
+		if _, ok := server.Target(strings.TrimPrefix(path, "/json/activate/")); !ok {
+			http.Error(writer, "No such target id", http.StatusNotFound)
+			return
+		}
+		writer.Write([]byte("Target activated"))
+
+	case strings.HasPrefix(path, "/json/close/"):
+		if err := server.CloseTarget(strings.TrimPrefix(path, "/json/close/")); nil != err {
+			http.Error(writer, "No such target id", http.StatusNotFound)
+			return
+		}
+		writer.Write([]byte("Target is closing"))
+
+	case "/devtools/browser/"+server.browser.ID() == path:
+		server.serveWebsocket(writer, request, server.browser)
+
+	case strings.HasPrefix(path, "/devtools/page/"):
+		tgt, ok := server.Target(strings.TrimPrefix(path, "/devtools/page/"))
+		if !ok {
+			http.NotFound(writer, request)
+			return
+		}
+		server.serveWebsocket(writer, request, tgt)
+
+	default:
+		http.NotFound(writer, request)
+	}
+}
+
+/*
+serveWebsocket upgrades the request and dispatches the commands read from the
+connection until it is closed.
+*/
+func (server *Server) serveWebsocket(writer http.ResponseWriter, request *http.Request, tgt *Target) {
+	wsConn, err := server.upgrader.Upgrade(writer, request, nil)
+	if nil != err {
+		return
+	}
+	client := &conn{conn: wsConn, mux: &sync.Mutex{}}
+	tgt.connect(client)
+	defer func() {
+		tgt.disconnect(client)
+		for _, page := range server.Targets() {
+			page.detachConn(client)
+		}
+	}()
+
+	for {
+		_, data, err := wsConn.ReadMessage()
+		if nil != err {
+			return
+		}
+		command := &Command{}
+		if err := json.Unmarshal(data, command); nil != err {
+			continue
+		}
+		if "" == command.SessionID {
+			tgt.dispatch(client, command)
+			continue
+		}
+		if session := server.session(command.SessionID); nil != session {
+			session.dispatch(client, command)
+			continue
+		}
+		client.write(&socket.Response{
+			Error: &socket.Error{
+				Code:    -32001,
+				Message: fmt.Sprintf("Session with given id not found: %s", command.SessionID),
+			},
+			ID:        command.ID,
+			SessionID: command.SessionID,
+		})
+	}
+}
+
+/*
+session returns the target attached to a flattened session.
+*/
+func (server *Server) session(sessionID string) *Target {
+	for _, tgt := range server.Targets() {
+		if tgt.hasSession(sessionID) {
+			return tgt
+		}
+	}
+	return nil
+}
+
+/*
+attachToTarget implements Target.attachToTarget. Only flattened sessions are
+supported, commands and events of the session are sent over the browser
+connection.
+*/
+func (server *Server) attachToTarget(tgt *Target, client *conn, params json.RawMessage) (interface{}, error) {
+	attach := &target.AttachToTargetParams{}
+	json.Unmarshal(params, attach)
+	attached, ok := server.Target(string(attach.ID))
+	if !ok {
+		return nil, &socket.Error{Code: -32602, Message: fmt.Sprintf("No target with given id found: %s", attach.ID)}
+	}
+
+	server.mux.Lock()
+	server.nextID++
+	sessionID := fmt.Sprintf("session-%d", server.nextID)
+	server.mux.Unlock()
+
+	attached.attach(sessionID, client)
+	return &target.AttachToTargetResult{SessionID: target.SessionID(sessionID)}, nil
+}
+
+/*
+closeTarget implements Target.closeTarget.
+*/
+func (server *Server) closeTarget(tgt *Target, client *conn, params json.RawMessage) (interface{}, error) {
+	closeParams := &target.CloseTargetParams{}
+	json.Unmarshal(params, closeParams)
+	if err := server.CloseTarget(string(closeParams.ID)); nil != err {
+		return nil, &socket.Error{Code: -32602, Message: err.Error()}
+	}
+	return &target.CloseTargetResult{Success: true}, nil
+}
+
+/*
+createTarget implements Target.createTarget.
+*/
+func (server *Server) createTarget(tgt *Target, client *conn, params json.RawMessage) (interface{}, error) {
+	create := &target.CreateTargetParams{}
+	json.Unmarshal(params, create)
+	created := server.NewTarget(create.URL)
+	return &target.CreateTargetResult{ID: target.ID(created.ID())}, nil
+}
+
+/*
+detachFromTarget implements Target.detachFromTarget.
+*/
+func (server *Server) detachFromTarget(tgt *Target, client *conn, params json.RawMessage) (interface{}, error) {
+	detach := &target.DetachFromTargetParams{}
+	json.Unmarshal(params, detach)
+	detached := server.session(string(detach.SessionID))
+	if nil == detached {
+		return nil, &socket.Error{Code: -32602, Message: fmt.Sprintf("No session with given id found: %s", detach.SessionID)}
+	}
+	detached.detachSession(string(detach.SessionID))
+	return map[string]interface{}{}, nil
+}
+
+/*
+getTargets implements Target.getTargets.
+*/
+func (server *Server) getTargets(tgt *Target, client *conn, params json.RawMessage) (interface{}, error) {
+	infos := make([]*target.Info, 0)
+	for _, page := range server.Targets() {
+		infos = append(infos, page.info())
+	}
+	return map[string]interface{}{"targetInfos": infos}, nil
+}
+
+/*
+getVersion implements Browser.getVersion.
+*/
+func (server *Server) getVersion(tgt *Target, client *conn, params json.RawMessage) (interface{}, error) {
+	return map[string]string{
+		"protocolVersion": "1.3",
+		"product":         "HeadlessChrome/0.0.0.0",
+		"revision":        "@0",
+		"userAgent":       "Mozilla/5.0 (chrometest) HeadlessChrome/0.0.0.0",
+		"jsVersion":       "0.0.0.0",
+	}, nil
+}
+
+/*
+writeJSON writes a JSON encoded HTTP response.
+*/
+func writeJSON(writer http.ResponseWriter, v interface{}) {
+	writer.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(writer).Encode(v)
+}
+
+/*
+setDiscoverTargets implements Target.setDiscoverTargets.
+*/
+func (server *Server) setDiscoverTargets(tgt *Target, client *conn, params json.RawMessage) (interface{}, error) {
+	discover := &target.SetDiscoverTargetsParams{}
+	json.Unmarshal(params, discover)
+	server.mux.Lock()
+	server.discover = discover.Discover
+	server.mux.Unlock()
+	return map[string]interface{}{}, nil
+}
@@ -0,0 +1,182 @@
+package chrometest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+func TestServerQuery(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL() + "/json/new?" + url.QueryEscape("https://example.com/?a=b"))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	created := map[string]string{}
+	json.NewDecoder(resp.Body).Decode(&created)
+	resp.Body.Close()
+	if "https://example.com/?a=b" != created["url"] {
+		t.Errorf("Expected 'https://example.com/?a=b', received '%s'", created["url"])
+	}
+
+	resp, err = http.Get(server.URL() + "/json/list")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	list := []map[string]string{}
+	json.NewDecoder(resp.Body).Decode(&list)
+	resp.Body.Close()
+	if 1 != len(list) || created["id"] != list[0]["id"] {
+		t.Fatalf("Expected the new target to be listed, received %v", list)
+	}
+
+	resp, _ = http.Get(server.URL() + "/json/close/" + created["id"])
+	resp.Body.Close()
+	if http.StatusOK != resp.StatusCode {
+		t.Errorf("Expected 200, received %d", resp.StatusCode)
+	}
+	resp, _ = http.Get(server.URL() + "/json/close/" + created["id"])
+	resp.Body.Close()
+	if http.StatusNotFound != resp.StatusCode {
+		t.Errorf("Expected 404, received %d", resp.StatusCode)
+	}
+	if 0 != len(server.Targets()) {
+		t.Errorf("Expected 0 targets, received %d", len(server.Targets()))
+	}
+}
+
+func TestServerTarget(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle("Page.navigate", func(target *Target, params json.RawMessage) (interface{}, error) {
+		target.Emit("Page.frameStartedLoading", map[string]string{"frameId": "frame-1"})
+		return map[string]string{"frameId": "frame-1"}, nil
+	})
+	page := server.NewTarget("about:blank")
+	page.Handle("Page.reload", func(target *Target, params json.RawMessage) (interface{}, error) {
+		return nil, errors.New("reload failed")
+	})
+
+	socketURL, _ := url.Parse(page.WebSocketDebuggerURL())
+	pageSocket := socket.New(socketURL)
+	defer pageSocket.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := socket.RawEvents(ctx, pageSocket, "Page.frameStartedLoading")
+
+	result := struct {
+		FrameID string `json:"frameId"`
+	}{}
+	err := pageSocket.Call(ctx, "Page.navigate", map[string]string{"url": "https://example.com"}, &result)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "frame-1" != result.FrameID {
+		t.Errorf("Expected 'frame-1', received '%s'", result.FrameID)
+	}
+	select {
+	case event := <-events:
+		if nil == event || "Page.frameStartedLoading" != event.Method {
+			t.Errorf("Expected the emitted event, received %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+
+	err = pageSocket.Call(ctx, "Page.reload", nil, nil)
+	protocolErr, ok := err.(*socket.Error)
+	if !ok || "reload failed" != protocolErr.Message {
+		t.Errorf("Expected the handler error, received %v", err)
+	}
+
+	commands := page.Commands()
+	if 2 != len(commands) || "Page.navigate" != commands[0].Method {
+		t.Fatalf("Expected 2 commands, received %v", commands)
+	}
+	if `{"url":"https://example.com"}` != string(commands[0].Params) {
+		t.Errorf("Expected the command params, received %s", commands[0].Params)
+	}
+}
+
+func TestServerSession(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	socketURL, _ := url.Parse(server.Browser().WebSocketDebuggerURL())
+	browser := socket.New(socketURL)
+	defer browser.Stop()
+
+	created := <-browser.Target().CreateTarget(nil)
+	if nil != created.Err {
+		t.Fatalf("Expected nil, received error: %v", created.Err)
+	}
+	page, ok := server.Target(string(created.ID))
+	if !ok {
+		t.Fatalf("Expected target '%s' to exist", created.ID)
+	}
+	page.Emit("Page.loadEventFired", map[string]int{"timestamp": 1})
+
+	session, err := browser.AttachToTarget(created.ID)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := session.Call(ctx, "Page.enable", nil, nil); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	commands := page.Commands()
+	if 1 != len(commands) || session.SessionID() != commands[0].SessionID {
+		t.Errorf("Expected the command to be sent to the session, received %v", commands)
+	}
+
+	if err := server.CloseTarget(page.ID()); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Session did not stop")
+	}
+}
+
+func TestServerDiscoverTargets(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	socketURL, _ := url.Parse(server.Browser().WebSocketDebuggerURL())
+	browser := socket.New(socketURL)
+	defer browser.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	created := browser.Target().TargetCreatedEvents(ctx)
+	destroyed := browser.Target().TargetDestroyedEvents(ctx)
+	if result := <-browser.Target().SetDiscoverTargets(&target.SetDiscoverTargetsParams{Discover: true}); nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+
+	worker := server.NewTargetType("service_worker", "https://example.com/sw.js")
+	if event := <-created; nil == event || "service_worker" != event.Info.Type {
+		t.Fatalf("Expected Target.targetCreated for the worker, received %v", event)
+	}
+	if !strings.HasSuffix(worker.WebSocketDebuggerURL(), "/devtools/page/"+worker.ID()) {
+		t.Errorf("Expected the worker to be served under /devtools/page/, received '%s'", worker.WebSocketDebuggerURL())
+	}
+	server.CloseTarget(worker.ID())
+	if event := <-destroyed; nil == event || worker.ID() != string(event.ID) {
+		t.Errorf("Expected Target.targetDestroyed for the worker, received %v", event)
+	}
+}
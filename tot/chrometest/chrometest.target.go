@@ -0,0 +1,330 @@
+package chrometest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+Target is a browser or page target served by a Server.
+*/
+type Target struct {
+	commands []*Command
+	conns    []*conn
+	handlers map[string]HandlerFunc
+	id       string
+	kind     string
+	mux      *sync.Mutex
+	pending  []*socket.Response
+	server   *Server
+	sessions map[string]*conn
+	url      string
+}
+
+/*
+Commands returns the commands the target received, in order.
+*/
+func (tgt *Target) Commands() []*Command {
+	tgt.mux.Lock()
+	defer tgt.mux.Unlock()
+	return append([]*Command{}, tgt.commands...)
+}
+
+/*
+Emit sends an event to the websocket connections of the target and the
+flattened sessions attached to it. If the target has no connection yet the
+event is sent to the first one.
+*/
+func (tgt *Target) Emit(method string, params interface{}) error {
+	data, err := json.Marshal(params)
+	if nil != err {
+		return err
+	}
+	event := &socket.Response{Method: method, Params: data}
+
+	tgt.mux.Lock()
+	if 0 == len(tgt.conns) && 0 == len(tgt.sessions) {
+		tgt.pending = append(tgt.pending, event)
+		tgt.mux.Unlock()
+		return nil
+	}
+	conns := append([]*conn{}, tgt.conns...)
+	sessions := make(map[string]*conn)
+	for sessionID, client := range tgt.sessions {
+		sessions[sessionID] = client
+	}
+	tgt.mux.Unlock()
+
+	for _, client := range conns {
+		if err := client.write(event); nil != err {
+			return err
+		}
+	}
+	for sessionID, client := range sessions {
+		sessionEvent := *event
+		sessionEvent.SessionID = sessionID
+		if err := client.write(&sessionEvent); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Handle sets the handler for a command method on this target.
+*/
+func (tgt *Target) Handle(method string, handler HandlerFunc) {
+	tgt.mux.Lock()
+	defer tgt.mux.Unlock()
+	tgt.handlers[method] = handler
+}
+
+/*
+ID returns the target ID.
+*/
+func (tgt *Target) ID() string {
+	return tgt.id
+}
+
+/*
+Type returns the target type, "browser" or "page".
+*/
+func (tgt *Target) Type() string {
+	return tgt.kind
+}
+
+/*
+URL returns the URL of the target.
+*/
+func (tgt *Target) URL() string {
+	return tgt.url
+}
+
+/*
+WebSocketDebuggerURL returns the websocket URL of the target. Like Chromium,
+all targets but the browser are served under /devtools/page/.
+*/
+func (tgt *Target) WebSocketDebuggerURL() string {
+	kind := "page"
+	if "browser" == tgt.kind {
+		kind = "browser"
+	}
+	return fmt.Sprintf("ws://%s/devtools/%s/%s", tgt.server.httpServer.Listener.Addr(), kind, tgt.id)
+}
+
+/*
+attach attaches a flattened session on a browser connection to the target.
+*/
+func (tgt *Target) attach(sessionID string, client *conn) {
+	tgt.mux.Lock()
+	defer tgt.mux.Unlock()
+	tgt.sessions[sessionID] = client
+}
+
+/*
+closeConns closes the websocket connections of the target.
+*/
+func (tgt *Target) closeConns() {
+	tgt.mux.Lock()
+	conns := append([]*conn{}, tgt.conns...)
+	tgt.mux.Unlock()
+	for _, client := range conns {
+		client.conn.Close()
+	}
+}
+
+/*
+connect adds a websocket connection to the target.
+*/
+func (tgt *Target) connect(client *conn) {
+	tgt.mux.Lock()
+	tgt.conns = append(tgt.conns, client)
+	tgt.mux.Unlock()
+	tgt.flush()
+}
+
+/*
+data returns the /json/list entry of the target.
+*/
+func (tgt *Target) data() map[string]string {
+	return map[string]string{
+		"description":          "",
+		"devtoolsFrontendUrl":  "",
+		"id":                   tgt.id,
+		"title":                tgt.url,
+		"type":                 tgt.kind,
+		"url":                  tgt.url,
+		"webSocketDebuggerUrl": tgt.WebSocketDebuggerURL(),
+	}
+}
+
+/*
+detach detaches all flattened sessions from the target.
+*/
+func (tgt *Target) detach() {
+	tgt.mux.Lock()
+	sessionIDs := make([]string, 0, len(tgt.sessions))
+	for sessionID := range tgt.sessions {
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+	tgt.mux.Unlock()
+	for _, sessionID := range sessionIDs {
+		tgt.detachSession(sessionID)
+	}
+}
+
+/*
+detachConn removes the flattened sessions of a closed browser connection.
+*/
+func (tgt *Target) detachConn(client *conn) {
+	tgt.mux.Lock()
+	defer tgt.mux.Unlock()
+	for sessionID, sessionConn := range tgt.sessions {
+		if sessionConn == client {
+			delete(tgt.sessions, sessionID)
+		}
+	}
+}
+
+/*
+detachSession detaches a flattened session from the target and sends
+Target.detachedFromTarget to the browser connection.
+*/
+func (tgt *Target) detachSession(sessionID string) {
+	tgt.mux.Lock()
+	client, ok := tgt.sessions[sessionID]
+	delete(tgt.sessions, sessionID)
+	tgt.mux.Unlock()
+	if !ok {
+		return
+	}
+	params, _ := json.Marshal(&target.DetachedFromTargetEvent{
+		SessionID: target.SessionID(sessionID),
+		ID:        target.ID(tgt.id),
+	})
+	client.write(&socket.Response{Method: "Target.detachedFromTarget", Params: params})
+}
+
+/*
+disconnect removes a closed websocket connection from the target.
+*/
+func (tgt *Target) disconnect(client *conn) {
+	tgt.mux.Lock()
+	defer tgt.mux.Unlock()
+	for k, targetConn := range tgt.conns {
+		if targetConn == client {
+			tgt.conns = append(tgt.conns[:k], tgt.conns[k+1:]...)
+			break
+		}
+	}
+}
+
+/*
+dispatch records a command, runs its handler and writes the response to the
+connection the command was received on.
+*/
+func (tgt *Target) dispatch(client *conn, command *Command) {
+	tgt.mux.Lock()
+	tgt.commands = append(tgt.commands, command)
+	handler := tgt.handlers[command.Method]
+	tgt.mux.Unlock()
+	if nil == handler {
+		handler = tgt.server.handler(command.Method)
+	}
+
+	var result interface{}
+	var err error
+	if nil != handler {
+		result, err = handler(tgt, command.Params)
+	} else if builtin := tgt.server.builtin(command.Method); nil != builtin {
+		result, err = builtin(tgt, client, command.Params)
+	} else {
+		result = map[string]interface{}{}
+	}
+
+	response := &socket.Response{
+		ID:        command.ID,
+		SessionID: command.SessionID,
+	}
+	if nil == err {
+		response.Result, err = json.Marshal(result)
+	}
+	if nil != err {
+		protocolErr, ok := err.(*socket.Error)
+		if !ok {
+			protocolErr = &socket.Error{Code: -32000, Message: err.Error()}
+		}
+		response.Error = protocolErr
+		response.Result = nil
+	}
+	client.write(response)
+
+	// Pending events of an attached target are sent once the client knows
+	// the session.
+	if attached, ok := result.(*target.AttachToTargetResult); ok && nil == err {
+		if session := tgt.server.session(string(attached.SessionID)); nil != session {
+			session.flush()
+		}
+	}
+}
+
+/*
+flush sends the events emitted before the target had a connection.
+*/
+func (tgt *Target) flush() {
+	tgt.mux.Lock()
+	pending := tgt.pending
+	tgt.pending = nil
+	tgt.mux.Unlock()
+	for _, event := range pending {
+		tgt.Emit(event.Method, event.Params)
+	}
+}
+
+/*
+hasSession returns whether a flattened session is attached to the target.
+*/
+func (tgt *Target) hasSession(sessionID string) bool {
+	tgt.mux.Lock()
+	defer tgt.mux.Unlock()
+	_, ok := tgt.sessions[sessionID]
+	return ok
+}
+
+/*
+info returns the Target.getTargets entry of the target.
+*/
+func (tgt *Target) info() *target.Info {
+	tgt.mux.Lock()
+	defer tgt.mux.Unlock()
+	return &target.Info{
+		ID:       target.ID(tgt.id),
+		Type:     tgt.kind,
+		Title:    tgt.url,
+		URL:      tgt.url,
+		Attached: len(tgt.conns) > 0 || len(tgt.sessions) > 0,
+	}
+}
+
+/*
+conn is a websocket connection to the server. Writes are serialized, events
+can be emitted while a command is dispatched.
+*/
+type conn struct {
+	conn *websocket.Conn
+	mux  *sync.Mutex
+}
+
+/*
+write writes a JSON message to the connection.
+*/
+func (client *conn) write(v interface{}) error {
+	client.mux.Lock()
+	defer client.mux.Unlock()
+	return client.conn.WriteJSON(v)
+}
@@ -0,0 +1,21 @@
+package inspector
+
+/*
+DisableResult represents the result of calls to Inspector.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to Inspector.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
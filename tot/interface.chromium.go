@@ -1,6 +1,11 @@
 package chrome
 
-import "net/url"
+import (
+	"net/url"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
 
 /*
 Chromium defines an interface for interacting with Chromium based web browsers
@@ -14,7 +19,14 @@ type Chromium interface {
 	// default value such as '/usr/bin/google-chrome'.
 	Binary() string
 
-	// Close ends the Chromium process and cleans up.
+	// Browser returns the socket connected to the browser target. When
+	// Chromium was launched with the remote-debugging-pipe flag the socket
+	// communicates over the pipe, otherwise it is connected to the websocket
+	// URL reported by the version endpoint.
+	Browser() (*socket.Socket, error)
+
+	// Close ends the Chromium process and cleans up. A browser that isn't
+	// owned by the instance is disconnected instead.
 	Close() error
 
 	// GetTab returns an open Tabber instance, or an error if the requested tab
@@ -29,7 +41,8 @@ type Chromium interface {
 	DebuggingAddress() string
 
 	// DebuggingPort is the port number that the remote debugging protocol is
-	// available on. Should return a sane default value such as 9222.
+	// available on. Should return a sane default value such as 9222. A value of
+	// 0 lets Chromium select a free port when it is launched.
 	DebuggingPort() int
 
 	// Args returns a ChromiumFlags interface used to define and manage CLI
@@ -41,6 +54,13 @@ type Chromium interface {
 	// struct.
 	Launch() error
 
+	// Logger returns the logger used by the Chromium instance.
+	Logger() socket.Logger
+
+	// MinVersion returns the oldest Chromium major version Launch accepts.
+	// Should return a sane default value such as 73.
+	MinVersion() int
+
 	// NewTab spawns a new tab and returns a reference to it.
 	NewTab(url string) (*Tab, error)
 
@@ -52,18 +72,48 @@ type Chromium interface {
 	// provided struct.
 	Query(path string, params url.Values, msg interface{}) (interface{}, error)
 
+	// SetLogger sets the logger used by the Chromium instance and the socket
+	// connection of each new tab.
+	SetLogger(logger socket.Logger)
+
+	// SetMinVersion sets the oldest Chromium major version Launch accepts. A
+	// negative version disables the version check, including for binaries
+	// that don't report their version.
+	SetMinVersion(version int)
+
+	// SetSocketOptions sets the options used to configure the socket
+	// connection of each new tab.
+	SetSocketOptions(options ...socket.Option)
+
+	// SetStartupTimeout sets how long Launch waits for Chromium to open the
+	// remote debugging endpoint.
+	SetStartupTimeout(timeout time.Duration)
+
+	// StartupTimeout returns how long Launch waits for Chromium to open the
+	// remote debugging endpoint. Should return a sane default value such as 10
+	// seconds.
+	StartupTimeout() time.Duration
+
 	// STDERR returns a string defining the location to write STDERR output.
 	STDERR() string
 
 	// STDOUT returns a string defining the location to write STDOUT output.
 	STDOUT() string
 
+	// SyncTabs adds a tab for each existing target that isn't in the tabs list
+	// yet and removes the tabs of targets that no longer exist.
+	SyncTabs() error
+
 	// Tabs returns the list of the currently open tabs.
 	Tabs() []*Tab
 
 	// Version returns Chromium version data.
 	Version() (*Version, error)
 
+	// WatchTabs keeps the tabs list in sync with the browser targets as they
+	// are created and destroyed.
+	WatchTabs() error
+
 	// Workdir returns the path of the Chromium working directory. Should return
 	// a sane default value such as '/tmp/headless-chrome'.
 	Workdir() string
@@ -1,6 +1,8 @@
 package chrome
 
 import (
+	"context"
+
 	"github.com/mkenney/go-chrome/tot/socket"
 )
 
@@ -12,10 +14,22 @@ type Socketer interface {
 	// event.
 	AddEventHandler(handler socket.EventHandler)
 
+	// Batch returns an empty command batch for sending many independent
+	// commands at once.
+	Batch() *socket.Batch
+
+	// Call sends a command for any protocol method, waits for the response
+	// and decodes the result into the provided value.
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+
 	// RemoveEventHandler removes a handler from the stack of listeners for an
 	// event.
 	RemoveEventHandler(handler socket.EventHandler)
 
 	// SendCommand delivers a command payload to the websocket connection.
 	SendCommand(command socket.Commander) *socket.Payload
+
+	// SendCommandContext delivers a command payload to the websocket
+	// connection and abandons the command if the context is done first.
+	SendCommandContext(ctx context.Context, command socket.Commander) chan *socket.Response
 }
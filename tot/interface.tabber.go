@@ -19,12 +19,20 @@ type Tabber interface {
 	// Data returns the tab metadata
 	Data() *TabData
 
+	// Err returns the error that ended the tab's debugging session, for
+	// example socket.ErrTargetCrashed, or nil while the tab is usable
+	Err() error
+
 	// Protocol returns the socket.Protocoller interface for this tab
 	Protocol() socket.Protocoller
 
 	// Socket returns the socket.Socketer interface for this tab
 	Socket() socket.Socketer
 
+	// Type returns the target type of this tab, such as 'page', 'iframe',
+	// 'service_worker' or 'background_page'
+	Type() string
+
 	// URL returns the URL of the websocket connection
 	URL() *url.URL
 }
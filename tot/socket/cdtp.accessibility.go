@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/accessibility"
@@ -25,14 +26,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-get
 */
 func (protocol *AccessibilityProtocol) GetPartialAXTree(
 	params *accessibility.PartialAXTreeParams,
+) <-chan *accessibility.PartialAXTreeResult {
+	return protocol.GetPartialAXTreeContext(context.Background(), params)
+}
+
+/*
+GetPartialAXTreeContext is the context.Context-aware version of
+GetPartialAXTree.
+*/
+func (protocol *AccessibilityProtocol) GetPartialAXTreeContext(
+	ctx context.Context,
+	params *accessibility.PartialAXTreeParams,
 ) <-chan *accessibility.PartialAXTreeResult {
 	resultChan := make(chan *accessibility.PartialAXTreeResult)
 	command := NewCommand(protocol.Socket, "Accessibility.getPartialAXTree", params)
 	result := &accessibility.PartialAXTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/animation"
@@ -21,13 +22,24 @@ Disable animation domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-disable
 */
 func (protocol *AnimationProtocol) Disable() <-chan *animation.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *AnimationProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *animation.DisableResult {
 	resultChan := make(chan *animation.DisableResult)
 	command := NewCommand(protocol.Socket, "Animation.disable", nil)
 	result := &animation.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,13 +55,24 @@ Enable animation domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-enable
 */
 func (protocol *AnimationProtocol) Enable() <-chan *animation.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *AnimationProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *animation.EnableResult {
 	resultChan := make(chan *animation.EnableResult)
 	command := NewCommand(protocol.Socket, "Animation.enable", nil)
 	result := &animation.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -66,14 +89,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getCurr
 */
 func (protocol *AnimationProtocol) GetCurrentTime(
 	params *animation.GetCurrentTimeParams,
+) <-chan *animation.GetCurrentTimeResult {
+	return protocol.GetCurrentTimeContext(context.Background(), params)
+}
+
+/*
+GetCurrentTimeContext is the context.Context-aware version of GetCurrentTime.
+*/
+func (protocol *AnimationProtocol) GetCurrentTimeContext(
+	ctx context.Context,
+	params *animation.GetCurrentTimeParams,
 ) <-chan *animation.GetCurrentTimeResult {
 	resultChan := make(chan *animation.GetCurrentTimeResult)
 	command := NewCommand(protocol.Socket, "Animation.getCurrentTime", params)
 	result := &animation.GetCurrentTimeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -91,13 +126,24 @@ GetPlaybackRate gets the playback rate of the document timeline.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getPlaybackRate
 */
 func (protocol *AnimationProtocol) GetPlaybackRate() <-chan *animation.GetPlaybackRateResult {
+	return protocol.GetPlaybackRateContext(context.Background())
+}
+
+/*
+GetPlaybackRateContext is the context.Context-aware version of GetPlaybackRate.
+*/
+func (protocol *AnimationProtocol) GetPlaybackRateContext(
+	ctx context.Context,
+) <-chan *animation.GetPlaybackRateResult {
 	resultChan := make(chan *animation.GetPlaybackRateResult)
 	command := NewCommand(protocol.Socket, "Animation.getPlaybackRate", nil)
 	result := &animation.GetPlaybackRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -116,14 +162,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-release
 */
 func (protocol *AnimationProtocol) ReleaseAnimations(
 	params *animation.ReleaseAnimationsParams,
+) <-chan *animation.ReleaseAnimationsResult {
+	return protocol.ReleaseAnimationsContext(context.Background(), params)
+}
+
+/*
+ReleaseAnimationsContext is the context.Context-aware version of
+ReleaseAnimations.
+*/
+func (protocol *AnimationProtocol) ReleaseAnimationsContext(
+	ctx context.Context,
+	params *animation.ReleaseAnimationsParams,
 ) <-chan *animation.ReleaseAnimationsResult {
 	resultChan := make(chan *animation.ReleaseAnimationsResult)
 	command := NewCommand(protocol.Socket, "Animation.releaseAnimations", params)
 	result := &animation.ReleaseAnimationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -140,14 +199,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-resolve
 */
 func (protocol *AnimationProtocol) ResolveAnimation(
 	params *animation.ResolveAnimationParams,
+) <-chan *animation.ResolveAnimationResult {
+	return protocol.ResolveAnimationContext(context.Background(), params)
+}
+
+/*
+ResolveAnimationContext is the context.Context-aware version of
+ResolveAnimation.
+*/
+func (protocol *AnimationProtocol) ResolveAnimationContext(
+	ctx context.Context,
+	params *animation.ResolveAnimationParams,
 ) <-chan *animation.ResolveAnimationResult {
 	resultChan := make(chan *animation.ResolveAnimationResult)
 	command := NewCommand(protocol.Socket, "Animation.resolveAnimation", params)
 	result := &animation.ResolveAnimationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -167,14 +239,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-seekAni
 */
 func (protocol *AnimationProtocol) SeekAnimations(
 	params *animation.SeekAnimationsParams,
+) <-chan *animation.SeekAnimationsResult {
+	return protocol.SeekAnimationsContext(context.Background(), params)
+}
+
+/*
+SeekAnimationsContext is the context.Context-aware version of SeekAnimations.
+*/
+func (protocol *AnimationProtocol) SeekAnimationsContext(
+	ctx context.Context,
+	params *animation.SeekAnimationsParams,
 ) <-chan *animation.SeekAnimationsResult {
 	resultChan := make(chan *animation.SeekAnimationsResult)
 	command := NewCommand(protocol.Socket, "Animation.seekAnimations", params)
 	result := &animation.SeekAnimationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -191,14 +275,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPaus
 */
 func (protocol *AnimationProtocol) SetPaused(
 	params *animation.SetPausedParams,
+) <-chan *animation.SetPausedResult {
+	return protocol.SetPausedContext(context.Background(), params)
+}
+
+/*
+SetPausedContext is the context.Context-aware version of SetPaused.
+*/
+func (protocol *AnimationProtocol) SetPausedContext(
+	ctx context.Context,
+	params *animation.SetPausedParams,
 ) <-chan *animation.SetPausedResult {
 	resultChan := make(chan *animation.SetPausedResult)
 	command := NewCommand(protocol.Socket, "Animation.setPaused", params)
 	result := &animation.SetPausedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -215,14 +311,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPlay
 */
 func (protocol *AnimationProtocol) SetPlaybackRate(
 	params *animation.SetPlaybackRateParams,
+) <-chan *animation.SetPlaybackRateResult {
+	return protocol.SetPlaybackRateContext(context.Background(), params)
+}
+
+/*
+SetPlaybackRateContext is the context.Context-aware version of SetPlaybackRate.
+*/
+func (protocol *AnimationProtocol) SetPlaybackRateContext(
+	ctx context.Context,
+	params *animation.SetPlaybackRateParams,
 ) <-chan *animation.SetPlaybackRateResult {
 	resultChan := make(chan *animation.SetPlaybackRateResult)
 	command := NewCommand(protocol.Socket, "Animation.setPlaybackRate", params)
 	result := &animation.SetPlaybackRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -239,14 +347,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setTimi
 */
 func (protocol *AnimationProtocol) SetTiming(
 	params *animation.SetTimingParams,
+) <-chan *animation.SetTimingResult {
+	return protocol.SetTimingContext(context.Background(), params)
+}
+
+/*
+SetTimingContext is the context.Context-aware version of SetTiming.
+*/
+func (protocol *AnimationProtocol) SetTimingContext(
+	ctx context.Context,
+	params *animation.SetTimingParams,
 ) <-chan *animation.SetTimingResult {
 	resultChan := make(chan *animation.SetTimingResult)
 	command := NewCommand(protocol.Socket, "Animation.setTiming", params)
 	result := &animation.SetTimingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -264,7 +384,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animatio
 */
 func (protocol *AnimationProtocol) OnAnimationCanceled(
 	callback func(event *animation.CanceledEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Animation.animationCanceled",
+		func(response *Response) {
+			event := &animation.CanceledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AnimationCanceledEvents returns a channel that receives each
+Animation.animationCanceled event. The channel is closed when the context is
+done or the socket stops listening. See OnAnimationCanceled.
+*/
+func (protocol *AnimationProtocol) AnimationCanceledEvents(
+	ctx context.Context,
+) <-chan *animation.CanceledEvent {
+	eventChan := make(chan *animation.CanceledEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Animation.animationCanceled")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &animation.CanceledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAnimationCanceled subscribes to the Animation.animationCanceled event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *AnimationProtocol) WaitForAnimationCanceled(
+	ctx context.Context,
+	predicate func(event *animation.CanceledEvent) bool,
+) <-chan *animation.CanceledEvent {
+	eventChan := make(chan *animation.CanceledEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Animation.animationCanceled",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &animation.CanceledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &animation.CanceledEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAnimationCanceledOnce adds a handler to the Animation.animationCanceled event
+that is removed after the first event is received. See OnAnimationCanceled.
+*/
+func (protocol *AnimationProtocol) OnAnimationCanceledOnce(
+	callback func(event *animation.CanceledEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Animation.animationCanceled",
 		func(response *Response) {
@@ -276,7 +487,7 @@ func (protocol *AnimationProtocol) OnAnimationCanceled(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -287,7 +498,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animatio
 */
 func (protocol *AnimationProtocol) OnAnimationCreated(
 	callback func(event *animation.CreatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Animation.animationCreated",
+		func(response *Response) {
+			event := &animation.CreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AnimationCreatedEvents returns a channel that receives each
+Animation.animationCreated event. The channel is closed when the context is done
+or the socket stops listening. See OnAnimationCreated.
+*/
+func (protocol *AnimationProtocol) AnimationCreatedEvents(
+	ctx context.Context,
+) <-chan *animation.CreatedEvent {
+	eventChan := make(chan *animation.CreatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Animation.animationCreated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &animation.CreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAnimationCreated subscribes to the Animation.animationCreated event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *AnimationProtocol) WaitForAnimationCreated(
+	ctx context.Context,
+	predicate func(event *animation.CreatedEvent) bool,
+) <-chan *animation.CreatedEvent {
+	eventChan := make(chan *animation.CreatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Animation.animationCreated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &animation.CreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &animation.CreatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAnimationCreatedOnce adds a handler to the Animation.animationCreated event
+that is removed after the first event is received. See OnAnimationCreated.
+*/
+func (protocol *AnimationProtocol) OnAnimationCreatedOnce(
+	callback func(event *animation.CreatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Animation.animationCreated",
 		func(response *Response) {
@@ -299,7 +601,7 @@ func (protocol *AnimationProtocol) OnAnimationCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -310,7 +612,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animatio
 */
 func (protocol *AnimationProtocol) OnAnimationStarted(
 	callback func(event *animation.StartedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Animation.animationStarted",
+		func(response *Response) {
+			event := &animation.StartedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AnimationStartedEvents returns a channel that receives each
+Animation.animationStarted event. The channel is closed when the context is done
+or the socket stops listening. See OnAnimationStarted.
+*/
+func (protocol *AnimationProtocol) AnimationStartedEvents(
+	ctx context.Context,
+) <-chan *animation.StartedEvent {
+	eventChan := make(chan *animation.StartedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Animation.animationStarted")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &animation.StartedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAnimationStarted subscribes to the Animation.animationStarted event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *AnimationProtocol) WaitForAnimationStarted(
+	ctx context.Context,
+	predicate func(event *animation.StartedEvent) bool,
+) <-chan *animation.StartedEvent {
+	eventChan := make(chan *animation.StartedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Animation.animationStarted",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &animation.StartedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &animation.StartedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAnimationStartedOnce adds a handler to the Animation.animationStarted event
+that is removed after the first event is received. See OnAnimationStarted.
+*/
+func (protocol *AnimationProtocol) OnAnimationStartedOnce(
+	callback func(event *animation.StartedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Animation.animationStarted",
 		func(response *Response) {
@@ -322,5 +715,5 @@ func (protocol *AnimationProtocol) OnAnimationStarted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
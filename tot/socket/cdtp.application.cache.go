@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/application/cache"
@@ -22,13 +23,24 @@ Enable enables application cache domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-enable
 */
 func (protocol *ApplicationCacheProtocol) Enable() <-chan *cache.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *ApplicationCacheProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *cache.EnableResult {
 	resultChan := make(chan *cache.EnableResult)
 	command := NewCommand(protocol.Socket, "ApplicationCache.enable", nil)
 	result := &cache.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -46,14 +58,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-
 */
 func (protocol *ApplicationCacheProtocol) GetForFrame(
 	params *cache.GetForFrameParams,
+) <-chan *cache.GetForFrameResult {
+	return protocol.GetForFrameContext(context.Background(), params)
+}
+
+/*
+GetForFrameContext is the context.Context-aware version of GetForFrame.
+*/
+func (protocol *ApplicationCacheProtocol) GetForFrameContext(
+	ctx context.Context,
+	params *cache.GetForFrameParams,
 ) <-chan *cache.GetForFrameResult {
 	resultChan := make(chan *cache.GetForFrameResult)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getApplicationCacheForFrame", params)
 	result := &cache.GetForFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -72,13 +96,25 @@ each frame containing a document associated with some application cache.
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getFramesWithManifests
 */
 func (protocol *ApplicationCacheProtocol) GetFramesWithManifests() <-chan *cache.GetFramesWithManifestsResult {
+	return protocol.GetFramesWithManifestsContext(context.Background())
+}
+
+/*
+GetFramesWithManifestsContext is the context.Context-aware version of
+GetFramesWithManifests.
+*/
+func (protocol *ApplicationCacheProtocol) GetFramesWithManifestsContext(
+	ctx context.Context,
+) <-chan *cache.GetFramesWithManifestsResult {
 	resultChan := make(chan *cache.GetFramesWithManifestsResult)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getFramesWithManifests", nil)
 	result := &cache.GetFramesWithManifestsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -97,14 +133,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-
 */
 func (protocol *ApplicationCacheProtocol) GetManifestForFrame(
 	params *cache.GetManifestForFrameParams,
+) <-chan *cache.GetManifestForFrameResult {
+	return protocol.GetManifestForFrameContext(context.Background(), params)
+}
+
+/*
+GetManifestForFrameContext is the context.Context-aware version of
+GetManifestForFrame.
+*/
+func (protocol *ApplicationCacheProtocol) GetManifestForFrameContext(
+	ctx context.Context,
+	params *cache.GetManifestForFrameParams,
 ) <-chan *cache.GetManifestForFrameResult {
 	resultChan := make(chan *cache.GetManifestForFrameResult)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getManifestForFrame", params)
 	result := &cache.GetManifestForFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -124,7 +173,100 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-a
 */
 func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdated(
 	callback func(event *cache.StatusUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"ApplicationCache.applicationCacheStatusUpdated",
+		func(response *Response) {
+			event := &cache.StatusUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ApplicationCacheStatusUpdatedEvents returns a channel that receives each
+ApplicationCache.applicationCacheStatusUpdated event. The channel is closed when
+the context is done or the socket stops listening. See
+OnApplicationCacheStatusUpdated.
+*/
+func (protocol *ApplicationCacheProtocol) ApplicationCacheStatusUpdatedEvents(
+	ctx context.Context,
+) <-chan *cache.StatusUpdatedEvent {
+	eventChan := make(chan *cache.StatusUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "ApplicationCache.applicationCacheStatusUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &cache.StatusUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForApplicationCacheStatusUpdated subscribes to the
+ApplicationCache.applicationCacheStatusUpdated event and returns a channel that
+receives the first event for which the predicate returns true. A nil predicate
+matches any event. If the context is done or the socket stops listening first,
+an event containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *ApplicationCacheProtocol) WaitForApplicationCacheStatusUpdated(
+	ctx context.Context,
+	predicate func(event *cache.StatusUpdatedEvent) bool,
+) <-chan *cache.StatusUpdatedEvent {
+	eventChan := make(chan *cache.StatusUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"ApplicationCache.applicationCacheStatusUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &cache.StatusUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &cache.StatusUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnApplicationCacheStatusUpdatedOnce adds a handler to the
+ApplicationCache.applicationCacheStatusUpdated event that is removed after the
+first event is received. See OnApplicationCacheStatusUpdated.
+*/
+func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdatedOnce(
+	callback func(event *cache.StatusUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"ApplicationCache.applicationCacheStatusUpdated",
 		func(response *Response) {
@@ -136,7 +278,7 @@ func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -146,7 +288,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-n
 */
 func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdated(
 	callback func(event *cache.NetworkStateUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"ApplicationCache.networkStateUpdated",
+		func(response *Response) {
+			event := &cache.NetworkStateUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+NetworkStateUpdatedEvents returns a channel that receives each
+ApplicationCache.networkStateUpdated event. The channel is closed when the
+context is done or the socket stops listening. See OnNetworkStateUpdated.
+*/
+func (protocol *ApplicationCacheProtocol) NetworkStateUpdatedEvents(
+	ctx context.Context,
+) <-chan *cache.NetworkStateUpdatedEvent {
+	eventChan := make(chan *cache.NetworkStateUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "ApplicationCache.networkStateUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &cache.NetworkStateUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForNetworkStateUpdated subscribes to the
+ApplicationCache.networkStateUpdated event and returns a channel that receives
+the first event for which the predicate returns true. A nil predicate matches
+any event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *ApplicationCacheProtocol) WaitForNetworkStateUpdated(
+	ctx context.Context,
+	predicate func(event *cache.NetworkStateUpdatedEvent) bool,
+) <-chan *cache.NetworkStateUpdatedEvent {
+	eventChan := make(chan *cache.NetworkStateUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"ApplicationCache.networkStateUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &cache.NetworkStateUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &cache.NetworkStateUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnNetworkStateUpdatedOnce adds a handler to the
+ApplicationCache.networkStateUpdated event that is removed after the first event
+is received. See OnNetworkStateUpdated.
+*/
+func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdatedOnce(
+	callback func(event *cache.NetworkStateUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"ApplicationCache.networkStateUpdated",
 		func(response *Response) {
@@ -158,5 +392,5 @@ func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
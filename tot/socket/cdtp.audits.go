@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/audits"
@@ -25,14 +26,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-getEncoded
 */
 func (protocol *AuditsProtocol) GetEncodedResponse(
 	params *audits.GetEncodedResponseParams,
+) <-chan *audits.GetEncodedResponseResult {
+	return protocol.GetEncodedResponseContext(context.Background(), params)
+}
+
+/*
+GetEncodedResponseContext is the context.Context-aware version of
+GetEncodedResponse.
+*/
+func (protocol *AuditsProtocol) GetEncodedResponseContext(
+	ctx context.Context,
+	params *audits.GetEncodedResponseParams,
 ) <-chan *audits.GetEncodedResponseResult {
 	resultChan := make(chan *audits.GetEncodedResponseResult)
 	command := NewCommand(protocol.Socket, "Audits.getEncodedResponse", params)
 	result := &audits.GetEncodedResponseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
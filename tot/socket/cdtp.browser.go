@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/browser"
@@ -22,13 +23,24 @@ Close closes the browser gracefully.
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-close
 */
 func (protocol *BrowserProtocol) Close() <-chan *browser.CloseResult {
+	return protocol.CloseContext(context.Background())
+}
+
+/*
+CloseContext is the context.Context-aware version of Close.
+*/
+func (protocol *BrowserProtocol) CloseContext(
+	ctx context.Context,
+) <-chan *browser.CloseResult {
 	resultChan := make(chan *browser.CloseResult)
 	command := NewCommand(protocol.Socket, "Browser.close", nil)
 	result := &browser.CloseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -44,13 +56,24 @@ GetVersion returns version information.
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getVersion
 */
 func (protocol *BrowserProtocol) GetVersion() <-chan *browser.GetVersionResult {
+	return protocol.GetVersionContext(context.Background())
+}
+
+/*
+GetVersionContext is the context.Context-aware version of GetVersion.
+*/
+func (protocol *BrowserProtocol) GetVersionContext(
+	ctx context.Context,
+) <-chan *browser.GetVersionResult {
 	resultChan := make(chan *browser.GetVersionResult)
 	command := NewCommand(protocol.Socket, "Browser.getVersion", nil)
 	result := &browser.GetVersionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -69,14 +92,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindow
 */
 func (protocol *BrowserProtocol) GetWindowBounds(
 	params *browser.GetWindowBoundsParams,
+) <-chan *browser.GetWindowBoundsResult {
+	return protocol.GetWindowBoundsContext(context.Background(), params)
+}
+
+/*
+GetWindowBoundsContext is the context.Context-aware version of GetWindowBounds.
+*/
+func (protocol *BrowserProtocol) GetWindowBoundsContext(
+	ctx context.Context,
+	params *browser.GetWindowBoundsParams,
 ) <-chan *browser.GetWindowBoundsResult {
 	resultChan := make(chan *browser.GetWindowBoundsResult)
 	command := NewCommand(protocol.Socket, "Browser.getWindowBounds", params)
 	result := &browser.GetWindowBoundsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -96,14 +131,27 @@ EXPERIMENTAL.
 */
 func (protocol *BrowserProtocol) GetWindowForTarget(
 	params *browser.GetWindowForTargetParams,
+) <-chan *browser.GetWindowForTargetResult {
+	return protocol.GetWindowForTargetContext(context.Background(), params)
+}
+
+/*
+GetWindowForTargetContext is the context.Context-aware version of
+GetWindowForTarget.
+*/
+func (protocol *BrowserProtocol) GetWindowForTargetContext(
+	ctx context.Context,
+	params *browser.GetWindowForTargetParams,
 ) <-chan *browser.GetWindowForTargetResult {
 	resultChan := make(chan *browser.GetWindowForTargetResult)
 	command := NewCommand(protocol.Socket, "Browser.getWindowForTarget", params)
 	result := &browser.GetWindowForTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -122,14 +170,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setWindow
 */
 func (protocol *BrowserProtocol) SetWindowBounds(
 	params *browser.SetWindowBoundsParams,
+) <-chan *browser.SetWindowBoundsResult {
+	return protocol.SetWindowBoundsContext(context.Background(), params)
+}
+
+/*
+SetWindowBoundsContext is the context.Context-aware version of SetWindowBounds.
+*/
+func (protocol *BrowserProtocol) SetWindowBoundsContext(
+	ctx context.Context,
+	params *browser.SetWindowBoundsParams,
 ) <-chan *browser.SetWindowBoundsResult {
 	resultChan := make(chan *browser.SetWindowBoundsResult)
 	command := NewCommand(protocol.Socket, "Browser.setWindowBounds", params)
 	result := &browser.SetWindowBoundsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/cache/storage"
@@ -23,14 +24,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-dele
 */
 func (protocol *CacheStorageProtocol) DeleteCache(
 	params *storage.DeleteCacheParams,
+) <-chan *storage.DeleteCacheResult {
+	return protocol.DeleteCacheContext(context.Background(), params)
+}
+
+/*
+DeleteCacheContext is the context.Context-aware version of DeleteCache.
+*/
+func (protocol *CacheStorageProtocol) DeleteCacheContext(
+	ctx context.Context,
+	params *storage.DeleteCacheParams,
 ) <-chan *storage.DeleteCacheResult {
 	resultChan := make(chan *storage.DeleteCacheResult)
 	command := NewCommand(protocol.Socket, "CacheStorage.deleteCache", params)
 	result := &storage.DeleteCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,14 +60,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-dele
 */
 func (protocol *CacheStorageProtocol) DeleteEntry(
 	params *storage.DeleteEntryParams,
+) <-chan *storage.DeleteEntryResult {
+	return protocol.DeleteEntryContext(context.Background(), params)
+}
+
+/*
+DeleteEntryContext is the context.Context-aware version of DeleteEntry.
+*/
+func (protocol *CacheStorageProtocol) DeleteEntryContext(
+	ctx context.Context,
+	params *storage.DeleteEntryParams,
 ) <-chan *storage.DeleteEntryResult {
 	resultChan := make(chan *storage.DeleteEntryResult)
 	command := NewCommand(protocol.Socket, "CacheStorage.deleteEntry", params)
 	result := &storage.DeleteEntryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -71,14 +96,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requ
 */
 func (protocol *CacheStorageProtocol) RequestCacheNames(
 	params *storage.RequestCacheNamesParams,
+) <-chan *storage.RequestCacheNamesResult {
+	return protocol.RequestCacheNamesContext(context.Background(), params)
+}
+
+/*
+RequestCacheNamesContext is the context.Context-aware version of
+RequestCacheNames.
+*/
+func (protocol *CacheStorageProtocol) RequestCacheNamesContext(
+	ctx context.Context,
+	params *storage.RequestCacheNamesParams,
 ) <-chan *storage.RequestCacheNamesResult {
 	resultChan := make(chan *storage.RequestCacheNamesResult)
 	command := NewCommand(protocol.Socket, "CacheStorage.requestCacheNames", params)
 	result := &storage.RequestCacheNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -97,14 +135,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requ
 */
 func (protocol *CacheStorageProtocol) RequestCachedResponse(
 	params *storage.RequestCachedResponseParams,
+) <-chan *storage.RequestCachedResponseResult {
+	return protocol.RequestCachedResponseContext(context.Background(), params)
+}
+
+/*
+RequestCachedResponseContext is the context.Context-aware version of
+RequestCachedResponse.
+*/
+func (protocol *CacheStorageProtocol) RequestCachedResponseContext(
+	ctx context.Context,
+	params *storage.RequestCachedResponseParams,
 ) <-chan *storage.RequestCachedResponseResult {
 	resultChan := make(chan *storage.RequestCachedResponseResult)
 	command := NewCommand(protocol.Socket, "CacheStorage.requestCachedResponse", params)
 	result := &storage.RequestCachedResponseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -123,14 +174,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requ
 */
 func (protocol *CacheStorageProtocol) RequestEntries(
 	params *storage.RequestEntriesParams,
+) <-chan *storage.RequestEntriesResult {
+	return protocol.RequestEntriesContext(context.Background(), params)
+}
+
+/*
+RequestEntriesContext is the context.Context-aware version of RequestEntries.
+*/
+func (protocol *CacheStorageProtocol) RequestEntriesContext(
+	ctx context.Context,
+	params *storage.RequestEntriesParams,
 ) <-chan *storage.RequestEntriesResult {
 	resultChan := make(chan *storage.RequestEntriesResult)
 	command := NewCommand(protocol.Socket, "CacheStorage.requestEntries", params)
 	result := &storage.RequestEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
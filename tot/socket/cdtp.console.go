@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/console"
@@ -22,13 +23,24 @@ ClearMessages does nothing.
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-clearMessages
 */
 func (protocol *ConsoleProtocol) ClearMessages() <-chan *console.ClearMessagesResult {
+	return protocol.ClearMessagesContext(context.Background())
+}
+
+/*
+ClearMessagesContext is the context.Context-aware version of ClearMessages.
+*/
+func (protocol *ConsoleProtocol) ClearMessagesContext(
+	ctx context.Context,
+) <-chan *console.ClearMessagesResult {
 	resultChan := make(chan *console.ClearMessagesResult)
 	command := NewCommand(protocol.Socket, "Console.clearMessages", nil)
 	result := &console.ClearMessagesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +57,24 @@ reported to the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-disable
 */
 func (protocol *ConsoleProtocol) Disable() <-chan *console.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *ConsoleProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *console.DisableResult {
 	resultChan := make(chan *console.DisableResult)
 	command := NewCommand(protocol.Socket, "Console.disable", nil)
 	result := &console.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,13 +91,24 @@ by means of the messageAdded notification.
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-enable
 */
 func (protocol *ConsoleProtocol) Enable() <-chan *console.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *ConsoleProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *console.EnableResult {
 	resultChan := make(chan *console.EnableResult)
 	command := NewCommand(protocol.Socket, "Console.enable", nil)
 	result := &console.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -92,7 +126,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Console/#event-messageAdd
 */
 func (protocol *ConsoleProtocol) OnMessageAdded(
 	callback func(event *console.MessageAddedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Console.messageAdded",
+		func(response *Response) {
+			event := &console.MessageAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+MessageAddedEvents returns a channel that receives each Console.messageAdded
+event. The channel is closed when the context is done or the socket stops
+listening. See OnMessageAdded.
+*/
+func (protocol *ConsoleProtocol) MessageAddedEvents(
+	ctx context.Context,
+) <-chan *console.MessageAddedEvent {
+	eventChan := make(chan *console.MessageAddedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Console.messageAdded")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &console.MessageAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForMessageAdded subscribes to the Console.messageAdded event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *ConsoleProtocol) WaitForMessageAdded(
+	ctx context.Context,
+	predicate func(event *console.MessageAddedEvent) bool,
+) <-chan *console.MessageAddedEvent {
+	eventChan := make(chan *console.MessageAddedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Console.messageAdded",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &console.MessageAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &console.MessageAddedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnMessageAddedOnce adds a handler to the Console.messageAdded event that is
+removed after the first event is received. See OnMessageAdded.
+*/
+func (protocol *ConsoleProtocol) OnMessageAddedOnce(
+	callback func(event *console.MessageAddedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Console.messageAdded",
 		func(response *Response) {
@@ -104,5 +229,5 @@ func (protocol *ConsoleProtocol) OnMessageAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/css"
@@ -31,14 +32,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-addRule
 */
 func (protocol *CSSProtocol) AddRule(
 	params *css.AddRuleParams,
+) <-chan *css.AddRuleResult {
+	return protocol.AddRuleContext(context.Background(), params)
+}
+
+/*
+AddRuleContext is the context.Context-aware version of AddRule.
+*/
+func (protocol *CSSProtocol) AddRuleContext(
+	ctx context.Context,
+	params *css.AddRuleParams,
 ) <-chan *css.AddRuleResult {
 	resultChan := make(chan *css.AddRuleResult)
 	command := NewCommand(protocol.Socket, "CSS.addRule", params)
 	result := &css.AddRuleResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -57,14 +70,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-collectClassN
 */
 func (protocol *CSSProtocol) CollectClassNames(
 	params *css.CollectClassNamesParams,
+) <-chan *css.CollectClassNamesResult {
+	return protocol.CollectClassNamesContext(context.Background(), params)
+}
+
+/*
+CollectClassNamesContext is the context.Context-aware version of
+CollectClassNames.
+*/
+func (protocol *CSSProtocol) CollectClassNamesContext(
+	ctx context.Context,
+	params *css.CollectClassNamesParams,
 ) <-chan *css.CollectClassNamesResult {
 	resultChan := make(chan *css.CollectClassNamesResult)
 	command := NewCommand(protocol.Socket, "CSS.collectClassNames", params)
 	result := &css.CollectClassNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -84,14 +110,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-createStyleSh
 */
 func (protocol *CSSProtocol) CreateStyleSheet(
 	params *css.CreateStyleSheetParams,
+) <-chan *css.CreateStyleSheetResult {
+	return protocol.CreateStyleSheetContext(context.Background(), params)
+}
+
+/*
+CreateStyleSheetContext is the context.Context-aware version of
+CreateStyleSheet.
+*/
+func (protocol *CSSProtocol) CreateStyleSheetContext(
+	ctx context.Context,
+	params *css.CreateStyleSheetParams,
 ) <-chan *css.CreateStyleSheetResult {
 	resultChan := make(chan *css.CreateStyleSheetResult)
 	command := NewCommand(protocol.Socket, "CSS.createStyleSheet", params)
 	result := &css.CreateStyleSheetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -109,13 +148,24 @@ Disable disables the CSS agent for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-disable
 */
 func (protocol *CSSProtocol) Disable() <-chan *css.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *CSSProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *css.DisableResult {
 	resultChan := make(chan *css.DisableResult)
 	command := NewCommand(protocol.Socket, "CSS.disable", nil)
 	result := &css.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -132,13 +182,24 @@ the CSS agent has been enabled until the result of this command is received.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-enable
 */
 func (protocol *CSSProtocol) Enable() <-chan *css.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *CSSProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *css.EnableResult {
 	resultChan := make(chan *css.EnableResult)
 	command := NewCommand(protocol.Socket, "CSS.enable", nil)
 	result := &css.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -156,14 +217,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forcePseudoSt
 */
 func (protocol *CSSProtocol) ForcePseudoState(
 	params *css.ForcePseudoStateParams,
+) <-chan *css.ForcePseudoStateResult {
+	return protocol.ForcePseudoStateContext(context.Background(), params)
+}
+
+/*
+ForcePseudoStateContext is the context.Context-aware version of
+ForcePseudoState.
+*/
+func (protocol *CSSProtocol) ForcePseudoStateContext(
+	ctx context.Context,
+	params *css.ForcePseudoStateParams,
 ) <-chan *css.ForcePseudoStateResult {
 	resultChan := make(chan *css.ForcePseudoStateResult)
 	command := NewCommand(protocol.Socket, "CSS.forcePseudoState", params)
 	result := &css.ForcePseudoStateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -180,14 +254,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getBackground
 */
 func (protocol *CSSProtocol) GetBackgroundColors(
 	params *css.GetBackgroundColorsParams,
+) <-chan *css.GetBackgroundColorsResult {
+	return protocol.GetBackgroundColorsContext(context.Background(), params)
+}
+
+/*
+GetBackgroundColorsContext is the context.Context-aware version of
+GetBackgroundColors.
+*/
+func (protocol *CSSProtocol) GetBackgroundColorsContext(
+	ctx context.Context,
+	params *css.GetBackgroundColorsParams,
 ) <-chan *css.GetBackgroundColorsResult {
 	resultChan := make(chan *css.GetBackgroundColorsResult)
 	command := NewCommand(protocol.Socket, "CSS.getBackgroundColors", params)
 	result := &css.GetBackgroundColorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -207,14 +294,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getComputedSt
 */
 func (protocol *CSSProtocol) GetComputedStyleForNode(
 	params *css.GetComputedStyleForNodeParams,
+) <-chan *css.GetComputedStyleForNodeResult {
+	return protocol.GetComputedStyleForNodeContext(context.Background(), params)
+}
+
+/*
+GetComputedStyleForNodeContext is the context.Context-aware version of
+GetComputedStyleForNode.
+*/
+func (protocol *CSSProtocol) GetComputedStyleForNodeContext(
+	ctx context.Context,
+	params *css.GetComputedStyleForNodeParams,
 ) <-chan *css.GetComputedStyleForNodeResult {
 	resultChan := make(chan *css.GetComputedStyleForNodeResult)
 	command := NewCommand(protocol.Socket, "CSS.getComputedStyleForNode", params)
 	result := &css.GetComputedStyleForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -235,14 +335,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getInlineStyl
 */
 func (protocol *CSSProtocol) GetInlineStylesForNode(
 	params *css.GetInlineStylesForNodeParams,
+) <-chan *css.GetInlineStylesForNodeResult {
+	return protocol.GetInlineStylesForNodeContext(context.Background(), params)
+}
+
+/*
+GetInlineStylesForNodeContext is the context.Context-aware version of
+GetInlineStylesForNode.
+*/
+func (protocol *CSSProtocol) GetInlineStylesForNodeContext(
+	ctx context.Context,
+	params *css.GetInlineStylesForNodeParams,
 ) <-chan *css.GetInlineStylesForNodeResult {
 	resultChan := make(chan *css.GetInlineStylesForNodeResult)
 	command := NewCommand(protocol.Socket, "CSS.getInlineStylesForNode", params)
 	result := &css.GetInlineStylesForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -262,14 +375,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMatchedSty
 */
 func (protocol *CSSProtocol) GetMatchedStylesForNode(
 	params *css.GetMatchedStylesForNodeParams,
+) <-chan *css.GetMatchedStylesForNodeResult {
+	return protocol.GetMatchedStylesForNodeContext(context.Background(), params)
+}
+
+/*
+GetMatchedStylesForNodeContext is the context.Context-aware version of
+GetMatchedStylesForNode.
+*/
+func (protocol *CSSProtocol) GetMatchedStylesForNodeContext(
+	ctx context.Context,
+	params *css.GetMatchedStylesForNodeParams,
 ) <-chan *css.GetMatchedStylesForNodeResult {
 	resultChan := make(chan *css.GetMatchedStylesForNodeResult)
 	command := NewCommand(protocol.Socket, "CSS.getMatchedStylesForNode", params)
 	result := &css.GetMatchedStylesForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -287,13 +413,24 @@ GetMediaQueries returns all media queries parsed by the rendering engine.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMediaQueries
 */
 func (protocol *CSSProtocol) GetMediaQueries() <-chan *css.GetMediaQueriesResult {
+	return protocol.GetMediaQueriesContext(context.Background())
+}
+
+/*
+GetMediaQueriesContext is the context.Context-aware version of GetMediaQueries.
+*/
+func (protocol *CSSProtocol) GetMediaQueriesContext(
+	ctx context.Context,
+) <-chan *css.GetMediaQueriesResult {
 	resultChan := make(chan *css.GetMediaQueriesResult)
 	command := NewCommand(protocol.Socket, "CSS.getMediaQueries", nil)
 	result := &css.GetMediaQueriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -313,14 +450,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getPlatformFo
 */
 func (protocol *CSSProtocol) GetPlatformFontsForNode(
 	params *css.GetPlatformFontsForNodeParams,
+) <-chan *css.GetPlatformFontsForNodeResult {
+	return protocol.GetPlatformFontsForNodeContext(context.Background(), params)
+}
+
+/*
+GetPlatformFontsForNodeContext is the context.Context-aware version of
+GetPlatformFontsForNode.
+*/
+func (protocol *CSSProtocol) GetPlatformFontsForNodeContext(
+	ctx context.Context,
+	params *css.GetPlatformFontsForNodeParams,
 ) <-chan *css.GetPlatformFontsForNodeResult {
 	resultChan := make(chan *css.GetPlatformFontsForNodeResult)
 	command := NewCommand(protocol.Socket, "CSS.getPlatformFontsForNode", params)
 	result := &css.GetPlatformFontsForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -340,14 +490,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getStyleSheet
 */
 func (protocol *CSSProtocol) GetStyleSheetText(
 	params *css.GetStyleSheetTextParams,
+) <-chan *css.GetStyleSheetTextResult {
+	return protocol.GetStyleSheetTextContext(context.Background(), params)
+}
+
+/*
+GetStyleSheetTextContext is the context.Context-aware version of
+GetStyleSheetText.
+*/
+func (protocol *CSSProtocol) GetStyleSheetTextContext(
+	ctx context.Context,
+	params *css.GetStyleSheetTextParams,
 ) <-chan *css.GetStyleSheetTextResult {
 	resultChan := make(chan *css.GetStyleSheetTextResult)
 	command := NewCommand(protocol.Socket, "CSS.getStyleSheetText", params)
 	result := &css.GetStyleSheetTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -367,14 +530,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setEffectiveP
 */
 func (protocol *CSSProtocol) SetEffectivePropertyValueForNode(
 	params *css.SetEffectivePropertyValueForNodeParams,
+) <-chan *css.SetEffectivePropertyValueForNodeResult {
+	return protocol.SetEffectivePropertyValueForNodeContext(context.Background(), params)
+}
+
+/*
+SetEffectivePropertyValueForNodeContext is the context.Context-aware version of
+SetEffectivePropertyValueForNode.
+*/
+func (protocol *CSSProtocol) SetEffectivePropertyValueForNodeContext(
+	ctx context.Context,
+	params *css.SetEffectivePropertyValueForNodeParams,
 ) <-chan *css.SetEffectivePropertyValueForNodeResult {
 	resultChan := make(chan *css.SetEffectivePropertyValueForNodeResult)
 	command := NewCommand(protocol.Socket, "CSS.setEffectivePropertyValueForNode", params)
 	result := &css.SetEffectivePropertyValueForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -391,14 +567,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setKeyframeKe
 */
 func (protocol *CSSProtocol) SetKeyframeKey(
 	params *css.SetKeyframeKeyParams,
+) <-chan *css.SetKeyframeKeyResult {
+	return protocol.SetKeyframeKeyContext(context.Background(), params)
+}
+
+/*
+SetKeyframeKeyContext is the context.Context-aware version of SetKeyframeKey.
+*/
+func (protocol *CSSProtocol) SetKeyframeKeyContext(
+	ctx context.Context,
+	params *css.SetKeyframeKeyParams,
 ) <-chan *css.SetKeyframeKeyResult {
 	resultChan := make(chan *css.SetKeyframeKeyResult)
 	command := NewCommand(protocol.Socket, "CSS.setKeyframeKey", params)
 	result := &css.SetKeyframeKeyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -417,14 +605,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setMediaText
 */
 func (protocol *CSSProtocol) SetMediaText(
 	params *css.SetMediaTextParams,
+) <-chan *css.SetMediaTextResult {
+	return protocol.SetMediaTextContext(context.Background(), params)
+}
+
+/*
+SetMediaTextContext is the context.Context-aware version of SetMediaText.
+*/
+func (protocol *CSSProtocol) SetMediaTextContext(
+	ctx context.Context,
+	params *css.SetMediaTextParams,
 ) <-chan *css.SetMediaTextResult {
 	resultChan := make(chan *css.SetMediaTextResult)
 	command := NewCommand(protocol.Socket, "CSS.setMediaText", params)
 	result := &css.SetMediaTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -443,14 +643,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setRuleSelect
 */
 func (protocol *CSSProtocol) SetRuleSelector(
 	params *css.SetRuleSelectorParams,
+) <-chan *css.SetRuleSelectorResult {
+	return protocol.SetRuleSelectorContext(context.Background(), params)
+}
+
+/*
+SetRuleSelectorContext is the context.Context-aware version of SetRuleSelector.
+*/
+func (protocol *CSSProtocol) SetRuleSelectorContext(
+	ctx context.Context,
+	params *css.SetRuleSelectorParams,
 ) <-chan *css.SetRuleSelectorResult {
 	resultChan := make(chan *css.SetRuleSelectorResult)
 	command := NewCommand(protocol.Socket, "CSS.setRuleSelector", params)
 	result := &css.SetRuleSelectorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -469,14 +681,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleSheet
 */
 func (protocol *CSSProtocol) SetStyleSheetText(
 	params *css.SetStyleSheetTextParams,
+) <-chan *css.SetStyleSheetTextResult {
+	return protocol.SetStyleSheetTextContext(context.Background(), params)
+}
+
+/*
+SetStyleSheetTextContext is the context.Context-aware version of
+SetStyleSheetText.
+*/
+func (protocol *CSSProtocol) SetStyleSheetTextContext(
+	ctx context.Context,
+	params *css.SetStyleSheetTextParams,
 ) <-chan *css.SetStyleSheetTextResult {
 	resultChan := make(chan *css.SetStyleSheetTextResult)
 	command := NewCommand(protocol.Socket, "CSS.setStyleSheetText", params)
 	result := &css.SetStyleSheetTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -495,14 +720,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleTexts
 */
 func (protocol *CSSProtocol) SetStyleTexts(
 	params *css.SetStyleTextsParams,
+) <-chan *css.SetStyleTextsResult {
+	return protocol.SetStyleTextsContext(context.Background(), params)
+}
+
+/*
+SetStyleTextsContext is the context.Context-aware version of SetStyleTexts.
+*/
+func (protocol *CSSProtocol) SetStyleTextsContext(
+	ctx context.Context,
+	params *css.SetStyleTextsParams,
 ) <-chan *css.SetStyleTextsResult {
 	resultChan := make(chan *css.SetStyleTextsResult)
 	command := NewCommand(protocol.Socket, "CSS.setStyleTexts", params)
 	result := &css.SetStyleTextsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -520,13 +757,25 @@ StartRuleUsageTracking enables the selector recording.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-startRuleUsageTracking
 */
 func (protocol *CSSProtocol) StartRuleUsageTracking() <-chan *css.StartRuleUsageTrackingResult {
+	return protocol.StartRuleUsageTrackingContext(context.Background())
+}
+
+/*
+StartRuleUsageTrackingContext is the context.Context-aware version of
+StartRuleUsageTracking.
+*/
+func (protocol *CSSProtocol) StartRuleUsageTrackingContext(
+	ctx context.Context,
+) <-chan *css.StartRuleUsageTrackingResult {
 	resultChan := make(chan *css.StartRuleUsageTrackingResult)
 	command := NewCommand(protocol.Socket, "CSS.startRuleUsageTracking", nil)
 	result := &css.StartRuleUsageTrackingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -543,13 +792,25 @@ they were used.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-stopRuleUsageTracking
 */
 func (protocol *CSSProtocol) StopRuleUsageTracking() <-chan *css.StopRuleUsageTrackingResult {
+	return protocol.StopRuleUsageTrackingContext(context.Background())
+}
+
+/*
+StopRuleUsageTrackingContext is the context.Context-aware version of
+StopRuleUsageTracking.
+*/
+func (protocol *CSSProtocol) StopRuleUsageTrackingContext(
+	ctx context.Context,
+) <-chan *css.StopRuleUsageTrackingResult {
 	resultChan := make(chan *css.StopRuleUsageTrackingResult)
 	command := NewCommand(protocol.Socket, "CSS.stopRuleUsageTracking", nil)
 	result := &css.StopRuleUsageTrackingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -568,13 +829,25 @@ this method (or since start of coverage instrumentation).
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-takeCoverageDelta
 */
 func (protocol *CSSProtocol) TakeCoverageDelta() <-chan *css.TakeCoverageDeltaResult {
+	return protocol.TakeCoverageDeltaContext(context.Background())
+}
+
+/*
+TakeCoverageDeltaContext is the context.Context-aware version of
+TakeCoverageDelta.
+*/
+func (protocol *CSSProtocol) TakeCoverageDeltaContext(
+	ctx context.Context,
+) <-chan *css.TakeCoverageDeltaResult {
 	resultChan := make(chan *css.TakeCoverageDeltaResult)
 	command := NewCommand(protocol.Socket, "CSS.takeCoverageDelta", nil)
 	result := &css.TakeCoverageDeltaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -594,7 +867,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-fontsUpdated
 */
 func (protocol *CSSProtocol) OnFontsUpdated(
 	callback func(event *css.FontsUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.fontsUpdated",
 		func(response *Response) {
@@ -606,7 +879,98 @@ func (protocol *CSSProtocol) OnFontsUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FontsUpdatedEvents returns a channel that receives each CSS.fontsUpdated event.
+The channel is closed when the context is done or the socket stops listening.
+See OnFontsUpdated.
+*/
+func (protocol *CSSProtocol) FontsUpdatedEvents(
+	ctx context.Context,
+) <-chan *css.FontsUpdatedEvent {
+	eventChan := make(chan *css.FontsUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "CSS.fontsUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &css.FontsUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFontsUpdated subscribes to the CSS.fontsUpdated event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *CSSProtocol) WaitForFontsUpdated(
+	ctx context.Context,
+	predicate func(event *css.FontsUpdatedEvent) bool,
+) <-chan *css.FontsUpdatedEvent {
+	eventChan := make(chan *css.FontsUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"CSS.fontsUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &css.FontsUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &css.FontsUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFontsUpdatedOnce adds a handler to the CSS.fontsUpdated event that is removed
+after the first event is received. See OnFontsUpdated.
+*/
+func (protocol *CSSProtocol) OnFontsUpdatedOnce(
+	callback func(event *css.FontsUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"CSS.fontsUpdated",
+		func(response *Response) {
+			event := &css.FontsUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -619,7 +983,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-mediaQueryResu
 */
 func (protocol *CSSProtocol) OnMediaQueryResultChanged(
 	callback func(event *css.MediaQueryResultChangedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"CSS.mediaQueryResultChanged",
+		func(response *Response) {
+			event := &css.MediaQueryResultChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+MediaQueryResultChangedEvents returns a channel that receives each
+CSS.mediaQueryResultChanged event. The channel is closed when the context is
+done or the socket stops listening. See OnMediaQueryResultChanged.
+*/
+func (protocol *CSSProtocol) MediaQueryResultChangedEvents(
+	ctx context.Context,
+) <-chan *css.MediaQueryResultChangedEvent {
+	eventChan := make(chan *css.MediaQueryResultChangedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "CSS.mediaQueryResultChanged")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &css.MediaQueryResultChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForMediaQueryResultChanged subscribes to the CSS.mediaQueryResultChanged
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *CSSProtocol) WaitForMediaQueryResultChanged(
+	ctx context.Context,
+	predicate func(event *css.MediaQueryResultChangedEvent) bool,
+) <-chan *css.MediaQueryResultChangedEvent {
+	eventChan := make(chan *css.MediaQueryResultChangedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"CSS.mediaQueryResultChanged",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &css.MediaQueryResultChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &css.MediaQueryResultChangedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnMediaQueryResultChangedOnce adds a handler to the CSS.mediaQueryResultChanged
+event that is removed after the first event is received. See
+OnMediaQueryResultChanged.
+*/
+func (protocol *CSSProtocol) OnMediaQueryResultChangedOnce(
+	callback func(event *css.MediaQueryResultChangedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.mediaQueryResultChanged",
 		func(response *Response) {
@@ -631,7 +1087,7 @@ func (protocol *CSSProtocol) OnMediaQueryResultChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -642,7 +1098,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetAdde
 */
 func (protocol *CSSProtocol) OnStyleSheetAdded(
 	callback func(event *css.StyleSheetAddedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"CSS.styleSheetAdded",
+		func(response *Response) {
+			event := &css.StyleSheetAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+StyleSheetAddedEvents returns a channel that receives each CSS.styleSheetAdded
+event. The channel is closed when the context is done or the socket stops
+listening. See OnStyleSheetAdded.
+*/
+func (protocol *CSSProtocol) StyleSheetAddedEvents(
+	ctx context.Context,
+) <-chan *css.StyleSheetAddedEvent {
+	eventChan := make(chan *css.StyleSheetAddedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "CSS.styleSheetAdded")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &css.StyleSheetAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForStyleSheetAdded subscribes to the CSS.styleSheetAdded event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *CSSProtocol) WaitForStyleSheetAdded(
+	ctx context.Context,
+	predicate func(event *css.StyleSheetAddedEvent) bool,
+) <-chan *css.StyleSheetAddedEvent {
+	eventChan := make(chan *css.StyleSheetAddedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"CSS.styleSheetAdded",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &css.StyleSheetAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &css.StyleSheetAddedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnStyleSheetAddedOnce adds a handler to the CSS.styleSheetAdded event that is
+removed after the first event is received. See OnStyleSheetAdded.
+*/
+func (protocol *CSSProtocol) OnStyleSheetAddedOnce(
+	callback func(event *css.StyleSheetAddedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.styleSheetAdded",
 		func(response *Response) {
@@ -654,7 +1201,7 @@ func (protocol *CSSProtocol) OnStyleSheetAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -666,7 +1213,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetChan
 */
 func (protocol *CSSProtocol) OnStyleSheetChanged(
 	callback func(event *css.StyleSheetChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.styleSheetChanged",
 		func(response *Response) {
@@ -678,7 +1225,98 @@ func (protocol *CSSProtocol) OnStyleSheetChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+StyleSheetChangedEvents returns a channel that receives each
+CSS.styleSheetChanged event. The channel is closed when the context is done or
+the socket stops listening. See OnStyleSheetChanged.
+*/
+func (protocol *CSSProtocol) StyleSheetChangedEvents(
+	ctx context.Context,
+) <-chan *css.StyleSheetChangedEvent {
+	eventChan := make(chan *css.StyleSheetChangedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "CSS.styleSheetChanged")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &css.StyleSheetChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForStyleSheetChanged subscribes to the CSS.styleSheetChanged event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *CSSProtocol) WaitForStyleSheetChanged(
+	ctx context.Context,
+	predicate func(event *css.StyleSheetChangedEvent) bool,
+) <-chan *css.StyleSheetChangedEvent {
+	eventChan := make(chan *css.StyleSheetChangedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"CSS.styleSheetChanged",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &css.StyleSheetChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &css.StyleSheetChangedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnStyleSheetChangedOnce adds a handler to the CSS.styleSheetChanged event that
+is removed after the first event is received. See OnStyleSheetChanged.
+*/
+func (protocol *CSSProtocol) OnStyleSheetChangedOnce(
+	callback func(event *css.StyleSheetChangedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"CSS.styleSheetChanged",
+		func(response *Response) {
+			event := &css.StyleSheetChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -689,7 +1327,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetRemo
 */
 func (protocol *CSSProtocol) OnStyleSheetRemoved(
 	callback func(event *css.StyleSheetRemovedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"CSS.styleSheetRemoved",
+		func(response *Response) {
+			event := &css.StyleSheetRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+StyleSheetRemovedEvents returns a channel that receives each
+CSS.styleSheetRemoved event. The channel is closed when the context is done or
+the socket stops listening. See OnStyleSheetRemoved.
+*/
+func (protocol *CSSProtocol) StyleSheetRemovedEvents(
+	ctx context.Context,
+) <-chan *css.StyleSheetRemovedEvent {
+	eventChan := make(chan *css.StyleSheetRemovedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "CSS.styleSheetRemoved")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &css.StyleSheetRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForStyleSheetRemoved subscribes to the CSS.styleSheetRemoved event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *CSSProtocol) WaitForStyleSheetRemoved(
+	ctx context.Context,
+	predicate func(event *css.StyleSheetRemovedEvent) bool,
+) <-chan *css.StyleSheetRemovedEvent {
+	eventChan := make(chan *css.StyleSheetRemovedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"CSS.styleSheetRemoved",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &css.StyleSheetRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &css.StyleSheetRemovedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnStyleSheetRemovedOnce adds a handler to the CSS.styleSheetRemoved event that
+is removed after the first event is received. See OnStyleSheetRemoved.
+*/
+func (protocol *CSSProtocol) OnStyleSheetRemovedOnce(
+	callback func(event *css.StyleSheetRemovedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.styleSheetRemoved",
 		func(response *Response) {
@@ -701,5 +1430,5 @@ func (protocol *CSSProtocol) OnStyleSheetRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/database"
@@ -22,13 +23,24 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-disable
 */
 func (protocol *DatabaseProtocol) Disable() <-chan *database.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *DatabaseProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *database.DisableResult {
 	resultChan := make(chan *database.DisableResult)
 	command := NewCommand(protocol.Socket, "Database.disable", nil)
 	result := &database.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +57,24 @@ client.
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-enable
 */
 func (protocol *DatabaseProtocol) Enable() <-chan *database.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *DatabaseProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *database.EnableResult {
 	resultChan := make(chan *database.EnableResult)
 	command := NewCommand(protocol.Socket, "Database.enable", nil)
 	result := &database.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,14 +91,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-executeS
 */
 func (protocol *DatabaseProtocol) ExecuteSQL(
 	params *database.ExecuteSQLParams,
+) <-chan *database.ExecuteSQLResult {
+	return protocol.ExecuteSQLContext(context.Background(), params)
+}
+
+/*
+ExecuteSQLContext is the context.Context-aware version of ExecuteSQL.
+*/
+func (protocol *DatabaseProtocol) ExecuteSQLContext(
+	ctx context.Context,
+	params *database.ExecuteSQLParams,
 ) <-chan *database.ExecuteSQLResult {
 	resultChan := make(chan *database.ExecuteSQLResult)
 	command := NewCommand(protocol.Socket, "Database.executeSQL", params)
 	result := &database.ExecuteSQLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -94,14 +129,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-getDatab
 */
 func (protocol *DatabaseProtocol) GetTableNames(
 	params *database.GetTableNamesParams,
+) <-chan *database.GetTableNamesResult {
+	return protocol.GetTableNamesContext(context.Background(), params)
+}
+
+/*
+GetTableNamesContext is the context.Context-aware version of GetTableNames.
+*/
+func (protocol *DatabaseProtocol) GetTableNamesContext(
+	ctx context.Context,
+	params *database.GetTableNamesParams,
 ) <-chan *database.GetTableNamesResult {
 	resultChan := make(chan *database.GetTableNamesResult)
 	command := NewCommand(protocol.Socket, "Database.executeSQL", params)
 	result := &database.GetTableNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -121,7 +168,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Database/#event-addDataba
 */
 func (protocol *DatabaseProtocol) OnAdd(
 	callback func(event *database.AddEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Database.addDatabase",
+		func(response *Response) {
+			event := &database.AddEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AddEvents returns a channel that receives each Database.addDatabase event. The
+channel is closed when the context is done or the socket stops listening. See
+OnAdd.
+*/
+func (protocol *DatabaseProtocol) AddEvents(
+	ctx context.Context,
+) <-chan *database.AddEvent {
+	eventChan := make(chan *database.AddEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Database.addDatabase")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &database.AddEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAdd subscribes to the Database.addDatabase event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DatabaseProtocol) WaitForAdd(
+	ctx context.Context,
+	predicate func(event *database.AddEvent) bool,
+) <-chan *database.AddEvent {
+	eventChan := make(chan *database.AddEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Database.addDatabase",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &database.AddEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &database.AddEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAddOnce adds a handler to the Database.addDatabase event that is removed after
+the first event is received. See OnAdd.
+*/
+func (protocol *DatabaseProtocol) OnAddOnce(
+	callback func(event *database.AddEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Database.addDatabase",
 		func(response *Response) {
@@ -133,5 +271,5 @@ func (protocol *DatabaseProtocol) OnAdd(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/debugger"
@@ -25,14 +26,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-continue
 */
 func (protocol *DebuggerProtocol) ContinueToLocation(
 	params *debugger.ContinueToLocationParams,
+) <-chan *debugger.ContinueToLocationResult {
+	return protocol.ContinueToLocationContext(context.Background(), params)
+}
+
+/*
+ContinueToLocationContext is the context.Context-aware version of
+ContinueToLocation.
+*/
+func (protocol *DebuggerProtocol) ContinueToLocationContext(
+	ctx context.Context,
+	params *debugger.ContinueToLocationParams,
 ) <-chan *debugger.ContinueToLocationResult {
 	resultChan := make(chan *debugger.ContinueToLocationResult)
 	command := NewCommand(protocol.Socket, "Debugger.continueToLocation", params)
 	result := &debugger.ContinueToLocationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -48,13 +62,24 @@ Disable disables debugger for given page.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-disable
 */
 func (protocol *DebuggerProtocol) Disable() <-chan *debugger.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *DebuggerProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *debugger.DisableResult {
 	resultChan := make(chan *debugger.DisableResult)
 	command := NewCommand(protocol.Socket, "Debugger.disable", nil)
 	result := &debugger.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -71,13 +96,24 @@ debugging has been enabled until the result for this command is received.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-enable
 */
 func (protocol *DebuggerProtocol) Enable() <-chan *debugger.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *DebuggerProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *debugger.EnableResult {
 	resultChan := make(chan *debugger.EnableResult)
 	command := NewCommand(protocol.Socket, "Debugger.enable", nil)
 	result := &debugger.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -96,14 +132,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-evaluate
 */
 func (protocol *DebuggerProtocol) EvaluateOnCallFrame(
 	params *debugger.EvaluateOnCallFrameParams,
+) <-chan *debugger.EvaluateOnCallFrameResult {
+	return protocol.EvaluateOnCallFrameContext(context.Background(), params)
+}
+
+/*
+EvaluateOnCallFrameContext is the context.Context-aware version of
+EvaluateOnCallFrame.
+*/
+func (protocol *DebuggerProtocol) EvaluateOnCallFrameContext(
+	ctx context.Context,
+	params *debugger.EvaluateOnCallFrameParams,
 ) <-chan *debugger.EvaluateOnCallFrameResult {
 	resultChan := make(chan *debugger.EvaluateOnCallFrameResult)
 	command := NewCommand(protocol.Socket, "Debugger.evaluateOnCallFrame", params)
 	result := &debugger.EvaluateOnCallFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -123,14 +172,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getPossi
 */
 func (protocol *DebuggerProtocol) GetPossibleBreakpoints(
 	params *debugger.GetPossibleBreakpointsParams,
+) <-chan *debugger.GetPossibleBreakpointsResult {
+	return protocol.GetPossibleBreakpointsContext(context.Background(), params)
+}
+
+/*
+GetPossibleBreakpointsContext is the context.Context-aware version of
+GetPossibleBreakpoints.
+*/
+func (protocol *DebuggerProtocol) GetPossibleBreakpointsContext(
+	ctx context.Context,
+	params *debugger.GetPossibleBreakpointsParams,
 ) <-chan *debugger.GetPossibleBreakpointsResult {
 	resultChan := make(chan *debugger.GetPossibleBreakpointsResult)
 	command := NewCommand(protocol.Socket, "Debugger.getPossibleBreakpoints", params)
 	result := &debugger.GetPossibleBreakpointsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -149,14 +211,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getScrip
 */
 func (protocol *DebuggerProtocol) GetScriptSource(
 	params *debugger.GetScriptSourceParams,
+) <-chan *debugger.GetScriptSourceResult {
+	return protocol.GetScriptSourceContext(context.Background(), params)
+}
+
+/*
+GetScriptSourceContext is the context.Context-aware version of GetScriptSource.
+*/
+func (protocol *DebuggerProtocol) GetScriptSourceContext(
+	ctx context.Context,
+	params *debugger.GetScriptSourceParams,
 ) <-chan *debugger.GetScriptSourceResult {
 	resultChan := make(chan *debugger.GetScriptSourceResult)
 	command := NewCommand(protocol.Socket, "Debugger.getScriptSource", params)
 	result := &debugger.GetScriptSourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -175,14 +249,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getStack
 */
 func (protocol *DebuggerProtocol) GetStackTrace(
 	params *debugger.GetStackTraceParams,
+) <-chan *debugger.GetStackTraceResult {
+	return protocol.GetStackTraceContext(context.Background(), params)
+}
+
+/*
+GetStackTraceContext is the context.Context-aware version of GetStackTrace.
+*/
+func (protocol *DebuggerProtocol) GetStackTraceContext(
+	ctx context.Context,
+	params *debugger.GetStackTraceParams,
 ) <-chan *debugger.GetStackTraceResult {
 	resultChan := make(chan *debugger.GetStackTraceResult)
 	command := NewCommand(protocol.Socket, "Debugger.getStackTrace", params)
 	result := &debugger.GetStackTraceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -200,13 +286,24 @@ Pause stops on the next JavaScript statement.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pause
 */
 func (protocol *DebuggerProtocol) Pause() <-chan *debugger.PauseResult {
+	return protocol.PauseContext(context.Background())
+}
+
+/*
+PauseContext is the context.Context-aware version of Pause.
+*/
+func (protocol *DebuggerProtocol) PauseContext(
+	ctx context.Context,
+) <-chan *debugger.PauseResult {
 	resultChan := make(chan *debugger.PauseResult)
 	command := NewCommand(protocol.Socket, "Debugger.pause", nil)
 	result := &debugger.PauseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -223,14 +320,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pauseOnA
 */
 func (protocol *DebuggerProtocol) PauseOnAsyncCall(
 	params *debugger.PauseOnAsyncCallParams,
+) <-chan *debugger.PauseOnAsyncCallResult {
+	return protocol.PauseOnAsyncCallContext(context.Background(), params)
+}
+
+/*
+PauseOnAsyncCallContext is the context.Context-aware version of
+PauseOnAsyncCall.
+*/
+func (protocol *DebuggerProtocol) PauseOnAsyncCallContext(
+	ctx context.Context,
+	params *debugger.PauseOnAsyncCallParams,
 ) <-chan *debugger.PauseOnAsyncCallResult {
 	resultChan := make(chan *debugger.PauseOnAsyncCallResult)
 	command := NewCommand(protocol.Socket, "Debugger.pauseOnAsyncCall", params)
 	result := &debugger.PauseOnAsyncCallResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -247,14 +357,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-removeBr
 */
 func (protocol *DebuggerProtocol) RemoveBreakpoint(
 	params *debugger.RemoveBreakpointParams,
+) <-chan *debugger.RemoveBreakpointResult {
+	return protocol.RemoveBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveBreakpointContext is the context.Context-aware version of
+RemoveBreakpoint.
+*/
+func (protocol *DebuggerProtocol) RemoveBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveBreakpointParams,
 ) <-chan *debugger.RemoveBreakpointResult {
 	resultChan := make(chan *debugger.RemoveBreakpointResult)
 	command := NewCommand(protocol.Socket, "Debugger.removeBreakpoint", params)
 	result := &debugger.RemoveBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -271,14 +394,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-restartF
 */
 func (protocol *DebuggerProtocol) RestartFrame(
 	params *debugger.RestartFrameParams,
+) <-chan *debugger.RestartFrameResult {
+	return protocol.RestartFrameContext(context.Background(), params)
+}
+
+/*
+RestartFrameContext is the context.Context-aware version of RestartFrame.
+*/
+func (protocol *DebuggerProtocol) RestartFrameContext(
+	ctx context.Context,
+	params *debugger.RestartFrameParams,
 ) <-chan *debugger.RestartFrameResult {
 	resultChan := make(chan *debugger.RestartFrameResult)
 	command := NewCommand(protocol.Socket, "Debugger.restartFrame", params)
 	result := &debugger.RestartFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -296,13 +431,24 @@ Resume resumes JavaScript execution.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-resume
 */
 func (protocol *DebuggerProtocol) Resume() <-chan *debugger.ResumeResult {
+	return protocol.ResumeContext(context.Background())
+}
+
+/*
+ResumeContext is the context.Context-aware version of Resume.
+*/
+func (protocol *DebuggerProtocol) ResumeContext(
+	ctx context.Context,
+) <-chan *debugger.ResumeResult {
 	resultChan := make(chan *debugger.ResumeResult)
 	command := NewCommand(protocol.Socket, "Debugger.resume", nil)
 	result := &debugger.ResumeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -323,13 +469,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-schedule
 EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *DebuggerProtocol) ScheduleStepIntoAsync() <-chan *debugger.ScheduleStepIntoAsyncResult {
+	return protocol.ScheduleStepIntoAsyncContext(context.Background())
+}
+
+/*
+ScheduleStepIntoAsyncContext is the context.Context-aware version of
+ScheduleStepIntoAsync.
+*/
+func (protocol *DebuggerProtocol) ScheduleStepIntoAsyncContext(
+	ctx context.Context,
+) <-chan *debugger.ScheduleStepIntoAsyncResult {
 	resultChan := make(chan *debugger.ScheduleStepIntoAsyncResult)
 	command := NewCommand(protocol.Socket, "Debugger.scheduleStepIntoAsync", nil)
 	result := &debugger.ScheduleStepIntoAsyncResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -346,14 +504,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-searchIn
 */
 func (protocol *DebuggerProtocol) SearchInContent(
 	params *debugger.SearchInContentParams,
+) <-chan *debugger.SearchInContentResult {
+	return protocol.SearchInContentContext(context.Background(), params)
+}
+
+/*
+SearchInContentContext is the context.Context-aware version of SearchInContent.
+*/
+func (protocol *DebuggerProtocol) SearchInContentContext(
+	ctx context.Context,
+	params *debugger.SearchInContentParams,
 ) <-chan *debugger.SearchInContentResult {
 	resultChan := make(chan *debugger.SearchInContentResult)
 	command := NewCommand(protocol.Socket, "Debugger.searchInContent", params)
 	result := &debugger.SearchInContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -372,14 +542,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setAsync
 */
 func (protocol *DebuggerProtocol) SetAsyncCallStackDepth(
 	params *debugger.SetAsyncCallStackDepthParams,
+) <-chan *debugger.SetAsyncCallStackDepthResult {
+	return protocol.SetAsyncCallStackDepthContext(context.Background(), params)
+}
+
+/*
+SetAsyncCallStackDepthContext is the context.Context-aware version of
+SetAsyncCallStackDepth.
+*/
+func (protocol *DebuggerProtocol) SetAsyncCallStackDepthContext(
+	ctx context.Context,
+	params *debugger.SetAsyncCallStackDepthParams,
 ) <-chan *debugger.SetAsyncCallStackDepthResult {
 	resultChan := make(chan *debugger.SetAsyncCallStackDepthResult)
 	command := NewCommand(protocol.Socket, "Debugger.setAsyncCallStackDepth", params)
 	result := &debugger.SetAsyncCallStackDepthResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -401,14 +584,27 @@ EXPERIMENTAL.
 */
 func (protocol *DebuggerProtocol) SetBlackboxPatterns(
 	params *debugger.SetBlackboxPatternsParams,
+) <-chan *debugger.SetBlackboxPatternsResult {
+	return protocol.SetBlackboxPatternsContext(context.Background(), params)
+}
+
+/*
+SetBlackboxPatternsContext is the context.Context-aware version of
+SetBlackboxPatterns.
+*/
+func (protocol *DebuggerProtocol) SetBlackboxPatternsContext(
+	ctx context.Context,
+	params *debugger.SetBlackboxPatternsParams,
 ) <-chan *debugger.SetBlackboxPatternsResult {
 	resultChan := make(chan *debugger.SetBlackboxPatternsResult)
 	command := NewCommand(protocol.Socket, "Debugger.setBlackboxPatterns", params)
 	result := &debugger.SetBlackboxPatternsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -428,14 +624,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlack
 */
 func (protocol *DebuggerProtocol) SetBlackboxedRanges(
 	params *debugger.SetBlackboxedRangesParams,
+) <-chan *debugger.SetBlackboxedRangesResult {
+	return protocol.SetBlackboxedRangesContext(context.Background(), params)
+}
+
+/*
+SetBlackboxedRangesContext is the context.Context-aware version of
+SetBlackboxedRanges.
+*/
+func (protocol *DebuggerProtocol) SetBlackboxedRangesContext(
+	ctx context.Context,
+	params *debugger.SetBlackboxedRangesParams,
 ) <-chan *debugger.SetBlackboxedRangesResult {
 	resultChan := make(chan *debugger.SetBlackboxedRangesResult)
 	command := NewCommand(protocol.Socket, "Debugger.setBlackboxedRanges", params)
 	result := &debugger.SetBlackboxedRangesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -452,14 +661,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreak
 */
 func (protocol *DebuggerProtocol) SetBreakpoint(
 	params *debugger.SetBreakpointParams,
+) <-chan *debugger.SetBreakpointResult {
+	return protocol.SetBreakpointContext(context.Background(), params)
+}
+
+/*
+SetBreakpointContext is the context.Context-aware version of SetBreakpoint.
+*/
+func (protocol *DebuggerProtocol) SetBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointParams,
 ) <-chan *debugger.SetBreakpointResult {
 	resultChan := make(chan *debugger.SetBreakpointResult)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpoint", params)
 	result := &debugger.SetBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -481,14 +702,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreak
 */
 func (protocol *DebuggerProtocol) SetBreakpointByURL(
 	params *debugger.SetBreakpointByURLParams,
+) <-chan *debugger.SetBreakpointByURLResult {
+	return protocol.SetBreakpointByURLContext(context.Background(), params)
+}
+
+/*
+SetBreakpointByURLContext is the context.Context-aware version of
+SetBreakpointByURL.
+*/
+func (protocol *DebuggerProtocol) SetBreakpointByURLContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointByURLParams,
 ) <-chan *debugger.SetBreakpointByURLResult {
 	resultChan := make(chan *debugger.SetBreakpointByURLResult)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpointByUrl", params)
 	result := &debugger.SetBreakpointByURLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -507,14 +741,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreak
 */
 func (protocol *DebuggerProtocol) SetBreakpointsActive(
 	params *debugger.SetBreakpointsActiveParams,
+) <-chan *debugger.SetBreakpointsActiveResult {
+	return protocol.SetBreakpointsActiveContext(context.Background(), params)
+}
+
+/*
+SetBreakpointsActiveContext is the context.Context-aware version of
+SetBreakpointsActive.
+*/
+func (protocol *DebuggerProtocol) SetBreakpointsActiveContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointsActiveParams,
 ) <-chan *debugger.SetBreakpointsActiveResult {
 	resultChan := make(chan *debugger.SetBreakpointsActiveResult)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpointsActive", params)
 	result := &debugger.SetBreakpointsActiveResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -532,14 +779,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setPause
 */
 func (protocol *DebuggerProtocol) SetPauseOnExceptions(
 	params *debugger.SetPauseOnExceptionsParams,
+) <-chan *debugger.SetPauseOnExceptionsResult {
+	return protocol.SetPauseOnExceptionsContext(context.Background(), params)
+}
+
+/*
+SetPauseOnExceptionsContext is the context.Context-aware version of
+SetPauseOnExceptions.
+*/
+func (protocol *DebuggerProtocol) SetPauseOnExceptionsContext(
+	ctx context.Context,
+	params *debugger.SetPauseOnExceptionsParams,
 ) <-chan *debugger.SetPauseOnExceptionsResult {
 	resultChan := make(chan *debugger.SetPauseOnExceptionsResult)
 	command := NewCommand(protocol.Socket, "Debugger.setPauseOnExceptions", params)
 	result := &debugger.SetPauseOnExceptionsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -557,14 +817,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setRetur
 */
 func (protocol *DebuggerProtocol) SetReturnValue(
 	params *debugger.SetReturnValueParams,
+) <-chan *debugger.SetReturnValueResult {
+	return protocol.SetReturnValueContext(context.Background(), params)
+}
+
+/*
+SetReturnValueContext is the context.Context-aware version of SetReturnValue.
+*/
+func (protocol *DebuggerProtocol) SetReturnValueContext(
+	ctx context.Context,
+	params *debugger.SetReturnValueParams,
 ) <-chan *debugger.SetReturnValueResult {
 	resultChan := make(chan *debugger.SetReturnValueResult)
 	command := NewCommand(protocol.Socket, "Debugger.setReturnValue", params)
 	result := &debugger.SetReturnValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -581,14 +853,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setScrip
 */
 func (protocol *DebuggerProtocol) SetScriptSource(
 	params *debugger.SetScriptSourceParams,
+) <-chan *debugger.SetScriptSourceResult {
+	return protocol.SetScriptSourceContext(context.Background(), params)
+}
+
+/*
+SetScriptSourceContext is the context.Context-aware version of SetScriptSource.
+*/
+func (protocol *DebuggerProtocol) SetScriptSourceContext(
+	ctx context.Context,
+	params *debugger.SetScriptSourceParams,
 ) <-chan *debugger.SetScriptSourceResult {
 	resultChan := make(chan *debugger.SetScriptSourceResult)
 	command := NewCommand(protocol.Socket, "Debugger.setScriptSource", params)
 	result := &debugger.SetScriptSourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -607,14 +891,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setSkipA
 */
 func (protocol *DebuggerProtocol) SetSkipAllPauses(
 	params *debugger.SetSkipAllPausesParams,
+) <-chan *debugger.SetSkipAllPausesResult {
+	return protocol.SetSkipAllPausesContext(context.Background(), params)
+}
+
+/*
+SetSkipAllPausesContext is the context.Context-aware version of
+SetSkipAllPauses.
+*/
+func (protocol *DebuggerProtocol) SetSkipAllPausesContext(
+	ctx context.Context,
+	params *debugger.SetSkipAllPausesParams,
 ) <-chan *debugger.SetSkipAllPausesResult {
 	resultChan := make(chan *debugger.SetSkipAllPausesResult)
 	command := NewCommand(protocol.Socket, "Debugger.setSkipAllPauses", params)
 	result := &debugger.SetSkipAllPausesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -632,14 +929,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setVaria
 */
 func (protocol *DebuggerProtocol) SetVariableValue(
 	params *debugger.SetVariableValueParams,
+) <-chan *debugger.SetVariableValueResult {
+	return protocol.SetVariableValueContext(context.Background(), params)
+}
+
+/*
+SetVariableValueContext is the context.Context-aware version of
+SetVariableValue.
+*/
+func (protocol *DebuggerProtocol) SetVariableValueContext(
+	ctx context.Context,
+	params *debugger.SetVariableValueParams,
 ) <-chan *debugger.SetVariableValueResult {
 	resultChan := make(chan *debugger.SetVariableValueResult)
 	command := NewCommand(protocol.Socket, "Debugger.setVariableValue", params)
 	result := &debugger.SetVariableValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -656,14 +966,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepInto
 */
 func (protocol *DebuggerProtocol) StepInto(
 	params *debugger.StepIntoParams,
+) <-chan *debugger.StepIntoResult {
+	return protocol.StepIntoContext(context.Background(), params)
+}
+
+/*
+StepIntoContext is the context.Context-aware version of StepInto.
+*/
+func (protocol *DebuggerProtocol) StepIntoContext(
+	ctx context.Context,
+	params *debugger.StepIntoParams,
 ) <-chan *debugger.StepIntoResult {
 	resultChan := make(chan *debugger.StepIntoResult)
 	command := NewCommand(protocol.Socket, "Debugger.stepInto", params)
 	result := &debugger.StepIntoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -679,13 +1001,24 @@ StepOut steps out of the function call.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOut
 */
 func (protocol *DebuggerProtocol) StepOut() <-chan *debugger.StepOutResult {
+	return protocol.StepOutContext(context.Background())
+}
+
+/*
+StepOutContext is the context.Context-aware version of StepOut.
+*/
+func (protocol *DebuggerProtocol) StepOutContext(
+	ctx context.Context,
+) <-chan *debugger.StepOutResult {
 	resultChan := make(chan *debugger.StepOutResult)
 	command := NewCommand(protocol.Socket, "Debugger.stepOut", nil)
 	result := &debugger.StepOutResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -701,13 +1034,24 @@ StepOver steps over the statement.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOver
 */
 func (protocol *DebuggerProtocol) StepOver() <-chan *debugger.StepOverResult {
+	return protocol.StepOverContext(context.Background())
+}
+
+/*
+StepOverContext is the context.Context-aware version of StepOver.
+*/
+func (protocol *DebuggerProtocol) StepOverContext(
+	ctx context.Context,
+) <-chan *debugger.StepOverResult {
 	resultChan := make(chan *debugger.StepOverResult)
 	command := NewCommand(protocol.Socket, "Debugger.stepOver", nil)
 	result := &debugger.StepOverResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -725,7 +1069,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-breakpoin
 */
 func (protocol *DebuggerProtocol) OnBreakpointResolved(
 	callback func(event *debugger.BreakpointResolvedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.breakpointResolved",
+		func(response *Response) {
+			event := &debugger.BreakpointResolvedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+BreakpointResolvedEvents returns a channel that receives each
+Debugger.breakpointResolved event. The channel is closed when the context is
+done or the socket stops listening. See OnBreakpointResolved.
+*/
+func (protocol *DebuggerProtocol) BreakpointResolvedEvents(
+	ctx context.Context,
+) <-chan *debugger.BreakpointResolvedEvent {
+	eventChan := make(chan *debugger.BreakpointResolvedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Debugger.breakpointResolved")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &debugger.BreakpointResolvedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForBreakpointResolved subscribes to the Debugger.breakpointResolved event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *DebuggerProtocol) WaitForBreakpointResolved(
+	ctx context.Context,
+	predicate func(event *debugger.BreakpointResolvedEvent) bool,
+) <-chan *debugger.BreakpointResolvedEvent {
+	eventChan := make(chan *debugger.BreakpointResolvedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Debugger.breakpointResolved",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &debugger.BreakpointResolvedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &debugger.BreakpointResolvedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnBreakpointResolvedOnce adds a handler to the Debugger.breakpointResolved event
+that is removed after the first event is received. See OnBreakpointResolved.
+*/
+func (protocol *DebuggerProtocol) OnBreakpointResolvedOnce(
+	callback func(event *debugger.BreakpointResolvedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.breakpointResolved",
 		func(response *Response) {
@@ -737,7 +1172,7 @@ func (protocol *DebuggerProtocol) OnBreakpointResolved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -748,7 +1183,97 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-paused
 */
 func (protocol *DebuggerProtocol) OnPaused(
 	callback func(event *debugger.PausedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.paused",
+		func(response *Response) {
+			event := &debugger.PausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+PausedEvents returns a channel that receives each Debugger.paused event. The
+channel is closed when the context is done or the socket stops listening. See
+OnPaused.
+*/
+func (protocol *DebuggerProtocol) PausedEvents(
+	ctx context.Context,
+) <-chan *debugger.PausedEvent {
+	eventChan := make(chan *debugger.PausedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Debugger.paused")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &debugger.PausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForPaused subscribes to the Debugger.paused event and returns a channel that
+receives the first event for which the predicate returns true. A nil predicate
+matches any event. If the context is done or the socket stops listening first,
+an event containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *DebuggerProtocol) WaitForPaused(
+	ctx context.Context,
+	predicate func(event *debugger.PausedEvent) bool,
+) <-chan *debugger.PausedEvent {
+	eventChan := make(chan *debugger.PausedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Debugger.paused",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &debugger.PausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &debugger.PausedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnPausedOnce adds a handler to the Debugger.paused event that is removed after
+the first event is received. See OnPaused.
+*/
+func (protocol *DebuggerProtocol) OnPausedOnce(
+	callback func(event *debugger.PausedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.paused",
 		func(response *Response) {
@@ -760,7 +1285,7 @@ func (protocol *DebuggerProtocol) OnPaused(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -771,7 +1296,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-resumed
 */
 func (protocol *DebuggerProtocol) OnResumed(
 	callback func(event *debugger.ResumedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.resumed",
+		func(response *Response) {
+			event := &debugger.ResumedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ResumedEvents returns a channel that receives each Debugger.resumed event. The
+channel is closed when the context is done or the socket stops listening. See
+OnResumed.
+*/
+func (protocol *DebuggerProtocol) ResumedEvents(
+	ctx context.Context,
+) <-chan *debugger.ResumedEvent {
+	eventChan := make(chan *debugger.ResumedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Debugger.resumed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &debugger.ResumedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForResumed subscribes to the Debugger.resumed event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DebuggerProtocol) WaitForResumed(
+	ctx context.Context,
+	predicate func(event *debugger.ResumedEvent) bool,
+) <-chan *debugger.ResumedEvent {
+	eventChan := make(chan *debugger.ResumedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Debugger.resumed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &debugger.ResumedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &debugger.ResumedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnResumedOnce adds a handler to the Debugger.resumed event that is removed after
+the first event is received. See OnResumed.
+*/
+func (protocol *DebuggerProtocol) OnResumedOnce(
+	callback func(event *debugger.ResumedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.resumed",
 		func(response *Response) {
@@ -783,7 +1399,7 @@ func (protocol *DebuggerProtocol) OnResumed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -794,7 +1410,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptFai
 */
 func (protocol *DebuggerProtocol) OnScriptFailedToParse(
 	callback func(event *debugger.ScriptFailedToParseEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.scriptFailedToParse",
+		func(response *Response) {
+			event := &debugger.ScriptFailedToParseEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ScriptFailedToParseEvents returns a channel that receives each
+Debugger.scriptFailedToParse event. The channel is closed when the context is
+done or the socket stops listening. See OnScriptFailedToParse.
+*/
+func (protocol *DebuggerProtocol) ScriptFailedToParseEvents(
+	ctx context.Context,
+) <-chan *debugger.ScriptFailedToParseEvent {
+	eventChan := make(chan *debugger.ScriptFailedToParseEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Debugger.scriptFailedToParse")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &debugger.ScriptFailedToParseEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForScriptFailedToParse subscribes to the Debugger.scriptFailedToParse event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *DebuggerProtocol) WaitForScriptFailedToParse(
+	ctx context.Context,
+	predicate func(event *debugger.ScriptFailedToParseEvent) bool,
+) <-chan *debugger.ScriptFailedToParseEvent {
+	eventChan := make(chan *debugger.ScriptFailedToParseEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Debugger.scriptFailedToParse",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &debugger.ScriptFailedToParseEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &debugger.ScriptFailedToParseEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnScriptFailedToParseOnce adds a handler to the Debugger.scriptFailedToParse
+event that is removed after the first event is received. See
+OnScriptFailedToParse.
+*/
+func (protocol *DebuggerProtocol) OnScriptFailedToParseOnce(
+	callback func(event *debugger.ScriptFailedToParseEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.scriptFailedToParse",
 		func(response *Response) {
@@ -806,7 +1514,7 @@ func (protocol *DebuggerProtocol) OnScriptFailedToParse(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -818,7 +1526,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptPar
 */
 func (protocol *DebuggerProtocol) OnScriptParsed(
 	callback func(event *debugger.ScriptParsedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.scriptParsed",
+		func(response *Response) {
+			event := &debugger.ScriptParsedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ScriptParsedEvents returns a channel that receives each Debugger.scriptParsed
+event. The channel is closed when the context is done or the socket stops
+listening. See OnScriptParsed.
+*/
+func (protocol *DebuggerProtocol) ScriptParsedEvents(
+	ctx context.Context,
+) <-chan *debugger.ScriptParsedEvent {
+	eventChan := make(chan *debugger.ScriptParsedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Debugger.scriptParsed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &debugger.ScriptParsedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForScriptParsed subscribes to the Debugger.scriptParsed event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DebuggerProtocol) WaitForScriptParsed(
+	ctx context.Context,
+	predicate func(event *debugger.ScriptParsedEvent) bool,
+) <-chan *debugger.ScriptParsedEvent {
+	eventChan := make(chan *debugger.ScriptParsedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Debugger.scriptParsed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &debugger.ScriptParsedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &debugger.ScriptParsedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnScriptParsedOnce adds a handler to the Debugger.scriptParsed event that is
+removed after the first event is received. See OnScriptParsed.
+*/
+func (protocol *DebuggerProtocol) OnScriptParsedOnce(
+	callback func(event *debugger.ScriptParsedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.scriptParsed",
 		func(response *Response) {
@@ -830,5 +1629,5 @@ func (protocol *DebuggerProtocol) OnScriptParsed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,8 @@
 package socket
 
 import (
+	"context"
+
 	"github.com/mkenney/go-chrome/tot/device/orientation"
 )
 
@@ -21,13 +23,24 @@ ClearOverride clears the overridden Device Orientation.
 https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-clearDeviceOrientationOverride
 */
 func (protocol *DeviceOrientationProtocol) ClearOverride() <-chan *orientation.ClearOverrideResult {
+	return protocol.ClearOverrideContext(context.Background())
+}
+
+/*
+ClearOverrideContext is the context.Context-aware version of ClearOverride.
+*/
+func (protocol *DeviceOrientationProtocol) ClearOverrideContext(
+	ctx context.Context,
+) <-chan *orientation.ClearOverrideResult {
 	resultChan := make(chan *orientation.ClearOverrideResult)
 	command := NewCommand(protocol.Socket, "DeviceOrientation.clearDeviceOrientationOverride", nil)
 	result := &orientation.ClearOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -44,14 +57,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method
 */
 func (protocol *DeviceOrientationProtocol) SetOverride(
 	params *orientation.SetOverrideParams,
+) <-chan *orientation.SetOverrideResult {
+	return protocol.SetOverrideContext(context.Background(), params)
+}
+
+/*
+SetOverrideContext is the context.Context-aware version of SetOverride.
+*/
+func (protocol *DeviceOrientationProtocol) SetOverrideContext(
+	ctx context.Context,
+	params *orientation.SetOverrideParams,
 ) <-chan *orientation.SetOverrideResult {
 	resultChan := make(chan *orientation.SetOverrideResult)
 	command := NewCommand(protocol.Socket, "DeviceOrientation.setDeviceOrientationOverride", params)
 	result := &orientation.SetOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
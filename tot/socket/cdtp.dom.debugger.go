@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/debugger"
@@ -25,14 +26,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-getEv
 */
 func (protocol *DOMDebuggerProtocol) GetEventListeners(
 	params *debugger.GetEventListenersParams,
+) <-chan *debugger.GetEventListenersResult {
+	return protocol.GetEventListenersContext(context.Background(), params)
+}
+
+/*
+GetEventListenersContext is the context.Context-aware version of
+GetEventListeners.
+*/
+func (protocol *DOMDebuggerProtocol) GetEventListenersContext(
+	ctx context.Context,
+	params *debugger.GetEventListenersParams,
 ) <-chan *debugger.GetEventListenersResult {
 	resultChan := make(chan *debugger.GetEventListenersResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.getEventListeners", params)
 	result := &debugger.GetEventListenersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -52,14 +66,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-remov
 */
 func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpoint(
 	params *debugger.RemoveDOMBreakpointParams,
+) <-chan *debugger.RemoveDOMBreakpointResult {
+	return protocol.RemoveDOMBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveDOMBreakpointContext is the context.Context-aware version of
+RemoveDOMBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveDOMBreakpointParams,
 ) <-chan *debugger.RemoveDOMBreakpointResult {
 	resultChan := make(chan *debugger.RemoveDOMBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeDOMBreakpoint", params)
 	result := &debugger.RemoveDOMBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -76,14 +103,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-remov
 */
 func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpoint(
 	params *debugger.RemoveEventListenerBreakpointParams,
+) <-chan *debugger.RemoveEventListenerBreakpointResult {
+	return protocol.RemoveEventListenerBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveEventListenerBreakpointContext is the context.Context-aware version of
+RemoveEventListenerBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveEventListenerBreakpointParams,
 ) <-chan *debugger.RemoveEventListenerBreakpointResult {
 	resultChan := make(chan *debugger.RemoveEventListenerBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeEventListenerBreakpoint", params)
 	result := &debugger.RemoveEventListenerBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -101,14 +141,27 @@ EXPERIMENTAL.
 */
 func (protocol *DOMDebuggerProtocol) RemoveInstrumentationBreakpoint(
 	params *debugger.RemoveInstrumentationBreakpointParams,
+) <-chan *debugger.RemoveInstrumentationBreakpointResult {
+	return protocol.RemoveInstrumentationBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveInstrumentationBreakpointContext is the context.Context-aware version of
+RemoveInstrumentationBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) RemoveInstrumentationBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveInstrumentationBreakpointParams,
 ) <-chan *debugger.RemoveInstrumentationBreakpointResult {
 	resultChan := make(chan *debugger.RemoveInstrumentationBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeInstrumentationBreakpoint", params)
 	result := &debugger.RemoveInstrumentationBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -125,14 +178,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-remov
 */
 func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpoint(
 	params *debugger.RemoveXHRBreakpointParams,
+) <-chan *debugger.RemoveXHRBreakpointResult {
+	return protocol.RemoveXHRBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveXHRBreakpointContext is the context.Context-aware version of
+RemoveXHRBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveXHRBreakpointParams,
 ) <-chan *debugger.RemoveXHRBreakpointResult {
 	resultChan := make(chan *debugger.RemoveXHRBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeXHRBreakpoint", params)
 	result := &debugger.RemoveXHRBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -149,14 +215,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setDO
 */
 func (protocol *DOMDebuggerProtocol) SetDOMBreakpoint(
 	params *debugger.SetDOMBreakpointParams,
+) <-chan *debugger.SetDOMBreakpointResult {
+	return protocol.SetDOMBreakpointContext(context.Background(), params)
+}
+
+/*
+SetDOMBreakpointContext is the context.Context-aware version of
+SetDOMBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) SetDOMBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetDOMBreakpointParams,
 ) <-chan *debugger.SetDOMBreakpointResult {
 	resultChan := make(chan *debugger.SetDOMBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setDOMBreakpoint", params)
 	result := &debugger.SetDOMBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -173,14 +252,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setEv
 */
 func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpoint(
 	params *debugger.SetEventListenerBreakpointParams,
+) <-chan *debugger.SetEventListenerBreakpointResult {
+	return protocol.SetEventListenerBreakpointContext(context.Background(), params)
+}
+
+/*
+SetEventListenerBreakpointContext is the context.Context-aware version of
+SetEventListenerBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetEventListenerBreakpointParams,
 ) <-chan *debugger.SetEventListenerBreakpointResult {
 	resultChan := make(chan *debugger.SetEventListenerBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setEventListenerBreakpoint", params)
 	result := &debugger.SetEventListenerBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -198,14 +290,27 @@ EXPERIMENTAL.
 */
 func (protocol *DOMDebuggerProtocol) SetInstrumentationBreakpoint(
 	params *debugger.SetInstrumentationBreakpointParams,
+) <-chan *debugger.SetInstrumentationBreakpointResult {
+	return protocol.SetInstrumentationBreakpointContext(context.Background(), params)
+}
+
+/*
+SetInstrumentationBreakpointContext is the context.Context-aware version of
+SetInstrumentationBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) SetInstrumentationBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetInstrumentationBreakpointParams,
 ) <-chan *debugger.SetInstrumentationBreakpointResult {
 	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setInstrumentationBreakpoint", params)
 	result := &debugger.SetInstrumentationBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -222,14 +327,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setXH
 */
 func (protocol *DOMDebuggerProtocol) SetXHRBreakpoint(
 	params *debugger.SetXHRBreakpointParams,
+) <-chan *debugger.SetXHRBreakpointResult {
+	return protocol.SetXHRBreakpointContext(context.Background(), params)
+}
+
+/*
+SetXHRBreakpointContext is the context.Context-aware version of
+SetXHRBreakpoint.
+*/
+func (protocol *DOMDebuggerProtocol) SetXHRBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetXHRBreakpointParams,
 ) <-chan *debugger.SetXHRBreakpointResult {
 	resultChan := make(chan *debugger.SetXHRBreakpointResult)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setXHRBreakpoint", params)
 	result := &debugger.SetXHRBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
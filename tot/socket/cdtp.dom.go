@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom"
@@ -33,14 +34,27 @@ EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) CollectClassNamesFromSubtree(
 	params *dom.CollectClassNamesFromSubtreeParams,
+) <-chan *dom.CollectClassNamesFromSubtreeResult {
+	return protocol.CollectClassNamesFromSubtreeContext(context.Background(), params)
+}
+
+/*
+CollectClassNamesFromSubtreeContext is the context.Context-aware version of
+CollectClassNamesFromSubtree.
+*/
+func (protocol *DOMProtocol) CollectClassNamesFromSubtreeContext(
+	ctx context.Context,
+	params *dom.CollectClassNamesFromSubtreeParams,
 ) <-chan *dom.CollectClassNamesFromSubtreeResult {
 	resultChan := make(chan *dom.CollectClassNamesFromSubtreeResult)
 	command := NewCommand(protocol.Socket, "DOM.collectClassNamesFromSubtree", params)
 	result := &dom.CollectClassNamesFromSubtreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -60,14 +74,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-copyTo EXPERI
 */
 func (protocol *DOMProtocol) CopyTo(
 	params *dom.CopyToParams,
+) <-chan *dom.CopyToResult {
+	return protocol.CopyToContext(context.Background(), params)
+}
+
+/*
+CopyToContext is the context.Context-aware version of CopyTo.
+*/
+func (protocol *DOMProtocol) CopyToContext(
+	ctx context.Context,
+	params *dom.CopyToParams,
 ) <-chan *dom.CopyToResult {
 	resultChan := make(chan *dom.CopyToResult)
 	command := NewCommand(protocol.Socket, "DOM.copyTo", params)
 	result := &dom.CopyToResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -87,14 +113,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-describeNode
 */
 func (protocol *DOMProtocol) DescribeNode(
 	params *dom.DescribeNodeParams,
+) <-chan *dom.DescribeNodeResult {
+	return protocol.DescribeNodeContext(context.Background(), params)
+}
+
+/*
+DescribeNodeContext is the context.Context-aware version of DescribeNode.
+*/
+func (protocol *DOMProtocol) DescribeNodeContext(
+	ctx context.Context,
+	params *dom.DescribeNodeParams,
 ) <-chan *dom.DescribeNodeResult {
 	resultChan := make(chan *dom.DescribeNodeResult)
 	command := NewCommand(protocol.Socket, "DOM.describeNode", params)
 	result := &dom.DescribeNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -112,13 +150,24 @@ Disable disables the DOM agent for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-disable
 */
 func (protocol *DOMProtocol) Disable() <-chan *dom.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *DOMProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *dom.DisableResult {
 	resultChan := make(chan *dom.DisableResult)
 	command := NewCommand(protocol.Socket, "DOM.disable", nil)
 	result := &dom.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -137,14 +186,27 @@ EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) DiscardSearchResults(
 	params *dom.DiscardSearchResultsParams,
+) <-chan *dom.DiscardSearchResultsResult {
+	return protocol.DiscardSearchResultsContext(context.Background(), params)
+}
+
+/*
+DiscardSearchResultsContext is the context.Context-aware version of
+DiscardSearchResults.
+*/
+func (protocol *DOMProtocol) DiscardSearchResultsContext(
+	ctx context.Context,
+	params *dom.DiscardSearchResultsParams,
 ) <-chan *dom.DiscardSearchResultsResult {
 	resultChan := make(chan *dom.DiscardSearchResultsResult)
 	command := NewCommand(protocol.Socket, "DOM.discardSearchResults", params)
 	result := &dom.DiscardSearchResultsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -160,13 +222,24 @@ Enable enables the DOM agent for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-enable
 */
 func (protocol *DOMProtocol) Enable() <-chan *dom.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *DOMProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *dom.EnableResult {
 	resultChan := make(chan *dom.EnableResult)
 	command := NewCommand(protocol.Socket, "DOM.enable", nil)
 	result := &dom.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -183,14 +256,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-focus
 */
 func (protocol *DOMProtocol) Focus(
 	params *dom.FocusParams,
+) <-chan *dom.FocusResult {
+	return protocol.FocusContext(context.Background(), params)
+}
+
+/*
+FocusContext is the context.Context-aware version of Focus.
+*/
+func (protocol *DOMProtocol) FocusContext(
+	ctx context.Context,
+	params *dom.FocusParams,
 ) <-chan *dom.FocusResult {
 	resultChan := make(chan *dom.FocusResult)
 	command := NewCommand(protocol.Socket, "DOM.focus", params)
 	result := &dom.FocusResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -207,14 +292,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAttributes
 */
 func (protocol *DOMProtocol) GetAttributes(
 	params *dom.GetAttributesParams,
+) <-chan *dom.GetAttributesResult {
+	return protocol.GetAttributesContext(context.Background(), params)
+}
+
+/*
+GetAttributesContext is the context.Context-aware version of GetAttributes.
+*/
+func (protocol *DOMProtocol) GetAttributesContext(
+	ctx context.Context,
+	params *dom.GetAttributesParams,
 ) <-chan *dom.GetAttributesResult {
 	resultChan := make(chan *dom.GetAttributesResult)
 	command := NewCommand(protocol.Socket, "DOM.getAttributes", params)
 	result := &dom.GetAttributesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -233,14 +330,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getBoxModel
 */
 func (protocol *DOMProtocol) GetBoxModel(
 	params *dom.GetBoxModelParams,
+) <-chan *dom.GetBoxModelResult {
+	return protocol.GetBoxModelContext(context.Background(), params)
+}
+
+/*
+GetBoxModelContext is the context.Context-aware version of GetBoxModel.
+*/
+func (protocol *DOMProtocol) GetBoxModelContext(
+	ctx context.Context,
+	params *dom.GetBoxModelParams,
 ) <-chan *dom.GetBoxModelResult {
 	resultChan := make(chan *dom.GetBoxModelResult)
 	command := NewCommand(protocol.Socket, "DOM.getBoxModel", params)
 	result := &dom.GetBoxModelResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -260,14 +369,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getDocument
 */
 func (protocol *DOMProtocol) GetDocument(
 	params *dom.GetDocumentParams,
+) <-chan *dom.GetDocumentResult {
+	return protocol.GetDocumentContext(context.Background(), params)
+}
+
+/*
+GetDocumentContext is the context.Context-aware version of GetDocument.
+*/
+func (protocol *DOMProtocol) GetDocumentContext(
+	ctx context.Context,
+	params *dom.GetDocumentParams,
 ) <-chan *dom.GetDocumentResult {
 	resultChan := make(chan *dom.GetDocumentResult)
 	command := NewCommand(protocol.Socket, "DOM.getDocument", params)
 	result := &dom.GetDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -287,14 +408,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFlattenedD
 */
 func (protocol *DOMProtocol) GetFlattenedDocument(
 	params *dom.GetFlattenedDocumentParams,
+) <-chan *dom.GetFlattenedDocumentResult {
+	return protocol.GetFlattenedDocumentContext(context.Background(), params)
+}
+
+/*
+GetFlattenedDocumentContext is the context.Context-aware version of
+GetFlattenedDocument.
+*/
+func (protocol *DOMProtocol) GetFlattenedDocumentContext(
+	ctx context.Context,
+	params *dom.GetFlattenedDocumentParams,
 ) <-chan *dom.GetFlattenedDocumentResult {
 	resultChan := make(chan *dom.GetFlattenedDocumentResult)
 	command := NewCommand(protocol.Socket, "DOM.getFlattenedDocument", params)
 	result := &dom.GetFlattenedDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -313,14 +447,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeForLoc
 */
 func (protocol *DOMProtocol) GetNodeForLocation(
 	params *dom.GetNodeForLocationParams,
+) <-chan *dom.GetNodeForLocationResult {
+	return protocol.GetNodeForLocationContext(context.Background(), params)
+}
+
+/*
+GetNodeForLocationContext is the context.Context-aware version of
+GetNodeForLocation.
+*/
+func (protocol *DOMProtocol) GetNodeForLocationContext(
+	ctx context.Context,
+	params *dom.GetNodeForLocationParams,
 ) <-chan *dom.GetNodeForLocationResult {
 	resultChan := make(chan *dom.GetNodeForLocationResult)
 	command := NewCommand(protocol.Socket, "DOM.getNodeForLocation", params)
 	result := &dom.GetNodeForLocationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -339,14 +486,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getOuterHTML
 */
 func (protocol *DOMProtocol) GetOuterHTML(
 	params *dom.GetOuterHTMLParams,
+) <-chan *dom.GetOuterHTMLResult {
+	return protocol.GetOuterHTMLContext(context.Background(), params)
+}
+
+/*
+GetOuterHTMLContext is the context.Context-aware version of GetOuterHTML.
+*/
+func (protocol *DOMProtocol) GetOuterHTMLContext(
+	ctx context.Context,
+	params *dom.GetOuterHTMLParams,
 ) <-chan *dom.GetOuterHTMLResult {
 	resultChan := make(chan *dom.GetOuterHTMLResult)
 	command := NewCommand(protocol.Socket, "DOM.getOuterHTML", params)
 	result := &dom.GetOuterHTMLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -366,14 +525,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getRelayoutBo
 */
 func (protocol *DOMProtocol) GetRelayoutBoundary(
 	params *dom.GetRelayoutBoundaryParams,
+) <-chan *dom.GetRelayoutBoundaryResult {
+	return protocol.GetRelayoutBoundaryContext(context.Background(), params)
+}
+
+/*
+GetRelayoutBoundaryContext is the context.Context-aware version of
+GetRelayoutBoundary.
+*/
+func (protocol *DOMProtocol) GetRelayoutBoundaryContext(
+	ctx context.Context,
+	params *dom.GetRelayoutBoundaryParams,
 ) <-chan *dom.GetRelayoutBoundaryResult {
 	resultChan := make(chan *dom.GetRelayoutBoundaryResult)
 	command := NewCommand(protocol.Socket, "DOM.getRelayoutBoundary", params)
 	result := &dom.GetRelayoutBoundaryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -394,14 +566,27 @@ EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) GetSearchResults(
 	params *dom.GetSearchResultsParams,
+) <-chan *dom.GetSearchResultsResult {
+	return protocol.GetSearchResultsContext(context.Background(), params)
+}
+
+/*
+GetSearchResultsContext is the context.Context-aware version of
+GetSearchResults.
+*/
+func (protocol *DOMProtocol) GetSearchResultsContext(
+	ctx context.Context,
+	params *dom.GetSearchResultsParams,
 ) <-chan *dom.GetSearchResultsResult {
 	resultChan := make(chan *dom.GetSearchResultsResult)
 	command := NewCommand(protocol.Socket, "DOM.getSearchResults", params)
 	result := &dom.GetSearchResultsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -420,13 +605,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-markUndoableS
 EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) MarkUndoableState() <-chan *dom.MarkUndoableStateResult {
+	return protocol.MarkUndoableStateContext(context.Background())
+}
+
+/*
+MarkUndoableStateContext is the context.Context-aware version of
+MarkUndoableState.
+*/
+func (protocol *DOMProtocol) MarkUndoableStateContext(
+	ctx context.Context,
+) <-chan *dom.MarkUndoableStateResult {
 	resultChan := make(chan *dom.MarkUndoableStateResult)
 	command := NewCommand(protocol.Socket, "DOM.markUndoableState", nil)
 	result := &dom.MarkUndoableStateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -443,14 +640,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-moveTo
 */
 func (protocol *DOMProtocol) MoveTo(
 	params *dom.MoveToParams,
+) <-chan *dom.MoveToResult {
+	return protocol.MoveToContext(context.Background(), params)
+}
+
+/*
+MoveToContext is the context.Context-aware version of MoveTo.
+*/
+func (protocol *DOMProtocol) MoveToContext(
+	ctx context.Context,
+	params *dom.MoveToParams,
 ) <-chan *dom.MoveToResult {
 	resultChan := make(chan *dom.MoveToResult)
 	command := NewCommand(protocol.Socket, "DOM.moveTo", params)
 	result := &dom.MoveToResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -471,14 +680,26 @@ EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) PerformSearch(
 	params *dom.PerformSearchParams,
+) <-chan *dom.PerformSearchResult {
+	return protocol.PerformSearchContext(context.Background(), params)
+}
+
+/*
+PerformSearchContext is the context.Context-aware version of PerformSearch.
+*/
+func (protocol *DOMProtocol) PerformSearchContext(
+	ctx context.Context,
+	params *dom.PerformSearchParams,
 ) <-chan *dom.PerformSearchResult {
 	resultChan := make(chan *dom.PerformSearchResult)
 	command := NewCommand(protocol.Socket, "DOM.performSearch", params)
 	result := &dom.PerformSearchResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -499,14 +720,27 @@ EXPERIMENTAL. @TODO, use XPath.
 */
 func (protocol *DOMProtocol) PushNodeByPathToFrontend(
 	params *dom.PushNodeByPathToFrontendParams,
+) <-chan *dom.PushNodeByPathToFrontendResult {
+	return protocol.PushNodeByPathToFrontendContext(context.Background(), params)
+}
+
+/*
+PushNodeByPathToFrontendContext is the context.Context-aware version of
+PushNodeByPathToFrontend.
+*/
+func (protocol *DOMProtocol) PushNodeByPathToFrontendContext(
+	ctx context.Context,
+	params *dom.PushNodeByPathToFrontendParams,
 ) <-chan *dom.PushNodeByPathToFrontendResult {
 	resultChan := make(chan *dom.PushNodeByPathToFrontendResult)
 	command := NewCommand(protocol.Socket, "DOM.pushNodeByPathToFrontend", params)
 	result := &dom.PushNodeByPathToFrontendResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -527,14 +761,27 @@ EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) PushNodesByBackendIDsToFrontend(
 	params *dom.PushNodesByBackendIDsToFrontendParams,
+) <-chan *dom.PushNodesByBackendIDsToFrontendResult {
+	return protocol.PushNodesByBackendIDsToFrontendContext(context.Background(), params)
+}
+
+/*
+PushNodesByBackendIDsToFrontendContext is the context.Context-aware version of
+PushNodesByBackendIDsToFrontend.
+*/
+func (protocol *DOMProtocol) PushNodesByBackendIDsToFrontendContext(
+	ctx context.Context,
+	params *dom.PushNodesByBackendIDsToFrontendParams,
 ) <-chan *dom.PushNodesByBackendIDsToFrontendResult {
 	resultChan := make(chan *dom.PushNodesByBackendIDsToFrontendResult)
 	command := NewCommand(protocol.Socket, "DOM.pushNodesByBackendIdsToFrontend", params)
 	result := &dom.PushNodesByBackendIDsToFrontendResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -553,14 +800,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelector
 */
 func (protocol *DOMProtocol) QuerySelector(
 	params *dom.QuerySelectorParams,
+) <-chan *dom.QuerySelectorResult {
+	return protocol.QuerySelectorContext(context.Background(), params)
+}
+
+/*
+QuerySelectorContext is the context.Context-aware version of QuerySelector.
+*/
+func (protocol *DOMProtocol) QuerySelectorContext(
+	ctx context.Context,
+	params *dom.QuerySelectorParams,
 ) <-chan *dom.QuerySelectorResult {
 	resultChan := make(chan *dom.QuerySelectorResult)
 	command := NewCommand(protocol.Socket, "DOM.querySelector", params)
 	result := &dom.QuerySelectorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -579,14 +838,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelector
 */
 func (protocol *DOMProtocol) QuerySelectorAll(
 	params *dom.QuerySelectorAllParams,
+) <-chan *dom.QuerySelectorAllResult {
+	return protocol.QuerySelectorAllContext(context.Background(), params)
+}
+
+/*
+QuerySelectorAllContext is the context.Context-aware version of
+QuerySelectorAll.
+*/
+func (protocol *DOMProtocol) QuerySelectorAllContext(
+	ctx context.Context,
+	params *dom.QuerySelectorAllParams,
 ) <-chan *dom.QuerySelectorAllResult {
 	resultChan := make(chan *dom.QuerySelectorAllResult)
 	command := NewCommand(protocol.Socket, "DOM.querySelectorAll", params)
 	result := &dom.QuerySelectorAllResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -604,13 +876,24 @@ Redo re-does the last undone action.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-redo EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) Redo() <-chan *dom.RedoResult {
+	return protocol.RedoContext(context.Background())
+}
+
+/*
+RedoContext is the context.Context-aware version of Redo.
+*/
+func (protocol *DOMProtocol) RedoContext(
+	ctx context.Context,
+) <-chan *dom.RedoResult {
 	resultChan := make(chan *dom.RedoResult)
 	command := NewCommand(protocol.Socket, "DOM.redo", nil)
 	result := &dom.RedoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -627,14 +910,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeAttribu
 */
 func (protocol *DOMProtocol) RemoveAttribute(
 	params *dom.RemoveAttributeParams,
+) <-chan *dom.RemoveAttributeResult {
+	return protocol.RemoveAttributeContext(context.Background(), params)
+}
+
+/*
+RemoveAttributeContext is the context.Context-aware version of RemoveAttribute.
+*/
+func (protocol *DOMProtocol) RemoveAttributeContext(
+	ctx context.Context,
+	params *dom.RemoveAttributeParams,
 ) <-chan *dom.RemoveAttributeResult {
 	resultChan := make(chan *dom.RemoveAttributeResult)
 	command := NewCommand(protocol.Socket, "DOM.removeAttribute", params)
 	result := &dom.RemoveAttributeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -651,14 +946,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeNode
 */
 func (protocol *DOMProtocol) RemoveNode(
 	params *dom.RemoveNodeParams,
+) <-chan *dom.RemoveNodeResult {
+	return protocol.RemoveNodeContext(context.Background(), params)
+}
+
+/*
+RemoveNodeContext is the context.Context-aware version of RemoveNode.
+*/
+func (protocol *DOMProtocol) RemoveNodeContext(
+	ctx context.Context,
+	params *dom.RemoveNodeParams,
 ) <-chan *dom.RemoveNodeResult {
 	resultChan := make(chan *dom.RemoveNodeResult)
 	command := NewCommand(protocol.Socket, "DOM.removeNode", params)
 	result := &dom.RemoveNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -677,14 +984,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestChildN
 */
 func (protocol *DOMProtocol) RequestChildNodes(
 	params *dom.RequestChildNodesParams,
+) <-chan *dom.RequestChildNodesResult {
+	return protocol.RequestChildNodesContext(context.Background(), params)
+}
+
+/*
+RequestChildNodesContext is the context.Context-aware version of
+RequestChildNodes.
+*/
+func (protocol *DOMProtocol) RequestChildNodesContext(
+	ctx context.Context,
+	params *dom.RequestChildNodesParams,
 ) <-chan *dom.RequestChildNodesResult {
 	resultChan := make(chan *dom.RequestChildNodesResult)
 	command := NewCommand(protocol.Socket, "DOM.requestChildNodes", params)
 	result := &dom.RequestChildNodesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -703,14 +1023,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestNode
 */
 func (protocol *DOMProtocol) RequestNode(
 	params *dom.RequestNodeParams,
+) <-chan *dom.RequestNodeResult {
+	return protocol.RequestNodeContext(context.Background(), params)
+}
+
+/*
+RequestNodeContext is the context.Context-aware version of RequestNode.
+*/
+func (protocol *DOMProtocol) RequestNodeContext(
+	ctx context.Context,
+	params *dom.RequestNodeParams,
 ) <-chan *dom.RequestNodeResult {
 	resultChan := make(chan *dom.RequestNodeResult)
 	command := NewCommand(protocol.Socket, "DOM.requestNode", params)
 	result := &dom.RequestNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -730,14 +1062,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-resolveNode
 */
 func (protocol *DOMProtocol) ResolveNode(
 	params *dom.ResolveNodeParams,
+) <-chan *dom.ResolveNodeResult {
+	return protocol.ResolveNodeContext(context.Background(), params)
+}
+
+/*
+ResolveNodeContext is the context.Context-aware version of ResolveNode.
+*/
+func (protocol *DOMProtocol) ResolveNodeContext(
+	ctx context.Context,
+	params *dom.ResolveNodeParams,
 ) <-chan *dom.ResolveNodeResult {
 	resultChan := make(chan *dom.ResolveNodeResult)
 	command := NewCommand(protocol.Socket, "DOM.resolveNode", params)
 	result := &dom.ResolveNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -756,14 +1100,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributeV
 */
 func (protocol *DOMProtocol) SetAttributeValue(
 	params *dom.SetAttributeValueParams,
+) <-chan *dom.SetAttributeValueResult {
+	return protocol.SetAttributeValueContext(context.Background(), params)
+}
+
+/*
+SetAttributeValueContext is the context.Context-aware version of
+SetAttributeValue.
+*/
+func (protocol *DOMProtocol) SetAttributeValueContext(
+	ctx context.Context,
+	params *dom.SetAttributeValueParams,
 ) <-chan *dom.SetAttributeValueResult {
 	resultChan := make(chan *dom.SetAttributeValueResult)
 	command := NewCommand(protocol.Socket, "DOM.setAttributeValue", params)
 	result := &dom.SetAttributeValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -782,14 +1139,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributes
 */
 func (protocol *DOMProtocol) SetAttributesAsText(
 	params *dom.SetAttributesAsTextParams,
+) <-chan *dom.SetAttributesAsTextResult {
+	return protocol.SetAttributesAsTextContext(context.Background(), params)
+}
+
+/*
+SetAttributesAsTextContext is the context.Context-aware version of
+SetAttributesAsText.
+*/
+func (protocol *DOMProtocol) SetAttributesAsTextContext(
+	ctx context.Context,
+	params *dom.SetAttributesAsTextParams,
 ) <-chan *dom.SetAttributesAsTextResult {
 	resultChan := make(chan *dom.SetAttributesAsTextResult)
 	command := NewCommand(protocol.Socket, "DOM.setAttributesAsText", params)
 	result := &dom.SetAttributesAsTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -806,14 +1176,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setFileInputF
 */
 func (protocol *DOMProtocol) SetFileInputFiles(
 	params *dom.SetFileInputFilesParams,
+) <-chan *dom.SetFileInputFilesResult {
+	return protocol.SetFileInputFilesContext(context.Background(), params)
+}
+
+/*
+SetFileInputFilesContext is the context.Context-aware version of
+SetFileInputFiles.
+*/
+func (protocol *DOMProtocol) SetFileInputFilesContext(
+	ctx context.Context,
+	params *dom.SetFileInputFilesParams,
 ) <-chan *dom.SetFileInputFilesResult {
 	resultChan := make(chan *dom.SetFileInputFilesResult)
 	command := NewCommand(protocol.Socket, "DOM.setFileInputFiles", params)
 	result := &dom.SetFileInputFilesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -831,14 +1214,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setInspectedN
 */
 func (protocol *DOMProtocol) SetInspectedNode(
 	params *dom.SetInspectedNodeParams,
+) <-chan *dom.SetInspectedNodeResult {
+	return protocol.SetInspectedNodeContext(context.Background(), params)
+}
+
+/*
+SetInspectedNodeContext is the context.Context-aware version of
+SetInspectedNode.
+*/
+func (protocol *DOMProtocol) SetInspectedNodeContext(
+	ctx context.Context,
+	params *dom.SetInspectedNodeParams,
 ) <-chan *dom.SetInspectedNodeResult {
 	resultChan := make(chan *dom.SetInspectedNodeResult)
 	command := NewCommand(protocol.Socket, "DOM.setInspectedNode", params)
 	result := &dom.SetInspectedNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -855,14 +1251,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeName
 */
 func (protocol *DOMProtocol) SetNodeName(
 	params *dom.SetNodeNameParams,
+) <-chan *dom.SetNodeNameResult {
+	return protocol.SetNodeNameContext(context.Background(), params)
+}
+
+/*
+SetNodeNameContext is the context.Context-aware version of SetNodeName.
+*/
+func (protocol *DOMProtocol) SetNodeNameContext(
+	ctx context.Context,
+	params *dom.SetNodeNameParams,
 ) <-chan *dom.SetNodeNameResult {
 	resultChan := make(chan *dom.SetNodeNameResult)
 	command := NewCommand(protocol.Socket, "DOM.setNodeName", params)
 	result := &dom.SetNodeNameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -881,14 +1289,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeValue
 */
 func (protocol *DOMProtocol) SetNodeValue(
 	params *dom.SetNodeValueParams,
+) <-chan *dom.SetNodeValueResult {
+	return protocol.SetNodeValueContext(context.Background(), params)
+}
+
+/*
+SetNodeValueContext is the context.Context-aware version of SetNodeValue.
+*/
+func (protocol *DOMProtocol) SetNodeValueContext(
+	ctx context.Context,
+	params *dom.SetNodeValueParams,
 ) <-chan *dom.SetNodeValueResult {
 	resultChan := make(chan *dom.SetNodeValueResult)
 	command := NewCommand(protocol.Socket, "DOM.setNodeValue", params)
 	result := &dom.SetNodeValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -905,14 +1325,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setOuterHTML
 */
 func (protocol *DOMProtocol) SetOuterHTML(
 	params *dom.SetOuterHTMLParams,
+) <-chan *dom.SetOuterHTMLResult {
+	return protocol.SetOuterHTMLContext(context.Background(), params)
+}
+
+/*
+SetOuterHTMLContext is the context.Context-aware version of SetOuterHTML.
+*/
+func (protocol *DOMProtocol) SetOuterHTMLContext(
+	ctx context.Context,
+	params *dom.SetOuterHTMLParams,
 ) <-chan *dom.SetOuterHTMLResult {
 	resultChan := make(chan *dom.SetOuterHTMLResult)
 	command := NewCommand(protocol.Socket, "DOM.setOuterHTML", params)
 	result := &dom.SetOuterHTMLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -929,13 +1361,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-undo
 EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) Undo() <-chan *dom.UndoResult {
+	return protocol.UndoContext(context.Background())
+}
+
+/*
+UndoContext is the context.Context-aware version of Undo.
+*/
+func (protocol *DOMProtocol) UndoContext(
+	ctx context.Context,
+) <-chan *dom.UndoResult {
 	resultChan := make(chan *dom.UndoResult)
 	command := NewCommand(protocol.Socket, "DOM.undo", nil)
 	result := &dom.UndoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -953,7 +1396,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeModif
 */
 func (protocol *DOMProtocol) OnAttributeModified(
 	callback func(event *dom.AttributeModifiedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.attributeModified",
 		func(response *Response) {
@@ -965,45 +1408,90 @@ func (protocol *DOMProtocol) OnAttributeModified(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnAttributeRemoved adds a handler to the DOM.attributeRemoved event.
-DOM.attributeRemoved fires when Element's attribute is modified.
-
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeRemoved
+AttributeModifiedEvents returns a channel that receives each
+DOM.attributeModified event. The channel is closed when the context is done or
+the socket stops listening. See OnAttributeModified.
 */
-func (protocol *DOMProtocol) OnAttributeRemoved(
-	callback func(event *dom.AttributeRemovedEvent),
-) {
-	handler := NewEventHandler(
-		"DOM.attributeRemoved",
-		func(response *Response) {
-			event := &dom.AttributeRemovedEvent{}
+func (protocol *DOMProtocol) AttributeModifiedEvents(
+	ctx context.Context,
+) <-chan *dom.AttributeModifiedEvent {
+	eventChan := make(chan *dom.AttributeModifiedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.attributeModified")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.AttributeModifiedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAttributeModified subscribes to the DOM.attributeModified event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForAttributeModified(
+	ctx context.Context,
+	predicate func(event *dom.AttributeModifiedEvent) bool,
+) <-chan *dom.AttributeModifiedEvent {
+	eventChan := make(chan *dom.AttributeModifiedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.attributeModified",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.AttributeModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.AttributeModifiedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnCharacterDataModified adds a handler to the DOM.characterDataModified event.
-DOM.characterDataModified mirrors the DOMCharacterDataModified event.
-
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-characterDataModified
+OnAttributeModifiedOnce adds a handler to the DOM.attributeModified event that
+is removed after the first event is received. See OnAttributeModified.
 */
-func (protocol *DOMProtocol) OnCharacterDataModified(
-	callback func(event *dom.CharacterDataModifiedEvent),
-) {
+func (protocol *DOMProtocol) OnAttributeModifiedOnce(
+	callback func(event *dom.AttributeModifiedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"DOM.characterDataModified",
+		"DOM.attributeModified",
 		func(response *Response) {
-			event := &dom.CharacterDataModifiedEvent{}
+			event := &dom.AttributeModifiedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1011,18 +1499,339 @@ func (protocol *DOMProtocol) OnCharacterDataModified(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnChildNodeCountUpdated adds a handler to the DOM.childNodeCountUpdated event.
-DOM.childNodeCountUpdated fires when Container's child node count has changed.
+OnAttributeRemoved adds a handler to the DOM.attributeRemoved event.
+DOM.attributeRemoved fires when Element's attribute is modified.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeCountUpdated
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeRemoved
 */
-func (protocol *DOMProtocol) OnChildNodeCountUpdated(
-	callback func(event *dom.ChildNodeCountUpdatedEvent),
-) {
+func (protocol *DOMProtocol) OnAttributeRemoved(
+	callback func(event *dom.AttributeRemovedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.attributeRemoved",
+		func(response *Response) {
+			event := &dom.AttributeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AttributeRemovedEvents returns a channel that receives each DOM.attributeRemoved
+event. The channel is closed when the context is done or the socket stops
+listening. See OnAttributeRemoved.
+*/
+func (protocol *DOMProtocol) AttributeRemovedEvents(
+	ctx context.Context,
+) <-chan *dom.AttributeRemovedEvent {
+	eventChan := make(chan *dom.AttributeRemovedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.attributeRemoved")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.AttributeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAttributeRemoved subscribes to the DOM.attributeRemoved event and returns
+a channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForAttributeRemoved(
+	ctx context.Context,
+	predicate func(event *dom.AttributeRemovedEvent) bool,
+) <-chan *dom.AttributeRemovedEvent {
+	eventChan := make(chan *dom.AttributeRemovedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.attributeRemoved",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.AttributeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.AttributeRemovedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAttributeRemovedOnce adds a handler to the DOM.attributeRemoved event that is
+removed after the first event is received. See OnAttributeRemoved.
+*/
+func (protocol *DOMProtocol) OnAttributeRemovedOnce(
+	callback func(event *dom.AttributeRemovedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.attributeRemoved",
+		func(response *Response) {
+			event := &dom.AttributeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnCharacterDataModified adds a handler to the DOM.characterDataModified event.
+DOM.characterDataModified mirrors the DOMCharacterDataModified event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-characterDataModified
+*/
+func (protocol *DOMProtocol) OnCharacterDataModified(
+	callback func(event *dom.CharacterDataModifiedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.characterDataModified",
+		func(response *Response) {
+			event := &dom.CharacterDataModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+CharacterDataModifiedEvents returns a channel that receives each
+DOM.characterDataModified event. The channel is closed when the context is done
+or the socket stops listening. See OnCharacterDataModified.
+*/
+func (protocol *DOMProtocol) CharacterDataModifiedEvents(
+	ctx context.Context,
+) <-chan *dom.CharacterDataModifiedEvent {
+	eventChan := make(chan *dom.CharacterDataModifiedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.characterDataModified")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.CharacterDataModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForCharacterDataModified subscribes to the DOM.characterDataModified event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForCharacterDataModified(
+	ctx context.Context,
+	predicate func(event *dom.CharacterDataModifiedEvent) bool,
+) <-chan *dom.CharacterDataModifiedEvent {
+	eventChan := make(chan *dom.CharacterDataModifiedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.characterDataModified",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.CharacterDataModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.CharacterDataModifiedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnCharacterDataModifiedOnce adds a handler to the DOM.characterDataModified
+event that is removed after the first event is received. See
+OnCharacterDataModified.
+*/
+func (protocol *DOMProtocol) OnCharacterDataModifiedOnce(
+	callback func(event *dom.CharacterDataModifiedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.characterDataModified",
+		func(response *Response) {
+			event := &dom.CharacterDataModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeCountUpdated adds a handler to the DOM.childNodeCountUpdated event.
+DOM.childNodeCountUpdated fires when Container's child node count has changed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeCountUpdated
+*/
+func (protocol *DOMProtocol) OnChildNodeCountUpdated(
+	callback func(event *dom.ChildNodeCountUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeCountUpdated",
+		func(response *Response) {
+			event := &dom.ChildNodeCountUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ChildNodeCountUpdatedEvents returns a channel that receives each
+DOM.childNodeCountUpdated event. The channel is closed when the context is done
+or the socket stops listening. See OnChildNodeCountUpdated.
+*/
+func (protocol *DOMProtocol) ChildNodeCountUpdatedEvents(
+	ctx context.Context,
+) <-chan *dom.ChildNodeCountUpdatedEvent {
+	eventChan := make(chan *dom.ChildNodeCountUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.childNodeCountUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.ChildNodeCountUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForChildNodeCountUpdated subscribes to the DOM.childNodeCountUpdated event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForChildNodeCountUpdated(
+	ctx context.Context,
+	predicate func(event *dom.ChildNodeCountUpdatedEvent) bool,
+) <-chan *dom.ChildNodeCountUpdatedEvent {
+	eventChan := make(chan *dom.ChildNodeCountUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.childNodeCountUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.ChildNodeCountUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.ChildNodeCountUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnChildNodeCountUpdatedOnce adds a handler to the DOM.childNodeCountUpdated
+event that is removed after the first event is received. See
+OnChildNodeCountUpdated.
+*/
+func (protocol *DOMProtocol) OnChildNodeCountUpdatedOnce(
+	callback func(event *dom.ChildNodeCountUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.childNodeCountUpdated",
 		func(response *Response) {
@@ -1034,22 +1843,596 @@ func (protocol *DOMProtocol) OnChildNodeCountUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeInserted adds a handler to the DOM.childNodeInserted event.
+DOM.childNodeInserted mirrors the DOMNodeInserted event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeInserted
+*/
+func (protocol *DOMProtocol) OnChildNodeInserted(
+	callback func(event *dom.ChildNodeInsertedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeInserted",
+		func(response *Response) {
+			event := &dom.ChildNodeInsertedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ChildNodeInsertedEvents returns a channel that receives each
+DOM.childNodeInserted event. The channel is closed when the context is done or
+the socket stops listening. See OnChildNodeInserted.
+*/
+func (protocol *DOMProtocol) ChildNodeInsertedEvents(
+	ctx context.Context,
+) <-chan *dom.ChildNodeInsertedEvent {
+	eventChan := make(chan *dom.ChildNodeInsertedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.childNodeInserted")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.ChildNodeInsertedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForChildNodeInserted subscribes to the DOM.childNodeInserted event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForChildNodeInserted(
+	ctx context.Context,
+	predicate func(event *dom.ChildNodeInsertedEvent) bool,
+) <-chan *dom.ChildNodeInsertedEvent {
+	eventChan := make(chan *dom.ChildNodeInsertedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.childNodeInserted",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.ChildNodeInsertedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.ChildNodeInsertedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnChildNodeInsertedOnce adds a handler to the DOM.childNodeInserted event that
+is removed after the first event is received. See OnChildNodeInserted.
+*/
+func (protocol *DOMProtocol) OnChildNodeInsertedOnce(
+	callback func(event *dom.ChildNodeInsertedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeInserted",
+		func(response *Response) {
+			event := &dom.ChildNodeInsertedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeRemoved adds a handler to the DOM.childNodeRemoved event.
+DOM.childNodeRemoved mirrors the DOMNodeRemoved event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeRemoved
+*/
+func (protocol *DOMProtocol) OnChildNodeRemoved(
+	callback func(event *dom.ChildNodeRemovedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeRemoved",
+		func(response *Response) {
+			event := &dom.ChildNodeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ChildNodeRemovedEvents returns a channel that receives each DOM.childNodeRemoved
+event. The channel is closed when the context is done or the socket stops
+listening. See OnChildNodeRemoved.
+*/
+func (protocol *DOMProtocol) ChildNodeRemovedEvents(
+	ctx context.Context,
+) <-chan *dom.ChildNodeRemovedEvent {
+	eventChan := make(chan *dom.ChildNodeRemovedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.childNodeRemoved")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.ChildNodeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForChildNodeRemoved subscribes to the DOM.childNodeRemoved event and returns
+a channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForChildNodeRemoved(
+	ctx context.Context,
+	predicate func(event *dom.ChildNodeRemovedEvent) bool,
+) <-chan *dom.ChildNodeRemovedEvent {
+	eventChan := make(chan *dom.ChildNodeRemovedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.childNodeRemoved",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.ChildNodeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.ChildNodeRemovedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnChildNodeRemovedOnce adds a handler to the DOM.childNodeRemoved event that is
+removed after the first event is received. See OnChildNodeRemoved.
+*/
+func (protocol *DOMProtocol) OnChildNodeRemovedOnce(
+	callback func(event *dom.ChildNodeRemovedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeRemoved",
+		func(response *Response) {
+			event := &dom.ChildNodeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnDistributedNodesUpdated adds a handler to the DOM.distributedNodesUpdated
+event. DOM.distributedNodesUpdated fires when distribution is changed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-distributedNodesUpdated
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) OnDistributedNodesUpdated(
+	callback func(event *dom.DistributedNodesUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.distributedNodesUpdated",
+		func(response *Response) {
+			event := &dom.DistributedNodesUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+DistributedNodesUpdatedEvents returns a channel that receives each
+DOM.distributedNodesUpdated event. The channel is closed when the context is
+done or the socket stops listening. See OnDistributedNodesUpdated.
+*/
+func (protocol *DOMProtocol) DistributedNodesUpdatedEvents(
+	ctx context.Context,
+) <-chan *dom.DistributedNodesUpdatedEvent {
+	eventChan := make(chan *dom.DistributedNodesUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.distributedNodesUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.DistributedNodesUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForDistributedNodesUpdated subscribes to the DOM.distributedNodesUpdated
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForDistributedNodesUpdated(
+	ctx context.Context,
+	predicate func(event *dom.DistributedNodesUpdatedEvent) bool,
+) <-chan *dom.DistributedNodesUpdatedEvent {
+	eventChan := make(chan *dom.DistributedNodesUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.distributedNodesUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.DistributedNodesUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.DistributedNodesUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnDistributedNodesUpdatedOnce adds a handler to the DOM.distributedNodesUpdated
+event that is removed after the first event is received. See
+OnDistributedNodesUpdated.
+*/
+func (protocol *DOMProtocol) OnDistributedNodesUpdatedOnce(
+	callback func(event *dom.DistributedNodesUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.distributedNodesUpdated",
+		func(response *Response) {
+			event := &dom.DistributedNodesUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnDocumentUpdated adds a handler to the DOM.documentUpdated event.
+DOM.documentUpdated fires when Document has been totally updated. Node IDs are
+no longer valid.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-documentUpdated
+*/
+func (protocol *DOMProtocol) OnDocumentUpdated(
+	callback func(event *dom.DocumentUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.documentUpdated",
+		func(response *Response) {
+			event := &dom.DocumentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+DocumentUpdatedEvents returns a channel that receives each DOM.documentUpdated
+event. The channel is closed when the context is done or the socket stops
+listening. See OnDocumentUpdated.
+*/
+func (protocol *DOMProtocol) DocumentUpdatedEvents(
+	ctx context.Context,
+) <-chan *dom.DocumentUpdatedEvent {
+	eventChan := make(chan *dom.DocumentUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.documentUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.DocumentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForDocumentUpdated subscribes to the DOM.documentUpdated event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForDocumentUpdated(
+	ctx context.Context,
+	predicate func(event *dom.DocumentUpdatedEvent) bool,
+) <-chan *dom.DocumentUpdatedEvent {
+	eventChan := make(chan *dom.DocumentUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.documentUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.DocumentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.DocumentUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnDocumentUpdatedOnce adds a handler to the DOM.documentUpdated event that is
+removed after the first event is received. See OnDocumentUpdated.
+*/
+func (protocol *DOMProtocol) OnDocumentUpdatedOnce(
+	callback func(event *dom.DocumentUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.documentUpdated",
+		func(response *Response) {
+			event := &dom.DocumentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnInlineStyleInvalidated adds a handler to the DOM.inlineStyleInvalidated event.
+DOM.inlineStyleInvalidated fires when Element's attribute is removed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-inlineStyleInvalidated
+*/
+func (protocol *DOMProtocol) OnInlineStyleInvalidated(
+	callback func(event *dom.InlineStyleInvalidatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.inlineStyleInvalidated",
+		func(response *Response) {
+			event := &dom.InlineStyleInvalidatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+InlineStyleInvalidatedEvents returns a channel that receives each
+DOM.inlineStyleInvalidated event. The channel is closed when the context is done
+or the socket stops listening. See OnInlineStyleInvalidated.
+*/
+func (protocol *DOMProtocol) InlineStyleInvalidatedEvents(
+	ctx context.Context,
+) <-chan *dom.InlineStyleInvalidatedEvent {
+	eventChan := make(chan *dom.InlineStyleInvalidatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.inlineStyleInvalidated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.InlineStyleInvalidatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForInlineStyleInvalidated subscribes to the DOM.inlineStyleInvalidated event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForInlineStyleInvalidated(
+	ctx context.Context,
+	predicate func(event *dom.InlineStyleInvalidatedEvent) bool,
+) <-chan *dom.InlineStyleInvalidatedEvent {
+	eventChan := make(chan *dom.InlineStyleInvalidatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.inlineStyleInvalidated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.InlineStyleInvalidatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.InlineStyleInvalidatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnInlineStyleInvalidatedOnce adds a handler to the DOM.inlineStyleInvalidated
+event that is removed after the first event is received. See
+OnInlineStyleInvalidated.
+*/
+func (protocol *DOMProtocol) OnInlineStyleInvalidatedOnce(
+	callback func(event *dom.InlineStyleInvalidatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.inlineStyleInvalidated",
+		func(response *Response) {
+			event := &dom.InlineStyleInvalidatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnChildNodeInserted adds a handler to the DOM.childNodeInserted event.
-DOM.childNodeInserted mirrors the DOMNodeInserted event.
+OnPseudoElementAdded adds a handler to the DOM.pseudoElementAdded event.
+DOM.pseudoElementAdded fires when a pseudo element is added to an element.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeInserted
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementAdded EXPERIMENTAL.
 */
-func (protocol *DOMProtocol) OnChildNodeInserted(
-	callback func(event *dom.ChildNodeInsertedEvent),
-) {
+func (protocol *DOMProtocol) OnPseudoElementAdded(
+	callback func(event *dom.PseudoElementAddedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"DOM.childNodeInserted",
+		"DOM.pseudoElementAdded",
 		func(response *Response) {
-			event := &dom.ChildNodeInsertedEvent{}
+			event := &dom.PseudoElementAddedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1057,46 +2440,90 @@ func (protocol *DOMProtocol) OnChildNodeInserted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnChildNodeRemoved adds a handler to the DOM.childNodeRemoved event.
-DOM.childNodeRemoved mirrors the DOMNodeRemoved event.
-
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeRemoved
+PseudoElementAddedEvents returns a channel that receives each
+DOM.pseudoElementAdded event. The channel is closed when the context is done or
+the socket stops listening. See OnPseudoElementAdded.
 */
-func (protocol *DOMProtocol) OnChildNodeRemoved(
-	callback func(event *dom.ChildNodeRemovedEvent),
-) {
-	handler := NewEventHandler(
-		"DOM.childNodeRemoved",
-		func(response *Response) {
-			event := &dom.ChildNodeRemovedEvent{}
+func (protocol *DOMProtocol) PseudoElementAddedEvents(
+	ctx context.Context,
+) <-chan *dom.PseudoElementAddedEvent {
+	eventChan := make(chan *dom.PseudoElementAddedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.pseudoElementAdded")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.PseudoElementAddedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForPseudoElementAdded subscribes to the DOM.pseudoElementAdded event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForPseudoElementAdded(
+	ctx context.Context,
+	predicate func(event *dom.PseudoElementAddedEvent) bool,
+) <-chan *dom.PseudoElementAddedEvent {
+	eventChan := make(chan *dom.PseudoElementAddedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.pseudoElementAdded",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.PseudoElementAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.PseudoElementAddedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnDistributedNodesUpdated adds a handler to the DOM.distributedNodesUpdated
-event. DOM.distributedNodesUpdated fires when distribution is changed.
-
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-distributedNodesUpdated
-EXPERIMENTAL.
+OnPseudoElementAddedOnce adds a handler to the DOM.pseudoElementAdded event that
+is removed after the first event is received. See OnPseudoElementAdded.
 */
-func (protocol *DOMProtocol) OnDistributedNodesUpdated(
-	callback func(event *dom.DistributedNodesUpdatedEvent),
-) {
+func (protocol *DOMProtocol) OnPseudoElementAddedOnce(
+	callback func(event *dom.PseudoElementAddedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"DOM.distributedNodesUpdated",
+		"DOM.pseudoElementAdded",
 		func(response *Response) {
-			event := &dom.DistributedNodesUpdatedEvent{}
+			event := &dom.PseudoElementAddedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1104,23 +2531,22 @@ func (protocol *DOMProtocol) OnDistributedNodesUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnDocumentUpdated adds a handler to the DOM.documentUpdated event.
-DOM.documentUpdated fires when Document has been totally updated. Node IDs are
-no longer valid.
+OnPseudoElementRemoved adds a handler to the DOM.pseudoElementRemoved event.
+DOM.pseudoElementRemoved fires when a pseudo element is removed from an element.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-documentUpdated
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementRemoved EXPERIMENTAL.
 */
-func (protocol *DOMProtocol) OnDocumentUpdated(
-	callback func(event *dom.DocumentUpdatedEvent),
-) {
+func (protocol *DOMProtocol) OnPseudoElementRemoved(
+	callback func(event *dom.PseudoElementRemovedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"DOM.documentUpdated",
+		"DOM.pseudoElementRemoved",
 		func(response *Response) {
-			event := &dom.DocumentUpdatedEvent{}
+			event := &dom.PseudoElementRemovedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1128,45 +2554,90 @@ func (protocol *DOMProtocol) OnDocumentUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnInlineStyleInvalidated adds a handler to the DOM.inlineStyleInvalidated event.
-DOM.inlineStyleInvalidated fires when Element's attribute is removed.
-
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-inlineStyleInvalidated
+PseudoElementRemovedEvents returns a channel that receives each
+DOM.pseudoElementRemoved event. The channel is closed when the context is done
+or the socket stops listening. See OnPseudoElementRemoved.
 */
-func (protocol *DOMProtocol) OnInlineStyleInvalidated(
-	callback func(event *dom.InlineStyleInvalidatedEvent),
-) {
-	handler := NewEventHandler(
-		"DOM.inlineStyleInvalidated",
-		func(response *Response) {
-			event := &dom.InlineStyleInvalidatedEvent{}
+func (protocol *DOMProtocol) PseudoElementRemovedEvents(
+	ctx context.Context,
+) <-chan *dom.PseudoElementRemovedEvent {
+	eventChan := make(chan *dom.PseudoElementRemovedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.pseudoElementRemoved")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.PseudoElementRemovedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForPseudoElementRemoved subscribes to the DOM.pseudoElementRemoved event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForPseudoElementRemoved(
+	ctx context.Context,
+	predicate func(event *dom.PseudoElementRemovedEvent) bool,
+) <-chan *dom.PseudoElementRemovedEvent {
+	eventChan := make(chan *dom.PseudoElementRemovedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.pseudoElementRemoved",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.PseudoElementRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.PseudoElementRemovedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnPseudoElementAdded adds a handler to the DOM.pseudoElementAdded event.
-DOM.pseudoElementAdded fires when a pseudo element is added to an element.
-
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementAdded EXPERIMENTAL.
+OnPseudoElementRemovedOnce adds a handler to the DOM.pseudoElementRemoved event
+that is removed after the first event is received. See OnPseudoElementRemoved.
 */
-func (protocol *DOMProtocol) OnPseudoElementAdded(
-	callback func(event *dom.PseudoElementAddedEvent),
-) {
+func (protocol *DOMProtocol) OnPseudoElementRemovedOnce(
+	callback func(event *dom.PseudoElementRemovedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"DOM.pseudoElementAdded",
+		"DOM.pseudoElementRemoved",
 		func(response *Response) {
-			event := &dom.PseudoElementAddedEvent{}
+			event := &dom.PseudoElementRemovedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1174,22 +2645,23 @@ func (protocol *DOMProtocol) OnPseudoElementAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnPseudoElementRemoved adds a handler to the DOM.pseudoElementRemoved event.
-DOM.pseudoElementRemoved fires when a pseudo element is removed from an element.
+OnSetChildNodes adds a handler to the DOM.setChildNodes event. DOM.setChildNodes
+fires when backend wants to provide client with the missing DOM structure. This
+happens upon most of the calls requesting node IDs.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementRemoved EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-setChildNodes
 */
-func (protocol *DOMProtocol) OnPseudoElementRemoved(
-	callback func(event *dom.PseudoElementRemovedEvent),
-) {
+func (protocol *DOMProtocol) OnSetChildNodes(
+	callback func(event *dom.SetChildNodesEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"DOM.pseudoElementRemoved",
+		"DOM.setChildNodes",
 		func(response *Response) {
-			event := &dom.PseudoElementRemovedEvent{}
+			event := &dom.SetChildNodesEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1197,19 +2669,86 @@ func (protocol *DOMProtocol) OnPseudoElementRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnSetChildNodes adds a handler to the DOM.setChildNodes event. DOM.setChildNodes
-fires when backend wants to provide client with the missing DOM structure. This
-happens upon most of the calls requesting node IDs.
+SetChildNodesEvents returns a channel that receives each DOM.setChildNodes
+event. The channel is closed when the context is done or the socket stops
+listening. See OnSetChildNodes.
+*/
+func (protocol *DOMProtocol) SetChildNodesEvents(
+	ctx context.Context,
+) <-chan *dom.SetChildNodesEvent {
+	eventChan := make(chan *dom.SetChildNodesEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.setChildNodes")
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-setChildNodes
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.SetChildNodesEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForSetChildNodes subscribes to the DOM.setChildNodes event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
 */
-func (protocol *DOMProtocol) OnSetChildNodes(
+func (protocol *DOMProtocol) WaitForSetChildNodes(
+	ctx context.Context,
+	predicate func(event *dom.SetChildNodesEvent) bool,
+) <-chan *dom.SetChildNodesEvent {
+	eventChan := make(chan *dom.SetChildNodesEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.setChildNodes",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.SetChildNodesEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.SetChildNodesEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnSetChildNodesOnce adds a handler to the DOM.setChildNodes event that is
+removed after the first event is received. See OnSetChildNodes.
+*/
+func (protocol *DOMProtocol) OnSetChildNodesOnce(
 	callback func(event *dom.SetChildNodesEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.setChildNodes",
 		func(response *Response) {
@@ -1221,7 +2760,7 @@ func (protocol *DOMProtocol) OnSetChildNodes(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -1232,7 +2771,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPopp
 */
 func (protocol *DOMProtocol) OnShadowRootPopped(
 	callback func(event *dom.ShadowRootPoppedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.shadowRootPopped",
+		func(response *Response) {
+			event := &dom.ShadowRootPoppedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ShadowRootPoppedEvents returns a channel that receives each DOM.shadowRootPopped
+event. The channel is closed when the context is done or the socket stops
+listening. See OnShadowRootPopped.
+*/
+func (protocol *DOMProtocol) ShadowRootPoppedEvents(
+	ctx context.Context,
+) <-chan *dom.ShadowRootPoppedEvent {
+	eventChan := make(chan *dom.ShadowRootPoppedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.shadowRootPopped")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.ShadowRootPoppedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForShadowRootPopped subscribes to the DOM.shadowRootPopped event and returns
+a channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForShadowRootPopped(
+	ctx context.Context,
+	predicate func(event *dom.ShadowRootPoppedEvent) bool,
+) <-chan *dom.ShadowRootPoppedEvent {
+	eventChan := make(chan *dom.ShadowRootPoppedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.shadowRootPopped",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.ShadowRootPoppedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.ShadowRootPoppedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnShadowRootPoppedOnce adds a handler to the DOM.shadowRootPopped event that is
+removed after the first event is received. See OnShadowRootPopped.
+*/
+func (protocol *DOMProtocol) OnShadowRootPoppedOnce(
+	callback func(event *dom.ShadowRootPoppedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.shadowRootPopped",
 		func(response *Response) {
@@ -1244,7 +2874,7 @@ func (protocol *DOMProtocol) OnShadowRootPopped(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -1255,7 +2885,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPush
 */
 func (protocol *DOMProtocol) OnShadowRootPushed(
 	callback func(event *dom.ShadowRootPushedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.shadowRootPushed",
+		func(response *Response) {
+			event := &dom.ShadowRootPushedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ShadowRootPushedEvents returns a channel that receives each DOM.shadowRootPushed
+event. The channel is closed when the context is done or the socket stops
+listening. See OnShadowRootPushed.
+*/
+func (protocol *DOMProtocol) ShadowRootPushedEvents(
+	ctx context.Context,
+) <-chan *dom.ShadowRootPushedEvent {
+	eventChan := make(chan *dom.ShadowRootPushedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOM.shadowRootPushed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &dom.ShadowRootPushedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForShadowRootPushed subscribes to the DOM.shadowRootPushed event and returns
+a channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMProtocol) WaitForShadowRootPushed(
+	ctx context.Context,
+	predicate func(event *dom.ShadowRootPushedEvent) bool,
+) <-chan *dom.ShadowRootPushedEvent {
+	eventChan := make(chan *dom.ShadowRootPushedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOM.shadowRootPushed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &dom.ShadowRootPushedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &dom.ShadowRootPushedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnShadowRootPushedOnce adds a handler to the DOM.shadowRootPushed event that is
+removed after the first event is received. See OnShadowRootPushed.
+*/
+func (protocol *DOMProtocol) OnShadowRootPushedOnce(
+	callback func(event *dom.ShadowRootPushedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.shadowRootPushed",
 		func(response *Response) {
@@ -1267,5 +2988,5 @@ func (protocol *DOMProtocol) OnShadowRootPushed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/storage"
@@ -23,14 +24,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-clear
 */
 func (protocol *DOMStorageProtocol) Clear(
 	params *storage.ClearParams,
+) <-chan *storage.ClearResult {
+	return protocol.ClearContext(context.Background(), params)
+}
+
+/*
+ClearContext is the context.Context-aware version of Clear.
+*/
+func (protocol *DOMStorageProtocol) ClearContext(
+	ctx context.Context,
+	params *storage.ClearParams,
 ) <-chan *storage.ClearResult {
 	resultChan := make(chan *storage.ClearResult)
 	command := NewCommand(protocol.Socket, "DOMStorage.clear", params)
 	result := &storage.ClearResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,13 +60,24 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-disable
 */
 func (protocol *DOMStorageProtocol) Disable() <-chan *storage.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *DOMStorageProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *storage.DisableResult {
 	resultChan := make(chan *storage.DisableResult)
 	command := NewCommand(protocol.Socket, "DOMStorage.disable", nil)
 	result := &storage.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -70,13 +94,24 @@ client.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-enable
 */
 func (protocol *DOMStorageProtocol) Enable() <-chan *storage.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *DOMStorageProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *storage.EnableResult {
 	resultChan := make(chan *storage.EnableResult)
 	command := NewCommand(protocol.Socket, "DOMStorage.enable", nil)
 	result := &storage.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -93,14 +128,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-getDOM
 */
 func (protocol *DOMStorageProtocol) GetItems(
 	params *storage.GetItemsParams,
+) <-chan *storage.GetItemsResult {
+	return protocol.GetItemsContext(context.Background(), params)
+}
+
+/*
+GetItemsContext is the context.Context-aware version of GetItems.
+*/
+func (protocol *DOMStorageProtocol) GetItemsContext(
+	ctx context.Context,
+	params *storage.GetItemsParams,
 ) <-chan *storage.GetItemsResult {
 	resultChan := make(chan *storage.GetItemsResult)
 	command := NewCommand(protocol.Socket, "DOMStorage.getDOMStorageItems", params)
 	result := &storage.GetItemsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -119,14 +166,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-remove
 */
 func (protocol *DOMStorageProtocol) RemoveItem(
 	params *storage.RemoveItemParams,
+) <-chan *storage.RemoveItemResult {
+	return protocol.RemoveItemContext(context.Background(), params)
+}
+
+/*
+RemoveItemContext is the context.Context-aware version of RemoveItem.
+*/
+func (protocol *DOMStorageProtocol) RemoveItemContext(
+	ctx context.Context,
+	params *storage.RemoveItemParams,
 ) <-chan *storage.RemoveItemResult {
 	resultChan := make(chan *storage.RemoveItemResult)
 	command := NewCommand(protocol.Socket, "DOMStorage.removeDOMStorageItem", params)
 	result := &storage.RemoveItemResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -143,14 +202,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-setDOM
 */
 func (protocol *DOMStorageProtocol) SetItem(
 	params *storage.SetItemParams,
+) <-chan *storage.SetItemResult {
+	return protocol.SetItemContext(context.Background(), params)
+}
+
+/*
+SetItemContext is the context.Context-aware version of SetItem.
+*/
+func (protocol *DOMStorageProtocol) SetItemContext(
+	ctx context.Context,
+	params *storage.SetItemParams,
 ) <-chan *storage.SetItemResult {
 	resultChan := make(chan *storage.SetItemResult)
 	command := NewCommand(protocol.Socket, "DOMStorage.setDOMStorageItem", params)
 	result := &storage.SetItemResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -168,7 +239,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemAdded(
 	callback func(event *storage.ItemAddedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"DOMStorage.domStorageItemAdded",
+		func(response *Response) {
+			event := &storage.ItemAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ItemAddedEvents returns a channel that receives each
+DOMStorage.domStorageItemAdded event. The channel is closed when the context is
+done or the socket stops listening. See OnItemAdded.
+*/
+func (protocol *DOMStorageProtocol) ItemAddedEvents(
+	ctx context.Context,
+) <-chan *storage.ItemAddedEvent {
+	eventChan := make(chan *storage.ItemAddedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOMStorage.domStorageItemAdded")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.ItemAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForItemAdded subscribes to the DOMStorage.domStorageItemAdded event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMStorageProtocol) WaitForItemAdded(
+	ctx context.Context,
+	predicate func(event *storage.ItemAddedEvent) bool,
+) <-chan *storage.ItemAddedEvent {
+	eventChan := make(chan *storage.ItemAddedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOMStorage.domStorageItemAdded",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.ItemAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.ItemAddedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnItemAddedOnce adds a handler to the DOMStorage.domStorageItemAdded event that
+is removed after the first event is received. See OnItemAdded.
+*/
+func (protocol *DOMStorageProtocol) OnItemAddedOnce(
+	callback func(event *storage.ItemAddedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemAdded",
 		func(response *Response) {
@@ -180,7 +342,7 @@ func (protocol *DOMStorageProtocol) OnItemAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -191,7 +353,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemRemoved(
 	callback func(event *storage.ItemRemovedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"DOMStorage.domStorageItemRemoved",
+		func(response *Response) {
+			event := &storage.ItemRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ItemRemovedEvents returns a channel that receives each
+DOMStorage.domStorageItemRemoved event. The channel is closed when the context
+is done or the socket stops listening. See OnItemRemoved.
+*/
+func (protocol *DOMStorageProtocol) ItemRemovedEvents(
+	ctx context.Context,
+) <-chan *storage.ItemRemovedEvent {
+	eventChan := make(chan *storage.ItemRemovedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOMStorage.domStorageItemRemoved")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.ItemRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForItemRemoved subscribes to the DOMStorage.domStorageItemRemoved event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMStorageProtocol) WaitForItemRemoved(
+	ctx context.Context,
+	predicate func(event *storage.ItemRemovedEvent) bool,
+) <-chan *storage.ItemRemovedEvent {
+	eventChan := make(chan *storage.ItemRemovedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOMStorage.domStorageItemRemoved",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.ItemRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.ItemRemovedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnItemRemovedOnce adds a handler to the DOMStorage.domStorageItemRemoved event
+that is removed after the first event is received. See OnItemRemoved.
+*/
+func (protocol *DOMStorageProtocol) OnItemRemovedOnce(
+	callback func(event *storage.ItemRemovedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemRemoved",
 		func(response *Response) {
@@ -203,7 +456,7 @@ func (protocol *DOMStorageProtocol) OnItemRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -214,7 +467,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemUpdated(
 	callback func(event *storage.ItemUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"DOMStorage.domStorageItemUpdated",
+		func(response *Response) {
+			event := &storage.ItemUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ItemUpdatedEvents returns a channel that receives each
+DOMStorage.domStorageItemUpdated event. The channel is closed when the context
+is done or the socket stops listening. See OnItemUpdated.
+*/
+func (protocol *DOMStorageProtocol) ItemUpdatedEvents(
+	ctx context.Context,
+) <-chan *storage.ItemUpdatedEvent {
+	eventChan := make(chan *storage.ItemUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOMStorage.domStorageItemUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.ItemUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForItemUpdated subscribes to the DOMStorage.domStorageItemUpdated event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *DOMStorageProtocol) WaitForItemUpdated(
+	ctx context.Context,
+	predicate func(event *storage.ItemUpdatedEvent) bool,
+) <-chan *storage.ItemUpdatedEvent {
+	eventChan := make(chan *storage.ItemUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOMStorage.domStorageItemUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.ItemUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.ItemUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnItemUpdatedOnce adds a handler to the DOMStorage.domStorageItemUpdated event
+that is removed after the first event is received. See OnItemUpdated.
+*/
+func (protocol *DOMStorageProtocol) OnItemUpdatedOnce(
+	callback func(event *storage.ItemUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemUpdated",
 		func(response *Response) {
@@ -226,7 +570,7 @@ func (protocol *DOMStorageProtocol) OnItemUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -237,7 +581,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemsCleared(
 	callback func(event *storage.ItemsClearedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"DOMStorage.domStorageItemsCleared",
+		func(response *Response) {
+			event := &storage.ItemsClearedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ItemsClearedEvents returns a channel that receives each
+DOMStorage.domStorageItemsCleared event. The channel is closed when the context
+is done or the socket stops listening. See OnItemsCleared.
+*/
+func (protocol *DOMStorageProtocol) ItemsClearedEvents(
+	ctx context.Context,
+) <-chan *storage.ItemsClearedEvent {
+	eventChan := make(chan *storage.ItemsClearedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "DOMStorage.domStorageItemsCleared")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.ItemsClearedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForItemsCleared subscribes to the DOMStorage.domStorageItemsCleared event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *DOMStorageProtocol) WaitForItemsCleared(
+	ctx context.Context,
+	predicate func(event *storage.ItemsClearedEvent) bool,
+) <-chan *storage.ItemsClearedEvent {
+	eventChan := make(chan *storage.ItemsClearedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"DOMStorage.domStorageItemsCleared",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.ItemsClearedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.ItemsClearedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnItemsClearedOnce adds a handler to the DOMStorage.domStorageItemsCleared event
+that is removed after the first event is received. See OnItemsCleared.
+*/
+func (protocol *DOMStorageProtocol) OnItemsClearedOnce(
+	callback func(event *storage.ItemsClearedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemsCleared",
 		func(response *Response) {
@@ -249,5 +684,5 @@ func (protocol *DOMStorageProtocol) OnItemsCleared(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/emulation"
@@ -22,13 +23,24 @@ CanEmulate tells whether emulation is supported.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-canEmulate
 */
 func (protocol *EmulationProtocol) CanEmulate() <-chan *emulation.CanEmulateResult {
+	return protocol.CanEmulateContext(context.Background())
+}
+
+/*
+CanEmulateContext is the context.Context-aware version of CanEmulate.
+*/
+func (protocol *EmulationProtocol) CanEmulateContext(
+	ctx context.Context,
+) <-chan *emulation.CanEmulateResult {
 	resultChan := make(chan *emulation.CanEmulateResult)
 	command := NewCommand(protocol.Socket, "Emulation.canEmulate", nil)
 	result := &emulation.CanEmulateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -46,13 +58,25 @@ ClearDeviceMetricsOverride clears the overridden device metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDeviceMetricsOverride
 */
 func (protocol *EmulationProtocol) ClearDeviceMetricsOverride() <-chan *emulation.ClearDeviceMetricsOverrideResult {
+	return protocol.ClearDeviceMetricsOverrideContext(context.Background())
+}
+
+/*
+ClearDeviceMetricsOverrideContext is the context.Context-aware version of
+ClearDeviceMetricsOverride.
+*/
+func (protocol *EmulationProtocol) ClearDeviceMetricsOverrideContext(
+	ctx context.Context,
+) <-chan *emulation.ClearDeviceMetricsOverrideResult {
 	resultChan := make(chan *emulation.ClearDeviceMetricsOverrideResult)
 	command := NewCommand(protocol.Socket, "Emulation.clearDeviceMetricsOverride", nil)
 	result := &emulation.ClearDeviceMetricsOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,13 +92,25 @@ ClearGeolocationOverride clears the overridden Geolocation Position and Error.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearGeolocationOverride
 */
 func (protocol *EmulationProtocol) ClearGeolocationOverride() <-chan *emulation.ClearGeolocationOverrideResult {
+	return protocol.ClearGeolocationOverrideContext(context.Background())
+}
+
+/*
+ClearGeolocationOverrideContext is the context.Context-aware version of
+ClearGeolocationOverride.
+*/
+func (protocol *EmulationProtocol) ClearGeolocationOverrideContext(
+	ctx context.Context,
+) <-chan *emulation.ClearGeolocationOverrideResult {
 	resultChan := make(chan *emulation.ClearGeolocationOverrideResult)
 	command := NewCommand(protocol.Socket, "Emulation.clearGeolocationOverride", nil)
 	result := &emulation.ClearGeolocationOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -91,13 +127,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-resetPa
 EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) ResetPageScaleFactor() <-chan *emulation.ResetPageScaleFactorResult {
+	return protocol.ResetPageScaleFactorContext(context.Background())
+}
+
+/*
+ResetPageScaleFactorContext is the context.Context-aware version of
+ResetPageScaleFactor.
+*/
+func (protocol *EmulationProtocol) ResetPageScaleFactorContext(
+	ctx context.Context,
+) <-chan *emulation.ResetPageScaleFactorResult {
 	resultChan := make(chan *emulation.ResetPageScaleFactorResult)
 	command := NewCommand(protocol.Socket, "Emulation.resetPageScaleFactor", nil)
 	result := &emulation.ResetPageScaleFactorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -115,14 +163,27 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetCPUThrottlingRate(
 	params *emulation.SetCPUThrottlingRateParams,
+) <-chan *emulation.SetCPUThrottlingRateResult {
+	return protocol.SetCPUThrottlingRateContext(context.Background(), params)
+}
+
+/*
+SetCPUThrottlingRateContext is the context.Context-aware version of
+SetCPUThrottlingRate.
+*/
+func (protocol *EmulationProtocol) SetCPUThrottlingRateContext(
+	ctx context.Context,
+	params *emulation.SetCPUThrottlingRateParams,
 ) <-chan *emulation.SetCPUThrottlingRateResult {
 	resultChan := make(chan *emulation.SetCPUThrottlingRateResult)
 	command := NewCommand(protocol.Socket, "Emulation.setCPUThrottlingRate", params)
 	result := &emulation.SetCPUThrottlingRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -141,14 +202,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDefa
 */
 func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverride(
 	params *emulation.SetDefaultBackgroundColorOverrideParams,
+) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
+	return protocol.SetDefaultBackgroundColorOverrideContext(context.Background(), params)
+}
+
+/*
+SetDefaultBackgroundColorOverrideContext is the context.Context-aware version of
+SetDefaultBackgroundColorOverride.
+*/
+func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverrideContext(
+	ctx context.Context,
+	params *emulation.SetDefaultBackgroundColorOverrideParams,
 ) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
 	resultChan := make(chan *emulation.SetDefaultBackgroundColorOverrideResult)
 	command := NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", params)
 	result := &emulation.SetDefaultBackgroundColorOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -168,14 +242,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDevi
 */
 func (protocol *EmulationProtocol) SetDeviceMetricsOverride(
 	params *emulation.SetDeviceMetricsOverrideParams,
+) <-chan *emulation.SetDeviceMetricsOverrideResult {
+	return protocol.SetDeviceMetricsOverrideContext(context.Background(), params)
+}
+
+/*
+SetDeviceMetricsOverrideContext is the context.Context-aware version of
+SetDeviceMetricsOverride.
+*/
+func (protocol *EmulationProtocol) SetDeviceMetricsOverrideContext(
+	ctx context.Context,
+	params *emulation.SetDeviceMetricsOverrideParams,
 ) <-chan *emulation.SetDeviceMetricsOverrideResult {
 	resultChan := make(chan *emulation.SetDeviceMetricsOverrideResult)
 	command := NewCommand(protocol.Socket, "Emulation.setDeviceMetricsOverride", params)
 	result := &emulation.SetDeviceMetricsOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -193,14 +280,27 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetEmitTouchEventsForMouse(
 	params *emulation.SetEmitTouchEventsForMouseParams,
+) <-chan *emulation.SetEmitTouchEventsForMouseResult {
+	return protocol.SetEmitTouchEventsForMouseContext(context.Background(), params)
+}
+
+/*
+SetEmitTouchEventsForMouseContext is the context.Context-aware version of
+SetEmitTouchEventsForMouse.
+*/
+func (protocol *EmulationProtocol) SetEmitTouchEventsForMouseContext(
+	ctx context.Context,
+	params *emulation.SetEmitTouchEventsForMouseParams,
 ) <-chan *emulation.SetEmitTouchEventsForMouseResult {
 	resultChan := make(chan *emulation.SetEmitTouchEventsForMouseResult)
 	command := NewCommand(protocol.Socket, "Emulation.setEmitTouchEventsForMouse", params)
 	result := &emulation.SetEmitTouchEventsForMouseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -217,14 +317,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmul
 */
 func (protocol *EmulationProtocol) SetEmulatedMedia(
 	params *emulation.SetEmulatedMediaParams,
+) <-chan *emulation.SetEmulatedMediaResult {
+	return protocol.SetEmulatedMediaContext(context.Background(), params)
+}
+
+/*
+SetEmulatedMediaContext is the context.Context-aware version of
+SetEmulatedMedia.
+*/
+func (protocol *EmulationProtocol) SetEmulatedMediaContext(
+	ctx context.Context,
+	params *emulation.SetEmulatedMediaParams,
 ) <-chan *emulation.SetEmulatedMediaResult {
 	resultChan := make(chan *emulation.SetEmulatedMediaResult)
 	command := NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", params)
 	result := &emulation.SetEmulatedMediaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -242,14 +355,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setGeol
 */
 func (protocol *EmulationProtocol) SetGeolocationOverride(
 	params *emulation.SetGeolocationOverrideParams,
+) <-chan *emulation.SetGeolocationOverrideResult {
+	return protocol.SetGeolocationOverrideContext(context.Background(), params)
+}
+
+/*
+SetGeolocationOverrideContext is the context.Context-aware version of
+SetGeolocationOverride.
+*/
+func (protocol *EmulationProtocol) SetGeolocationOverrideContext(
+	ctx context.Context,
+	params *emulation.SetGeolocationOverrideParams,
 ) <-chan *emulation.SetGeolocationOverrideResult {
 	resultChan := make(chan *emulation.SetGeolocationOverrideResult)
 	command := NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", params)
 	result := &emulation.SetGeolocationOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -268,14 +394,27 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetNavigatorOverrides(
 	params *emulation.SetNavigatorOverridesParams,
+) <-chan *emulation.SetNavigatorOverridesResult {
+	return protocol.SetNavigatorOverridesContext(context.Background(), params)
+}
+
+/*
+SetNavigatorOverridesContext is the context.Context-aware version of
+SetNavigatorOverrides.
+*/
+func (protocol *EmulationProtocol) SetNavigatorOverridesContext(
+	ctx context.Context,
+	params *emulation.SetNavigatorOverridesParams,
 ) <-chan *emulation.SetNavigatorOverridesResult {
 	resultChan := make(chan *emulation.SetNavigatorOverridesResult)
 	command := NewCommand(protocol.Socket, "Emulation.setNavigatorOverrides", params)
 	result := &emulation.SetNavigatorOverridesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -293,14 +432,27 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetPageScaleFactor(
 	params *emulation.SetPageScaleFactorParams,
+) <-chan *emulation.SetPageScaleFactorResult {
+	return protocol.SetPageScaleFactorContext(context.Background(), params)
+}
+
+/*
+SetPageScaleFactorContext is the context.Context-aware version of
+SetPageScaleFactor.
+*/
+func (protocol *EmulationProtocol) SetPageScaleFactorContext(
+	ctx context.Context,
+	params *emulation.SetPageScaleFactorParams,
 ) <-chan *emulation.SetPageScaleFactorResult {
 	resultChan := make(chan *emulation.SetPageScaleFactorResult)
 	command := NewCommand(protocol.Socket, "Emulation.setPageScaleFactor", params)
 	result := &emulation.SetPageScaleFactorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -317,14 +469,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScri
 */
 func (protocol *EmulationProtocol) SetScriptExecutionDisabled(
 	params *emulation.SetScriptExecutionDisabledParams,
+) <-chan *emulation.SetScriptExecutionDisabledResult {
+	return protocol.SetScriptExecutionDisabledContext(context.Background(), params)
+}
+
+/*
+SetScriptExecutionDisabledContext is the context.Context-aware version of
+SetScriptExecutionDisabled.
+*/
+func (protocol *EmulationProtocol) SetScriptExecutionDisabledContext(
+	ctx context.Context,
+	params *emulation.SetScriptExecutionDisabledParams,
 ) <-chan *emulation.SetScriptExecutionDisabledResult {
 	resultChan := make(chan *emulation.SetScriptExecutionDisabledResult)
 	command := NewCommand(protocol.Socket, "Emulation.setScriptExecutionDisabled", params)
 	result := &emulation.SetScriptExecutionDisabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -341,14 +506,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTouc
 */
 func (protocol *EmulationProtocol) SetTouchEmulationEnabled(
 	params *emulation.SetTouchEmulationEnabledParams,
+) <-chan *emulation.SetTouchEmulationEnabledResult {
+	return protocol.SetTouchEmulationEnabledContext(context.Background(), params)
+}
+
+/*
+SetTouchEmulationEnabledContext is the context.Context-aware version of
+SetTouchEmulationEnabled.
+*/
+func (protocol *EmulationProtocol) SetTouchEmulationEnabledContext(
+	ctx context.Context,
+	params *emulation.SetTouchEmulationEnabledParams,
 ) <-chan *emulation.SetTouchEmulationEnabledResult {
 	resultChan := make(chan *emulation.SetTouchEmulationEnabledResult)
 	command := NewCommand(protocol.Socket, "Emulation.setTouchEmulationEnabled", params)
 	result := &emulation.SetTouchEmulationEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -368,14 +546,27 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetVirtualTimePolicy(
 	params *emulation.SetVirtualTimePolicyParams,
+) <-chan *emulation.SetVirtualTimePolicyResult {
+	return protocol.SetVirtualTimePolicyContext(context.Background(), params)
+}
+
+/*
+SetVirtualTimePolicyContext is the context.Context-aware version of
+SetVirtualTimePolicy.
+*/
+func (protocol *EmulationProtocol) SetVirtualTimePolicyContext(
+	ctx context.Context,
+	params *emulation.SetVirtualTimePolicyParams,
 ) <-chan *emulation.SetVirtualTimePolicyResult {
 	resultChan := make(chan *emulation.SetVirtualTimePolicyResult)
 	command := NewCommand(protocol.Socket, "Emulation.SetVirtualTimePolicy", nil)
 	result := &emulation.SetVirtualTimePolicyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -397,14 +588,26 @@ EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *EmulationProtocol) SetVisibleSize(
 	params *emulation.SetVisibleSizeParams,
+) <-chan *emulation.SetVisibleSizeResult {
+	return protocol.SetVisibleSizeContext(context.Background(), params)
+}
+
+/*
+SetVisibleSizeContext is the context.Context-aware version of SetVisibleSize.
+*/
+func (protocol *EmulationProtocol) SetVisibleSizeContext(
+	ctx context.Context,
+	params *emulation.SetVisibleSizeParams,
 ) <-chan *emulation.SetVisibleSizeResult {
 	resultChan := make(chan *emulation.SetVisibleSizeResult)
 	command := NewCommand(protocol.Socket, "Emulation.setVisibleSize", params)
 	result := &emulation.SetVisibleSizeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -423,7 +626,99 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) OnVirtualTimeAdvanced(
 	callback func(event *emulation.VirtualTimeAdvancedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Emulation.virtualTimeAdvanced",
+		func(response *Response) {
+			event := &emulation.VirtualTimeAdvancedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+VirtualTimeAdvancedEvents returns a channel that receives each
+Emulation.virtualTimeAdvanced event. The channel is closed when the context is
+done or the socket stops listening. See OnVirtualTimeAdvanced.
+*/
+func (protocol *EmulationProtocol) VirtualTimeAdvancedEvents(
+	ctx context.Context,
+) <-chan *emulation.VirtualTimeAdvancedEvent {
+	eventChan := make(chan *emulation.VirtualTimeAdvancedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Emulation.virtualTimeAdvanced")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &emulation.VirtualTimeAdvancedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForVirtualTimeAdvanced subscribes to the Emulation.virtualTimeAdvanced event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *EmulationProtocol) WaitForVirtualTimeAdvanced(
+	ctx context.Context,
+	predicate func(event *emulation.VirtualTimeAdvancedEvent) bool,
+) <-chan *emulation.VirtualTimeAdvancedEvent {
+	eventChan := make(chan *emulation.VirtualTimeAdvancedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Emulation.virtualTimeAdvanced",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &emulation.VirtualTimeAdvancedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &emulation.VirtualTimeAdvancedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnVirtualTimeAdvancedOnce adds a handler to the Emulation.virtualTimeAdvanced
+event that is removed after the first event is received. See
+OnVirtualTimeAdvanced.
+*/
+func (protocol *EmulationProtocol) OnVirtualTimeAdvancedOnce(
+	callback func(event *emulation.VirtualTimeAdvancedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Emulation.virtualTimeAdvanced",
 		func(response *Response) {
@@ -435,7 +730,7 @@ func (protocol *EmulationProtocol) OnVirtualTimeAdvanced(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -448,7 +743,7 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpired(
 	callback func(event *emulation.VirtualTimeBudgetExpiredEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Emulation.virtualTimeBudgetExpired",
 		func(response *Response) {
@@ -460,7 +755,99 @@ func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpired(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+VirtualTimeBudgetExpiredEvents returns a channel that receives each
+Emulation.virtualTimeBudgetExpired event. The channel is closed when the context
+is done or the socket stops listening. See OnVirtualTimeBudgetExpired.
+*/
+func (protocol *EmulationProtocol) VirtualTimeBudgetExpiredEvents(
+	ctx context.Context,
+) <-chan *emulation.VirtualTimeBudgetExpiredEvent {
+	eventChan := make(chan *emulation.VirtualTimeBudgetExpiredEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Emulation.virtualTimeBudgetExpired")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &emulation.VirtualTimeBudgetExpiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForVirtualTimeBudgetExpired subscribes to the
+Emulation.virtualTimeBudgetExpired event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *EmulationProtocol) WaitForVirtualTimeBudgetExpired(
+	ctx context.Context,
+	predicate func(event *emulation.VirtualTimeBudgetExpiredEvent) bool,
+) <-chan *emulation.VirtualTimeBudgetExpiredEvent {
+	eventChan := make(chan *emulation.VirtualTimeBudgetExpiredEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Emulation.virtualTimeBudgetExpired",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &emulation.VirtualTimeBudgetExpiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &emulation.VirtualTimeBudgetExpiredEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnVirtualTimeBudgetExpiredOnce adds a handler to the
+Emulation.virtualTimeBudgetExpired event that is removed after the first event
+is received. See OnVirtualTimeBudgetExpired.
+*/
+func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpiredOnce(
+	callback func(event *emulation.VirtualTimeBudgetExpiredEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Emulation.virtualTimeBudgetExpired",
+		func(response *Response) {
+			event := &emulation.VirtualTimeBudgetExpiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -472,7 +859,98 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) OnVirtualTimePaused(
 	callback func(event *emulation.VirtualTimePausedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Emulation.virtualTimePaused",
+		func(response *Response) {
+			event := &emulation.VirtualTimePausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+VirtualTimePausedEvents returns a channel that receives each
+Emulation.virtualTimePaused event. The channel is closed when the context is
+done or the socket stops listening. See OnVirtualTimePaused.
+*/
+func (protocol *EmulationProtocol) VirtualTimePausedEvents(
+	ctx context.Context,
+) <-chan *emulation.VirtualTimePausedEvent {
+	eventChan := make(chan *emulation.VirtualTimePausedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Emulation.virtualTimePaused")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &emulation.VirtualTimePausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForVirtualTimePaused subscribes to the Emulation.virtualTimePaused event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *EmulationProtocol) WaitForVirtualTimePaused(
+	ctx context.Context,
+	predicate func(event *emulation.VirtualTimePausedEvent) bool,
+) <-chan *emulation.VirtualTimePausedEvent {
+	eventChan := make(chan *emulation.VirtualTimePausedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Emulation.virtualTimePaused",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &emulation.VirtualTimePausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &emulation.VirtualTimePausedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnVirtualTimePausedOnce adds a handler to the Emulation.virtualTimePaused event
+that is removed after the first event is received. See OnVirtualTimePaused.
+*/
+func (protocol *EmulationProtocol) OnVirtualTimePausedOnce(
+	callback func(event *emulation.VirtualTimePausedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Emulation.virtualTimePaused",
 		func(response *Response) {
@@ -484,5 +962,5 @@ func (protocol *EmulationProtocol) OnVirtualTimePaused(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/headless/experimental"
@@ -26,14 +27,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#met
 */
 func (protocol *HeadlessExperimentalProtocol) BeginFrame(
 	params *experimental.BeginFrameParams,
+) <-chan *experimental.BeginFrameResult {
+	return protocol.BeginFrameContext(context.Background(), params)
+}
+
+/*
+BeginFrameContext is the context.Context-aware version of BeginFrame.
+*/
+func (protocol *HeadlessExperimentalProtocol) BeginFrameContext(
+	ctx context.Context,
+	params *experimental.BeginFrameParams,
 ) <-chan *experimental.BeginFrameResult {
 	resultChan := make(chan *experimental.BeginFrameResult)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.beginFrame", params)
 	result := &experimental.BeginFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -51,13 +64,24 @@ Disable disables headless events for the target.
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-disable
 */
 func (protocol *HeadlessExperimentalProtocol) Disable() <-chan *experimental.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *HeadlessExperimentalProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *experimental.DisableResult {
 	resultChan := make(chan *experimental.DisableResult)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.disable", nil)
 	result := &experimental.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -73,13 +97,24 @@ Enable enables headless events for the target.
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-enable
 */
 func (protocol *HeadlessExperimentalProtocol) Enable() <-chan *experimental.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *HeadlessExperimentalProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *experimental.EnableResult {
 	resultChan := make(chan *experimental.EnableResult)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.enable", nil)
 	result := &experimental.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -99,7 +134,101 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#eve
 */
 func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshots(
 	callback func(event *experimental.MainFrameReadyForScreenshotsEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"HeadlessExperimental.mainFrameReadyForScreenshots",
+		func(response *Response) {
+			event := &experimental.MainFrameReadyForScreenshotsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+MainFrameReadyForScreenshotsEvents returns a channel that receives each
+HeadlessExperimental.mainFrameReadyForScreenshots event. The channel is closed
+when the context is done or the socket stops listening. See
+OnMainFrameReadyForScreenshots.
+*/
+func (protocol *HeadlessExperimentalProtocol) MainFrameReadyForScreenshotsEvents(
+	ctx context.Context,
+) <-chan *experimental.MainFrameReadyForScreenshotsEvent {
+	eventChan := make(chan *experimental.MainFrameReadyForScreenshotsEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "HeadlessExperimental.mainFrameReadyForScreenshots")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &experimental.MainFrameReadyForScreenshotsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForMainFrameReadyForScreenshots subscribes to the
+HeadlessExperimental.mainFrameReadyForScreenshots event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *HeadlessExperimentalProtocol) WaitForMainFrameReadyForScreenshots(
+	ctx context.Context,
+	predicate func(event *experimental.MainFrameReadyForScreenshotsEvent) bool,
+) <-chan *experimental.MainFrameReadyForScreenshotsEvent {
+	eventChan := make(chan *experimental.MainFrameReadyForScreenshotsEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"HeadlessExperimental.mainFrameReadyForScreenshots",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &experimental.MainFrameReadyForScreenshotsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &experimental.MainFrameReadyForScreenshotsEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnMainFrameReadyForScreenshotsOnce adds a handler to the
+HeadlessExperimental.mainFrameReadyForScreenshots event that is removed after
+the first event is received. See OnMainFrameReadyForScreenshots.
+*/
+func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshotsOnce(
+	callback func(event *experimental.MainFrameReadyForScreenshotsEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeadlessExperimental.mainFrameReadyForScreenshots",
 		func(response *Response) {
@@ -111,7 +240,7 @@ func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshots(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -123,7 +252,100 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#eve
 */
 func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChanged(
 	callback func(event *experimental.NeedsBeginFramesChangedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"HeadlessExperimental.needsBeginFramesChanged",
+		func(response *Response) {
+			event := &experimental.NeedsBeginFramesChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+NeedsBeginFramesChangedEvents returns a channel that receives each
+HeadlessExperimental.needsBeginFramesChanged event. The channel is closed when
+the context is done or the socket stops listening. See
+OnNeedsBeginFramesChanged.
+*/
+func (protocol *HeadlessExperimentalProtocol) NeedsBeginFramesChangedEvents(
+	ctx context.Context,
+) <-chan *experimental.NeedsBeginFramesChangedEvent {
+	eventChan := make(chan *experimental.NeedsBeginFramesChangedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "HeadlessExperimental.needsBeginFramesChanged")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &experimental.NeedsBeginFramesChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForNeedsBeginFramesChanged subscribes to the
+HeadlessExperimental.needsBeginFramesChanged event and returns a channel that
+receives the first event for which the predicate returns true. A nil predicate
+matches any event. If the context is done or the socket stops listening first,
+an event containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *HeadlessExperimentalProtocol) WaitForNeedsBeginFramesChanged(
+	ctx context.Context,
+	predicate func(event *experimental.NeedsBeginFramesChangedEvent) bool,
+) <-chan *experimental.NeedsBeginFramesChangedEvent {
+	eventChan := make(chan *experimental.NeedsBeginFramesChangedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"HeadlessExperimental.needsBeginFramesChanged",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &experimental.NeedsBeginFramesChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &experimental.NeedsBeginFramesChangedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnNeedsBeginFramesChangedOnce adds a handler to the
+HeadlessExperimental.needsBeginFramesChanged event that is removed after the
+first event is received. See OnNeedsBeginFramesChanged.
+*/
+func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChangedOnce(
+	callback func(event *experimental.NeedsBeginFramesChangedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeadlessExperimental.needsBeginFramesChanged",
 		func(response *Response) {
@@ -135,5 +357,5 @@ func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/heap/profiler"
@@ -24,14 +25,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-addI
 */
 func (protocol *HeapProfilerProtocol) AddInspectedHeapObject(
 	params *profiler.AddInspectedHeapObjectParams,
+) <-chan *profiler.AddInspectedHeapObjectResult {
+	return protocol.AddInspectedHeapObjectContext(context.Background(), params)
+}
+
+/*
+AddInspectedHeapObjectContext is the context.Context-aware version of
+AddInspectedHeapObject.
+*/
+func (protocol *HeapProfilerProtocol) AddInspectedHeapObjectContext(
+	ctx context.Context,
+	params *profiler.AddInspectedHeapObjectParams,
 ) <-chan *profiler.AddInspectedHeapObjectResult {
 	resultChan := make(chan *profiler.AddInspectedHeapObjectResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.addInspectedHeapObject", params)
 	result := &profiler.AddInspectedHeapObjectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -48,13 +62,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-coll
 EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) CollectGarbage() <-chan *profiler.CollectGarbageResult {
+	return protocol.CollectGarbageContext(context.Background())
+}
+
+/*
+CollectGarbageContext is the context.Context-aware version of CollectGarbage.
+*/
+func (protocol *HeapProfilerProtocol) CollectGarbageContext(
+	ctx context.Context,
+) <-chan *profiler.CollectGarbageResult {
 	resultChan := make(chan *profiler.CollectGarbageResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.collectGarbage", nil)
 	result := &profiler.CollectGarbageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -70,13 +95,24 @@ Disable disables the HeapProfiler.
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-disable
 */
 func (protocol *HeapProfilerProtocol) Disable() <-chan *profiler.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *HeapProfilerProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *profiler.DisableResult {
 	resultChan := make(chan *profiler.DisableResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.disable", nil)
 	result := &profiler.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -92,13 +128,24 @@ Enable enables the HeapProfiler.
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-enable
 */
 func (protocol *HeapProfilerProtocol) Enable() <-chan *profiler.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *HeapProfilerProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *profiler.EnableResult {
 	resultChan := make(chan *profiler.EnableResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.enable", nil)
 	result := &profiler.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -116,14 +163,26 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetHeapObjectID(
 	params *profiler.GetHeapObjectIDParams,
+) <-chan *profiler.GetHeapObjectIDResult {
+	return protocol.GetHeapObjectIDContext(context.Background(), params)
+}
+
+/*
+GetHeapObjectIDContext is the context.Context-aware version of GetHeapObjectID.
+*/
+func (protocol *HeapProfilerProtocol) GetHeapObjectIDContext(
+	ctx context.Context,
+	params *profiler.GetHeapObjectIDParams,
 ) <-chan *profiler.GetHeapObjectIDResult {
 	resultChan := make(chan *profiler.GetHeapObjectIDResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.getHeapObjectID", params)
 	result := &profiler.GetHeapObjectIDResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -143,14 +202,27 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetObjectByHeapObjectID(
 	params *profiler.GetObjectByHeapObjectIDParams,
+) <-chan *profiler.GetObjectByHeapObjectIDResult {
+	return protocol.GetObjectByHeapObjectIDContext(context.Background(), params)
+}
+
+/*
+GetObjectByHeapObjectIDContext is the context.Context-aware version of
+GetObjectByHeapObjectID.
+*/
+func (protocol *HeapProfilerProtocol) GetObjectByHeapObjectIDContext(
+	ctx context.Context,
+	params *profiler.GetObjectByHeapObjectIDParams,
 ) <-chan *profiler.GetObjectByHeapObjectIDResult {
 	resultChan := make(chan *profiler.GetObjectByHeapObjectIDResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.getObjectByHeapObjectId", params)
 	result := &profiler.GetObjectByHeapObjectIDResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -170,14 +242,27 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetSamplingProfile(
 	params *profiler.GetSamplingProfileParams,
+) <-chan *profiler.GetSamplingProfileResult {
+	return protocol.GetSamplingProfileContext(context.Background(), params)
+}
+
+/*
+GetSamplingProfileContext is the context.Context-aware version of
+GetSamplingProfile.
+*/
+func (protocol *HeapProfilerProtocol) GetSamplingProfileContext(
+	ctx context.Context,
+	params *profiler.GetSamplingProfileParams,
 ) <-chan *profiler.GetSamplingProfileResult {
 	resultChan := make(chan *profiler.GetSamplingProfileResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.getSamplingProfile", params)
 	result := &profiler.GetSamplingProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -195,14 +280,26 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StartSampling(
 	params *profiler.StartSamplingParams,
+) <-chan *profiler.StartSamplingResult {
+	return protocol.StartSamplingContext(context.Background(), params)
+}
+
+/*
+StartSamplingContext is the context.Context-aware version of StartSampling.
+*/
+func (protocol *HeapProfilerProtocol) StartSamplingContext(
+	ctx context.Context,
+	params *profiler.StartSamplingParams,
 ) <-chan *profiler.StartSamplingResult {
 	resultChan := make(chan *profiler.StartSamplingResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.startSampling", params)
 	result := &profiler.StartSamplingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -220,14 +317,27 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StartTrackingHeapObjects(
 	params *profiler.StartTrackingHeapObjectsParams,
+) <-chan *profiler.StartTrackingHeapObjectsResult {
+	return protocol.StartTrackingHeapObjectsContext(context.Background(), params)
+}
+
+/*
+StartTrackingHeapObjectsContext is the context.Context-aware version of
+StartTrackingHeapObjects.
+*/
+func (protocol *HeapProfilerProtocol) StartTrackingHeapObjectsContext(
+	ctx context.Context,
+	params *profiler.StartTrackingHeapObjectsParams,
 ) <-chan *profiler.StartTrackingHeapObjectsResult {
 	resultChan := make(chan *profiler.StartTrackingHeapObjectsResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.startTrackingHeapObjects", params)
 	result := &profiler.StartTrackingHeapObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -245,14 +355,26 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StopSampling(
 	params *profiler.StopSamplingParams,
+) <-chan *profiler.StopSamplingResult {
+	return protocol.StopSamplingContext(context.Background(), params)
+}
+
+/*
+StopSamplingContext is the context.Context-aware version of StopSampling.
+*/
+func (protocol *HeapProfilerProtocol) StopSamplingContext(
+	ctx context.Context,
+	params *profiler.StopSamplingParams,
 ) <-chan *profiler.StopSamplingResult {
 	resultChan := make(chan *profiler.StopSamplingResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.stopSampling", params)
 	result := &profiler.StopSamplingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -270,14 +392,27 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StopTrackingHeapObjects(
 	params *profiler.StopTrackingHeapObjectsParams,
+) <-chan *profiler.StopTrackingHeapObjectsResult {
+	return protocol.StopTrackingHeapObjectsContext(context.Background(), params)
+}
+
+/*
+StopTrackingHeapObjectsContext is the context.Context-aware version of
+StopTrackingHeapObjects.
+*/
+func (protocol *HeapProfilerProtocol) StopTrackingHeapObjectsContext(
+	ctx context.Context,
+	params *profiler.StopTrackingHeapObjectsParams,
 ) <-chan *profiler.StopTrackingHeapObjectsResult {
 	resultChan := make(chan *profiler.StopTrackingHeapObjectsResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.stopTrackingHeapObjects", params)
 	result := &profiler.StopTrackingHeapObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -295,14 +430,27 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) TakeHeapSnapshot(
 	params *profiler.TakeHeapSnapshotParams,
+) <-chan *profiler.TakeHeapSnapshotResult {
+	return protocol.TakeHeapSnapshotContext(context.Background(), params)
+}
+
+/*
+TakeHeapSnapshotContext is the context.Context-aware version of
+TakeHeapSnapshot.
+*/
+func (protocol *HeapProfilerProtocol) TakeHeapSnapshotContext(
+	ctx context.Context,
+	params *profiler.TakeHeapSnapshotParams,
 ) <-chan *profiler.TakeHeapSnapshotResult {
 	resultChan := make(chan *profiler.TakeHeapSnapshotResult)
 	command := NewCommand(protocol.Socket, "HeapProfiler.takeHeapSnapshot", params)
 	result := &profiler.TakeHeapSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -321,7 +469,99 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunk(
 	callback func(event *profiler.AddHeapSnapshotChunkEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"HeapProfiler.addHeapSnapshotChunk",
+		func(response *Response) {
+			event := &profiler.AddHeapSnapshotChunkEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AddHeapSnapshotChunkEvents returns a channel that receives each
+HeapProfiler.addHeapSnapshotChunk event. The channel is closed when the context
+is done or the socket stops listening. See OnAddHeapSnapshotChunk.
+*/
+func (protocol *HeapProfilerProtocol) AddHeapSnapshotChunkEvents(
+	ctx context.Context,
+) <-chan *profiler.AddHeapSnapshotChunkEvent {
+	eventChan := make(chan *profiler.AddHeapSnapshotChunkEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "HeapProfiler.addHeapSnapshotChunk")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &profiler.AddHeapSnapshotChunkEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAddHeapSnapshotChunk subscribes to the HeapProfiler.addHeapSnapshotChunk
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *HeapProfilerProtocol) WaitForAddHeapSnapshotChunk(
+	ctx context.Context,
+	predicate func(event *profiler.AddHeapSnapshotChunkEvent) bool,
+) <-chan *profiler.AddHeapSnapshotChunkEvent {
+	eventChan := make(chan *profiler.AddHeapSnapshotChunkEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"HeapProfiler.addHeapSnapshotChunk",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &profiler.AddHeapSnapshotChunkEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &profiler.AddHeapSnapshotChunkEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAddHeapSnapshotChunkOnce adds a handler to the
+HeapProfiler.addHeapSnapshotChunk event that is removed after the first event is
+received. See OnAddHeapSnapshotChunk.
+*/
+func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunkOnce(
+	callback func(event *profiler.AddHeapSnapshotChunkEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.addHeapSnapshotChunk",
 		func(response *Response) {
@@ -333,7 +573,7 @@ func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunk(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -345,7 +585,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-heapS
 */
 func (protocol *HeapProfilerProtocol) OnHeapStatsUpdate(
 	callback func(event *profiler.HeapStatsUpdateEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"HeapProfiler.heapStatsUpdate",
+		func(response *Response) {
+			event := &profiler.HeapStatsUpdateEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+HeapStatsUpdateEvents returns a channel that receives each
+HeapProfiler.heapStatsUpdate event. The channel is closed when the context is
+done or the socket stops listening. See OnHeapStatsUpdate.
+*/
+func (protocol *HeapProfilerProtocol) HeapStatsUpdateEvents(
+	ctx context.Context,
+) <-chan *profiler.HeapStatsUpdateEvent {
+	eventChan := make(chan *profiler.HeapStatsUpdateEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "HeapProfiler.heapStatsUpdate")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &profiler.HeapStatsUpdateEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForHeapStatsUpdate subscribes to the HeapProfiler.heapStatsUpdate event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *HeapProfilerProtocol) WaitForHeapStatsUpdate(
+	ctx context.Context,
+	predicate func(event *profiler.HeapStatsUpdateEvent) bool,
+) <-chan *profiler.HeapStatsUpdateEvent {
+	eventChan := make(chan *profiler.HeapStatsUpdateEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"HeapProfiler.heapStatsUpdate",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &profiler.HeapStatsUpdateEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &profiler.HeapStatsUpdateEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnHeapStatsUpdateOnce adds a handler to the HeapProfiler.heapStatsUpdate event
+that is removed after the first event is received. See OnHeapStatsUpdate.
+*/
+func (protocol *HeapProfilerProtocol) OnHeapStatsUpdateOnce(
+	callback func(event *profiler.HeapStatsUpdateEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.heapStatsUpdate",
 		func(response *Response) {
@@ -357,7 +688,7 @@ func (protocol *HeapProfilerProtocol) OnHeapStatsUpdate(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -371,7 +702,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-lastS
 */
 func (protocol *HeapProfilerProtocol) OnLastSeenObjectID(
 	callback func(event *profiler.LastSeenObjectIDEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"HeapProfiler.lastSeenObjectID",
+		func(response *Response) {
+			event := &profiler.LastSeenObjectIDEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+LastSeenObjectIDEvents returns a channel that receives each
+HeapProfiler.lastSeenObjectID event. The channel is closed when the context is
+done or the socket stops listening. See OnLastSeenObjectID.
+*/
+func (protocol *HeapProfilerProtocol) LastSeenObjectIDEvents(
+	ctx context.Context,
+) <-chan *profiler.LastSeenObjectIDEvent {
+	eventChan := make(chan *profiler.LastSeenObjectIDEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "HeapProfiler.lastSeenObjectID")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &profiler.LastSeenObjectIDEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForLastSeenObjectID subscribes to the HeapProfiler.lastSeenObjectID event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *HeapProfilerProtocol) WaitForLastSeenObjectID(
+	ctx context.Context,
+	predicate func(event *profiler.LastSeenObjectIDEvent) bool,
+) <-chan *profiler.LastSeenObjectIDEvent {
+	eventChan := make(chan *profiler.LastSeenObjectIDEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"HeapProfiler.lastSeenObjectID",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &profiler.LastSeenObjectIDEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &profiler.LastSeenObjectIDEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnLastSeenObjectIDOnce adds a handler to the HeapProfiler.lastSeenObjectID event
+that is removed after the first event is received. See OnLastSeenObjectID.
+*/
+func (protocol *HeapProfilerProtocol) OnLastSeenObjectIDOnce(
+	callback func(event *profiler.LastSeenObjectIDEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.lastSeenObjectID",
 		func(response *Response) {
@@ -383,7 +805,7 @@ func (protocol *HeapProfilerProtocol) OnLastSeenObjectID(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -396,7 +818,99 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgress(
 	callback func(event *profiler.ReportHeapSnapshotProgressEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"HeapProfiler.reportHeapSnapshotProgress",
+		func(response *Response) {
+			event := &profiler.ReportHeapSnapshotProgressEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ReportHeapSnapshotProgressEvents returns a channel that receives each
+HeapProfiler.reportHeapSnapshotProgress event. The channel is closed when the
+context is done or the socket stops listening. See OnReportHeapSnapshotProgress.
+*/
+func (protocol *HeapProfilerProtocol) ReportHeapSnapshotProgressEvents(
+	ctx context.Context,
+) <-chan *profiler.ReportHeapSnapshotProgressEvent {
+	eventChan := make(chan *profiler.ReportHeapSnapshotProgressEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "HeapProfiler.reportHeapSnapshotProgress")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &profiler.ReportHeapSnapshotProgressEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForReportHeapSnapshotProgress subscribes to the
+HeapProfiler.reportHeapSnapshotProgress event and returns a channel that
+receives the first event for which the predicate returns true. A nil predicate
+matches any event. If the context is done or the socket stops listening first,
+an event containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *HeapProfilerProtocol) WaitForReportHeapSnapshotProgress(
+	ctx context.Context,
+	predicate func(event *profiler.ReportHeapSnapshotProgressEvent) bool,
+) <-chan *profiler.ReportHeapSnapshotProgressEvent {
+	eventChan := make(chan *profiler.ReportHeapSnapshotProgressEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"HeapProfiler.reportHeapSnapshotProgress",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &profiler.ReportHeapSnapshotProgressEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &profiler.ReportHeapSnapshotProgressEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnReportHeapSnapshotProgressOnce adds a handler to the
+HeapProfiler.reportHeapSnapshotProgress event that is removed after the first
+event is received. See OnReportHeapSnapshotProgress.
+*/
+func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgressOnce(
+	callback func(event *profiler.ReportHeapSnapshotProgressEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.reportHeapSnapshotProgress",
 		func(response *Response) {
@@ -408,7 +922,7 @@ func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgress(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -419,7 +933,98 @@ EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnResetProfiles(
 	callback func(event *profiler.ResetProfilesEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"HeapProfiler.resetProfiles",
+		func(response *Response) {
+			event := &profiler.ResetProfilesEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ResetProfilesEvents returns a channel that receives each
+HeapProfiler.resetProfiles event. The channel is closed when the context is done
+or the socket stops listening. See OnResetProfiles.
+*/
+func (protocol *HeapProfilerProtocol) ResetProfilesEvents(
+	ctx context.Context,
+) <-chan *profiler.ResetProfilesEvent {
+	eventChan := make(chan *profiler.ResetProfilesEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "HeapProfiler.resetProfiles")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &profiler.ResetProfilesEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForResetProfiles subscribes to the HeapProfiler.resetProfiles event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *HeapProfilerProtocol) WaitForResetProfiles(
+	ctx context.Context,
+	predicate func(event *profiler.ResetProfilesEvent) bool,
+) <-chan *profiler.ResetProfilesEvent {
+	eventChan := make(chan *profiler.ResetProfilesEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"HeapProfiler.resetProfiles",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &profiler.ResetProfilesEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &profiler.ResetProfilesEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnResetProfilesOnce adds a handler to the HeapProfiler.resetProfiles event that
+is removed after the first event is received. See OnResetProfiles.
+*/
+func (protocol *HeapProfilerProtocol) OnResetProfilesOnce(
+	callback func(event *profiler.ResetProfilesEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.resetProfiles",
 		func(response *Response) {
@@ -431,5 +1036,5 @@ func (protocol *HeapProfilerProtocol) OnResetProfiles(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
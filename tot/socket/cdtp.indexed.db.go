@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/indexed/db"
@@ -23,14 +24,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-clearOb
 */
 func (protocol *IndexedDBProtocol) ClearObjectStore(
 	params *db.ClearObjectStoreParams,
+) <-chan *db.ClearObjectStoreResult {
+	return protocol.ClearObjectStoreContext(context.Background(), params)
+}
+
+/*
+ClearObjectStoreContext is the context.Context-aware version of
+ClearObjectStore.
+*/
+func (protocol *IndexedDBProtocol) ClearObjectStoreContext(
+	ctx context.Context,
+	params *db.ClearObjectStoreParams,
 ) <-chan *db.ClearObjectStoreResult {
 	resultChan := make(chan *db.ClearObjectStoreResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.clearObjectStore", params)
 	result := &db.ClearObjectStoreResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,14 +61,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteD
 */
 func (protocol *IndexedDBProtocol) DeleteDatabase(
 	params *db.DeleteDatabaseParams,
+) <-chan *db.DeleteDatabaseResult {
+	return protocol.DeleteDatabaseContext(context.Background(), params)
+}
+
+/*
+DeleteDatabaseContext is the context.Context-aware version of DeleteDatabase.
+*/
+func (protocol *IndexedDBProtocol) DeleteDatabaseContext(
+	ctx context.Context,
+	params *db.DeleteDatabaseParams,
 ) <-chan *db.DeleteDatabaseResult {
 	resultChan := make(chan *db.DeleteDatabaseResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.deleteDatabase", params)
 	result := &db.DeleteDatabaseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -71,14 +97,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteO
 */
 func (protocol *IndexedDBProtocol) DeleteObjectStoreEntries(
 	params *db.DeleteObjectStoreEntriesParams,
+) <-chan *db.DeleteObjectStoreEntriesResult {
+	return protocol.DeleteObjectStoreEntriesContext(context.Background(), params)
+}
+
+/*
+DeleteObjectStoreEntriesContext is the context.Context-aware version of
+DeleteObjectStoreEntries.
+*/
+func (protocol *IndexedDBProtocol) DeleteObjectStoreEntriesContext(
+	ctx context.Context,
+	params *db.DeleteObjectStoreEntriesParams,
 ) <-chan *db.DeleteObjectStoreEntriesResult {
 	resultChan := make(chan *db.DeleteObjectStoreEntriesResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.deleteObjectStoreEntries", params)
 	result := &db.DeleteObjectStoreEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,13 +133,24 @@ Disable disables events from backend.
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-disable
 */
 func (protocol *IndexedDBProtocol) Disable() <-chan *db.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *IndexedDBProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *db.DisableResult {
 	resultChan := make(chan *db.DisableResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.disable", nil)
 	result := &db.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -116,13 +166,24 @@ Enable enables events from backend.
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-enable
 */
 func (protocol *IndexedDBProtocol) Enable() <-chan *db.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *IndexedDBProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *db.EnableResult {
 	resultChan := make(chan *db.EnableResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.enable", nil)
 	result := &db.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -139,14 +200,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-request
 */
 func (protocol *IndexedDBProtocol) RequestData(
 	params *db.RequestDataParams,
+) <-chan *db.RequestDataResult {
+	return protocol.RequestDataContext(context.Background(), params)
+}
+
+/*
+RequestDataContext is the context.Context-aware version of RequestData.
+*/
+func (protocol *IndexedDBProtocol) RequestDataContext(
+	ctx context.Context,
+	params *db.RequestDataParams,
 ) <-chan *db.RequestDataResult {
 	resultChan := make(chan *db.RequestDataResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestData", params)
 	result := &db.RequestDataResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -165,14 +238,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-request
 */
 func (protocol *IndexedDBProtocol) RequestDatabase(
 	params *db.RequestDatabaseParams,
+) <-chan *db.RequestDatabaseResult {
+	return protocol.RequestDatabaseContext(context.Background(), params)
+}
+
+/*
+RequestDatabaseContext is the context.Context-aware version of RequestDatabase.
+*/
+func (protocol *IndexedDBProtocol) RequestDatabaseContext(
+	ctx context.Context,
+	params *db.RequestDatabaseParams,
 ) <-chan *db.RequestDatabaseResult {
 	resultChan := make(chan *db.RequestDatabaseResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestDatabase", params)
 	result := &db.RequestDatabaseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -191,14 +276,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-request
 */
 func (protocol *IndexedDBProtocol) RequestDatabaseNames(
 	params *db.RequestDatabaseNamesParams,
+) <-chan *db.RequestDatabaseNamesResult {
+	return protocol.RequestDatabaseNamesContext(context.Background(), params)
+}
+
+/*
+RequestDatabaseNamesContext is the context.Context-aware version of
+RequestDatabaseNames.
+*/
+func (protocol *IndexedDBProtocol) RequestDatabaseNamesContext(
+	ctx context.Context,
+	params *db.RequestDatabaseNamesParams,
 ) <-chan *db.RequestDatabaseNamesResult {
 	resultChan := make(chan *db.RequestDatabaseNamesResult)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestDatabaseNames", params)
 	result := &db.RequestDatabaseNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
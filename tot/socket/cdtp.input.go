@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"github.com/mkenney/go-chrome/tot/input"
 )
 
@@ -20,14 +21,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchKey
 */
 func (protocol *InputProtocol) DispatchKeyEvent(
 	params *input.DispatchKeyEventParams,
+) <-chan *input.DispatchKeyEventResult {
+	return protocol.DispatchKeyEventContext(context.Background(), params)
+}
+
+/*
+DispatchKeyEventContext is the context.Context-aware version of
+DispatchKeyEvent.
+*/
+func (protocol *InputProtocol) DispatchKeyEventContext(
+	ctx context.Context,
+	params *input.DispatchKeyEventParams,
 ) <-chan *input.DispatchKeyEventResult {
 	resultChan := make(chan *input.DispatchKeyEventResult)
 	command := NewCommand(protocol.Socket, "Input.dispatchKeyEvent", params)
 	result := &input.DispatchKeyEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -44,14 +58,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMou
 */
 func (protocol *InputProtocol) DispatchMouseEvent(
 	params *input.DispatchMouseEventParams,
+) <-chan *input.DispatchMouseEventResult {
+	return protocol.DispatchMouseEventContext(context.Background(), params)
+}
+
+/*
+DispatchMouseEventContext is the context.Context-aware version of
+DispatchMouseEvent.
+*/
+func (protocol *InputProtocol) DispatchMouseEventContext(
+	ctx context.Context,
+	params *input.DispatchMouseEventParams,
 ) <-chan *input.DispatchMouseEventResult {
 	resultChan := make(chan *input.DispatchMouseEventResult)
 	command := NewCommand(protocol.Socket, "Input.dispatchMouseEvent", params)
 	result := &input.DispatchMouseEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,14 +95,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchTou
 */
 func (protocol *InputProtocol) DispatchTouchEvent(
 	params *input.DispatchTouchEventParams,
+) <-chan *input.DispatchTouchEventResult {
+	return protocol.DispatchTouchEventContext(context.Background(), params)
+}
+
+/*
+DispatchTouchEventContext is the context.Context-aware version of
+DispatchTouchEvent.
+*/
+func (protocol *InputProtocol) DispatchTouchEventContext(
+	ctx context.Context,
+	params *input.DispatchTouchEventParams,
 ) <-chan *input.DispatchTouchEventResult {
 	resultChan := make(chan *input.DispatchTouchEventResult)
 	command := NewCommand(protocol.Socket, "Input.dispatchTouchEvent", params)
 	result := &input.DispatchTouchEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -93,14 +133,27 @@ EXPERIMENTAL.
 */
 func (protocol *InputProtocol) EmulateTouchFromMouseEvent(
 	params *input.EmulateTouchFromMouseEventParams,
+) <-chan *input.EmulateTouchFromMouseEventResult {
+	return protocol.EmulateTouchFromMouseEventContext(context.Background(), params)
+}
+
+/*
+EmulateTouchFromMouseEventContext is the context.Context-aware version of
+EmulateTouchFromMouseEvent.
+*/
+func (protocol *InputProtocol) EmulateTouchFromMouseEventContext(
+	ctx context.Context,
+	params *input.EmulateTouchFromMouseEventParams,
 ) <-chan *input.EmulateTouchFromMouseEventResult {
 	resultChan := make(chan *input.EmulateTouchFromMouseEventResult)
 	command := NewCommand(protocol.Socket, "Input.emulateTouchFromMouseEvent", params)
 	result := &input.EmulateTouchFromMouseEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -117,14 +170,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setIgnoreIn
 */
 func (protocol *InputProtocol) SetIgnoreEvents(
 	params *input.SetIgnoreEventsParams,
+) <-chan *input.SetIgnoreEventsResult {
+	return protocol.SetIgnoreEventsContext(context.Background(), params)
+}
+
+/*
+SetIgnoreEventsContext is the context.Context-aware version of SetIgnoreEvents.
+*/
+func (protocol *InputProtocol) SetIgnoreEventsContext(
+	ctx context.Context,
+	params *input.SetIgnoreEventsParams,
 ) <-chan *input.SetIgnoreEventsResult {
 	resultChan := make(chan *input.SetIgnoreEventsResult)
 	command := NewCommand(protocol.Socket, "Input.setIgnoreInputEvents", params)
 	result := &input.SetIgnoreEventsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -143,14 +208,27 @@ EXPERIMENTAL.
 */
 func (protocol *InputProtocol) SynthesizePinchGesture(
 	params *input.SynthesizePinchGestureParams,
+) <-chan *input.SynthesizePinchGestureResult {
+	return protocol.SynthesizePinchGestureContext(context.Background(), params)
+}
+
+/*
+SynthesizePinchGestureContext is the context.Context-aware version of
+SynthesizePinchGesture.
+*/
+func (protocol *InputProtocol) SynthesizePinchGestureContext(
+	ctx context.Context,
+	params *input.SynthesizePinchGestureParams,
 ) <-chan *input.SynthesizePinchGestureResult {
 	resultChan := make(chan *input.SynthesizePinchGestureResult)
 	command := NewCommand(protocol.Socket, "Input.synthesizePinchGesture", params)
 	result := &input.SynthesizePinchGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -169,14 +247,27 @@ EXPERIMENTAL.
 */
 func (protocol *InputProtocol) SynthesizeScrollGesture(
 	params *input.SynthesizeScrollGestureParams,
+) <-chan *input.SynthesizeScrollGestureResult {
+	return protocol.SynthesizeScrollGestureContext(context.Background(), params)
+}
+
+/*
+SynthesizeScrollGestureContext is the context.Context-aware version of
+SynthesizeScrollGesture.
+*/
+func (protocol *InputProtocol) SynthesizeScrollGestureContext(
+	ctx context.Context,
+	params *input.SynthesizeScrollGestureParams,
 ) <-chan *input.SynthesizeScrollGestureResult {
 	resultChan := make(chan *input.SynthesizeScrollGestureResult)
 	command := NewCommand(protocol.Socket, "Input.synthesizeScrollGesture", params)
 	result := &input.SynthesizeScrollGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -195,14 +286,27 @@ EXPERIMENTAL.
 */
 func (protocol *InputProtocol) SynthesizeTapGesture(
 	params *input.SynthesizeTapGestureParams,
+) <-chan *input.SynthesizeTapGestureResult {
+	return protocol.SynthesizeTapGestureContext(context.Background(), params)
+}
+
+/*
+SynthesizeTapGestureContext is the context.Context-aware version of
+SynthesizeTapGesture.
+*/
+func (protocol *InputProtocol) SynthesizeTapGestureContext(
+	ctx context.Context,
+	params *input.SynthesizeTapGestureParams,
 ) <-chan *input.SynthesizeTapGestureResult {
 	resultChan := make(chan *input.SynthesizeTapGestureResult)
 	command := NewCommand(protocol.Socket, "Input.synthesizeTapGesture", params)
 	result := &input.SynthesizeTapGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
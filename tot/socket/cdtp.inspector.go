@@ -0,0 +1,431 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/inspector"
+)
+
+/*
+InspectorProtocol provides a namespace for the Chrome Inspector protocol
+methods. The Inspector protocol reports when the inspected target crashes or
+the debugging session is detached.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/
+*/
+type InspectorProtocol struct {
+	Socket Socketer
+}
+
+/*
+Disable disables inspector domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#method-disable
+*/
+func (protocol *InspectorProtocol) Disable() <-chan *inspector.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *InspectorProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *inspector.DisableResult {
+	resultChan := make(chan *inspector.DisableResult)
+	command := NewCommand(protocol.Socket, "Inspector.disable", nil)
+	result := &inspector.DisableResult{}
+
+	go func() {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable enables inspector domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#method-enable
+*/
+func (protocol *InspectorProtocol) Enable() <-chan *inspector.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *InspectorProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *inspector.EnableResult {
+	resultChan := make(chan *inspector.EnableResult)
+	command := NewCommand(protocol.Socket, "Inspector.enable", nil)
+	result := &inspector.EnableResult{}
+
+	go func() {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDetached adds a handler to the Inspector.detached event. Inspector.detached
+fires when the remote debugging session is terminated, for example when the
+target is closed or another client attaches to it.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#event-detached
+*/
+func (protocol *InspectorProtocol) OnDetached(
+	callback func(event *inspector.DetachedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Inspector.detached",
+		func(response *Response) {
+			event := &inspector.DetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+DetachedEvents returns a channel that receives each Inspector.detached event.
+The channel is closed when the context is done or the socket stops listening.
+See OnDetached.
+*/
+func (protocol *InspectorProtocol) DetachedEvents(
+	ctx context.Context,
+) <-chan *inspector.DetachedEvent {
+	eventChan := make(chan *inspector.DetachedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Inspector.detached")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &inspector.DetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForDetached subscribes to the Inspector.detached event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *InspectorProtocol) WaitForDetached(
+	ctx context.Context,
+	predicate func(event *inspector.DetachedEvent) bool,
+) <-chan *inspector.DetachedEvent {
+	eventChan := make(chan *inspector.DetachedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Inspector.detached",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &inspector.DetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &inspector.DetachedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnDetachedOnce adds a handler to the Inspector.detached event that is removed
+after the first event is received. See OnDetached.
+*/
+func (protocol *InspectorProtocol) OnDetachedOnce(
+	callback func(event *inspector.DetachedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Inspector.detached",
+		func(response *Response) {
+			event := &inspector.DetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnTargetCrashed adds a handler to the Inspector.targetCrashed event.
+Inspector.targetCrashed fires when the debugging target has crashed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#event-targetCrashed
+*/
+func (protocol *InspectorProtocol) OnTargetCrashed(
+	callback func(event *inspector.TargetCrashedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Inspector.targetCrashed",
+		func(response *Response) {
+			event := &inspector.TargetCrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+TargetCrashedEvents returns a channel that receives each
+Inspector.targetCrashed event. The channel is closed when the context is done
+or the socket stops listening. See OnTargetCrashed.
+*/
+func (protocol *InspectorProtocol) TargetCrashedEvents(
+	ctx context.Context,
+) <-chan *inspector.TargetCrashedEvent {
+	eventChan := make(chan *inspector.TargetCrashedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Inspector.targetCrashed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &inspector.TargetCrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForTargetCrashed subscribes to the Inspector.targetCrashed event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *InspectorProtocol) WaitForTargetCrashed(
+	ctx context.Context,
+	predicate func(event *inspector.TargetCrashedEvent) bool,
+) <-chan *inspector.TargetCrashedEvent {
+	eventChan := make(chan *inspector.TargetCrashedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Inspector.targetCrashed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &inspector.TargetCrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &inspector.TargetCrashedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnTargetCrashedOnce adds a handler to the Inspector.targetCrashed event that
+is removed after the first event is received. See OnTargetCrashed.
+*/
+func (protocol *InspectorProtocol) OnTargetCrashedOnce(
+	callback func(event *inspector.TargetCrashedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Inspector.targetCrashed",
+		func(response *Response) {
+			event := &inspector.TargetCrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnTargetReloadedAfterCrash adds a handler to the
+Inspector.targetReloadedAfterCrash event. Inspector.targetReloadedAfterCrash
+fires when the debugging target has reloaded after a crash.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#event-targetReloadedAfterCrash
+*/
+func (protocol *InspectorProtocol) OnTargetReloadedAfterCrash(
+	callback func(event *inspector.TargetReloadedAfterCrashEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Inspector.targetReloadedAfterCrash",
+		func(response *Response) {
+			event := &inspector.TargetReloadedAfterCrashEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+TargetReloadedAfterCrashEvents returns a channel that receives each
+Inspector.targetReloadedAfterCrash event. The channel is closed when the
+context is done or the socket stops listening. See OnTargetReloadedAfterCrash.
+*/
+func (protocol *InspectorProtocol) TargetReloadedAfterCrashEvents(
+	ctx context.Context,
+) <-chan *inspector.TargetReloadedAfterCrashEvent {
+	eventChan := make(chan *inspector.TargetReloadedAfterCrashEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Inspector.targetReloadedAfterCrash")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &inspector.TargetReloadedAfterCrashEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForTargetReloadedAfterCrash subscribes to the
+Inspector.targetReloadedAfterCrash event and returns a channel that receives
+the first event for which the predicate returns true. A nil predicate matches
+any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *InspectorProtocol) WaitForTargetReloadedAfterCrash(
+	ctx context.Context,
+	predicate func(event *inspector.TargetReloadedAfterCrashEvent) bool,
+) <-chan *inspector.TargetReloadedAfterCrashEvent {
+	eventChan := make(chan *inspector.TargetReloadedAfterCrashEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Inspector.targetReloadedAfterCrash",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &inspector.TargetReloadedAfterCrashEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &inspector.TargetReloadedAfterCrashEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnTargetReloadedAfterCrashOnce adds a handler to the
+Inspector.targetReloadedAfterCrash event that is removed after the first event
+is received. See OnTargetReloadedAfterCrash.
+*/
+func (protocol *InspectorProtocol) OnTargetReloadedAfterCrashOnce(
+	callback func(event *inspector.TargetReloadedAfterCrashEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Inspector.targetReloadedAfterCrash",
+		func(response *Response) {
+			event := &inspector.TargetReloadedAfterCrashEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/io"
@@ -23,14 +24,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/IO/#method-close
 */
 func (protocol *IOProtocol) Close(
 	params *io.CloseParams,
+) <-chan *io.CloseResult {
+	return protocol.CloseContext(context.Background(), params)
+}
+
+/*
+CloseContext is the context.Context-aware version of Close.
+*/
+func (protocol *IOProtocol) CloseContext(
+	ctx context.Context,
+	params *io.CloseParams,
 ) <-chan *io.CloseResult {
 	resultChan := make(chan *io.CloseResult)
 	command := NewCommand(protocol.Socket, "IO.close", params)
 	result := &io.CloseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,14 +60,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/IO/#method-read
 */
 func (protocol *IOProtocol) Read(
 	params *io.ReadParams,
+) <-chan *io.ReadResult {
+	return protocol.ReadContext(context.Background(), params)
+}
+
+/*
+ReadContext is the context.Context-aware version of Read.
+*/
+func (protocol *IOProtocol) ReadContext(
+	ctx context.Context,
+	params *io.ReadParams,
 ) <-chan *io.ReadResult {
 	resultChan := make(chan *io.ReadResult)
 	command := NewCommand(protocol.Socket, "IO.read", params)
 	result := &io.ReadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -73,14 +98,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/IO/#method-resolveBlob
 */
 func (protocol *IOProtocol) ResolveBlob(
 	params *io.ResolveBlobParams,
+) <-chan *io.ResolveBlobResult {
+	return protocol.ResolveBlobContext(context.Background(), params)
+}
+
+/*
+ResolveBlobContext is the context.Context-aware version of ResolveBlob.
+*/
+func (protocol *IOProtocol) ResolveBlobContext(
+	ctx context.Context,
+	params *io.ResolveBlobParams,
 ) <-chan *io.ResolveBlobResult {
 	resultChan := make(chan *io.ResolveBlobResult)
 	command := NewCommand(protocol.Socket, "IO.resolveBlob", params)
 	result := &io.ResolveBlobResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/layer/tree"
@@ -23,14 +24,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-composi
 */
 func (protocol *LayerTreeProtocol) CompositingReasons(
 	params *tree.CompositingReasonsParams,
+) <-chan *tree.CompositingReasonsResult {
+	return protocol.CompositingReasonsContext(context.Background(), params)
+}
+
+/*
+CompositingReasonsContext is the context.Context-aware version of
+CompositingReasons.
+*/
+func (protocol *LayerTreeProtocol) CompositingReasonsContext(
+	ctx context.Context,
+	params *tree.CompositingReasonsParams,
 ) <-chan *tree.CompositingReasonsResult {
 	resultChan := make(chan *tree.CompositingReasonsResult)
 	command := NewCommand(protocol.Socket, "LayerTree.compositingReasons", params)
 	result := &tree.CompositingReasonsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -48,13 +62,24 @@ Disable disables compositing tree inspection.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-disable
 */
 func (protocol *LayerTreeProtocol) Disable() <-chan *tree.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *LayerTreeProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *tree.DisableResult {
 	resultChan := make(chan *tree.DisableResult)
 	command := NewCommand(protocol.Socket, "LayerTree.disable", nil)
 	result := &tree.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -70,13 +95,24 @@ Enable enables compositing tree inspection.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-enable
 */
 func (protocol *LayerTreeProtocol) Enable() <-chan *tree.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *LayerTreeProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *tree.EnableResult {
 	resultChan := make(chan *tree.EnableResult)
 	command := NewCommand(protocol.Socket, "LayerTree.enable", nil)
 	result := &tree.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -93,14 +129,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-loadSna
 */
 func (protocol *LayerTreeProtocol) LoadSnapshot(
 	params *tree.LoadSnapshotParams,
+) <-chan *tree.LoadSnapshotResult {
+	return protocol.LoadSnapshotContext(context.Background(), params)
+}
+
+/*
+LoadSnapshotContext is the context.Context-aware version of LoadSnapshot.
+*/
+func (protocol *LayerTreeProtocol) LoadSnapshotContext(
+	ctx context.Context,
+	params *tree.LoadSnapshotParams,
 ) <-chan *tree.LoadSnapshotResult {
 	resultChan := make(chan *tree.LoadSnapshotResult)
 	command := NewCommand(protocol.Socket, "LayerTree.loadSnapshot", params)
 	result := &tree.LoadSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -119,14 +167,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-makeSna
 */
 func (protocol *LayerTreeProtocol) MakeSnapshot(
 	params *tree.MakeSnapshotParams,
+) <-chan *tree.MakeSnapshotResult {
+	return protocol.MakeSnapshotContext(context.Background(), params)
+}
+
+/*
+MakeSnapshotContext is the context.Context-aware version of MakeSnapshot.
+*/
+func (protocol *LayerTreeProtocol) MakeSnapshotContext(
+	ctx context.Context,
+	params *tree.MakeSnapshotParams,
 ) <-chan *tree.MakeSnapshotResult {
 	resultChan := make(chan *tree.MakeSnapshotResult)
 	command := NewCommand(protocol.Socket, "LayerTree.makeSnapshot", params)
 	result := &tree.MakeSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -145,14 +205,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-profile
 */
 func (protocol *LayerTreeProtocol) ProfileSnapshot(
 	params *tree.ProfileSnapshotParams,
+) <-chan *tree.ProfileSnapshotResult {
+	return protocol.ProfileSnapshotContext(context.Background(), params)
+}
+
+/*
+ProfileSnapshotContext is the context.Context-aware version of ProfileSnapshot.
+*/
+func (protocol *LayerTreeProtocol) ProfileSnapshotContext(
+	ctx context.Context,
+	params *tree.ProfileSnapshotParams,
 ) <-chan *tree.ProfileSnapshotResult {
 	resultChan := make(chan *tree.ProfileSnapshotResult)
 	command := NewCommand(protocol.Socket, "LayerTree.profileSnapshot", params)
 	result := &tree.ProfileSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -171,14 +243,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-release
 */
 func (protocol *LayerTreeProtocol) ReleaseSnapshot(
 	params *tree.ReleaseSnapshotParams,
+) <-chan *tree.ReleaseSnapshotResult {
+	return protocol.ReleaseSnapshotContext(context.Background(), params)
+}
+
+/*
+ReleaseSnapshotContext is the context.Context-aware version of ReleaseSnapshot.
+*/
+func (protocol *LayerTreeProtocol) ReleaseSnapshotContext(
+	ctx context.Context,
+	params *tree.ReleaseSnapshotParams,
 ) <-chan *tree.ReleaseSnapshotResult {
 	resultChan := make(chan *tree.ReleaseSnapshotResult)
 	command := NewCommand(protocol.Socket, "LayerTree.releaseSnapshot", params)
 	result := &tree.ReleaseSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -195,14 +279,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-replayS
 */
 func (protocol *LayerTreeProtocol) ReplaySnapshot(
 	params *tree.ReplaySnapshotParams,
+) <-chan *tree.ReplaySnapshotResult {
+	return protocol.ReplaySnapshotContext(context.Background(), params)
+}
+
+/*
+ReplaySnapshotContext is the context.Context-aware version of ReplaySnapshot.
+*/
+func (protocol *LayerTreeProtocol) ReplaySnapshotContext(
+	ctx context.Context,
+	params *tree.ReplaySnapshotParams,
 ) <-chan *tree.ReplaySnapshotResult {
 	resultChan := make(chan *tree.ReplaySnapshotResult)
 	command := NewCommand(protocol.Socket, "LayerTree.replaySnapshot", params)
 	result := &tree.ReplaySnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -221,14 +317,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-snapsho
 */
 func (protocol *LayerTreeProtocol) SnapshotCommandLog(
 	params *tree.SnapshotCommandLogParams,
+) <-chan *tree.SnapshotCommandLogResult {
+	return protocol.SnapshotCommandLogContext(context.Background(), params)
+}
+
+/*
+SnapshotCommandLogContext is the context.Context-aware version of
+SnapshotCommandLog.
+*/
+func (protocol *LayerTreeProtocol) SnapshotCommandLogContext(
+	ctx context.Context,
+	params *tree.SnapshotCommandLogParams,
 ) <-chan *tree.SnapshotCommandLogResult {
 	resultChan := make(chan *tree.SnapshotCommandLogResult)
 	command := NewCommand(protocol.Socket, "LayerTree.snapshotCommandLog", params)
 	result := &tree.SnapshotCommandLogResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -248,7 +357,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerPai
 */
 func (protocol *LayerTreeProtocol) OnLayerPainted(
 	callback func(event *tree.LayerPaintedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"LayerTree.layerPainted",
+		func(response *Response) {
+			event := &tree.LayerPaintedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+LayerPaintedEvents returns a channel that receives each LayerTree.layerPainted
+event. The channel is closed when the context is done or the socket stops
+listening. See OnLayerPainted.
+*/
+func (protocol *LayerTreeProtocol) LayerPaintedEvents(
+	ctx context.Context,
+) <-chan *tree.LayerPaintedEvent {
+	eventChan := make(chan *tree.LayerPaintedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "LayerTree.layerPainted")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &tree.LayerPaintedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForLayerPainted subscribes to the LayerTree.layerPainted event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *LayerTreeProtocol) WaitForLayerPainted(
+	ctx context.Context,
+	predicate func(event *tree.LayerPaintedEvent) bool,
+) <-chan *tree.LayerPaintedEvent {
+	eventChan := make(chan *tree.LayerPaintedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"LayerTree.layerPainted",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &tree.LayerPaintedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &tree.LayerPaintedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnLayerPaintedOnce adds a handler to the LayerTree.layerPainted event that is
+removed after the first event is received. See OnLayerPainted.
+*/
+func (protocol *LayerTreeProtocol) OnLayerPaintedOnce(
+	callback func(event *tree.LayerPaintedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"LayerTree.layerPainted",
 		func(response *Response) {
@@ -260,7 +460,7 @@ func (protocol *LayerTreeProtocol) OnLayerPainted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -271,7 +471,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerTre
 */
 func (protocol *LayerTreeProtocol) OnLayerTreeDidChange(
 	callback func(event *tree.DidChangeEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"LayerTree.layerTreeDidChange",
+		func(response *Response) {
+			event := &tree.DidChangeEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+LayerTreeDidChangeEvents returns a channel that receives each
+LayerTree.layerTreeDidChange event. The channel is closed when the context is
+done or the socket stops listening. See OnLayerTreeDidChange.
+*/
+func (protocol *LayerTreeProtocol) LayerTreeDidChangeEvents(
+	ctx context.Context,
+) <-chan *tree.DidChangeEvent {
+	eventChan := make(chan *tree.DidChangeEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "LayerTree.layerTreeDidChange")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &tree.DidChangeEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForLayerTreeDidChange subscribes to the LayerTree.layerTreeDidChange event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *LayerTreeProtocol) WaitForLayerTreeDidChange(
+	ctx context.Context,
+	predicate func(event *tree.DidChangeEvent) bool,
+) <-chan *tree.DidChangeEvent {
+	eventChan := make(chan *tree.DidChangeEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"LayerTree.layerTreeDidChange",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &tree.DidChangeEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &tree.DidChangeEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnLayerTreeDidChangeOnce adds a handler to the LayerTree.layerTreeDidChange
+event that is removed after the first event is received. See
+OnLayerTreeDidChange.
+*/
+func (protocol *LayerTreeProtocol) OnLayerTreeDidChangeOnce(
+	callback func(event *tree.DidChangeEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"LayerTree.layerTreeDidChange",
 		func(response *Response) {
@@ -283,5 +575,5 @@ func (protocol *LayerTreeProtocol) OnLayerTreeDidChange(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
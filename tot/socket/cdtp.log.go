@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/log"
@@ -22,13 +23,24 @@ Clear clears the log.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-clear
 */
 func (protocol *LogProtocol) Clear() <-chan *log.ClearResult {
+	return protocol.ClearContext(context.Background())
+}
+
+/*
+ClearContext is the context.Context-aware version of Clear.
+*/
+func (protocol *LogProtocol) ClearContext(
+	ctx context.Context,
+) <-chan *log.ClearResult {
 	resultChan := make(chan *log.ClearResult)
 	command := NewCommand(protocol.Socket, "Log.clear", nil)
 	result := &log.ClearResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +57,24 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-disable
 */
 func (protocol *LogProtocol) Disable() <-chan *log.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *LogProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *log.DisableResult {
 	resultChan := make(chan *log.DisableResult)
 	command := NewCommand(protocol.Socket, "Log.disable", nil)
 	result := &log.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,13 +91,24 @@ means of the `entryAdded` notification.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-enable
 */
 func (protocol *LogProtocol) Enable() <-chan *log.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *LogProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *log.EnableResult {
 	resultChan := make(chan *log.EnableResult)
 	command := NewCommand(protocol.Socket, "Log.enable", nil)
 	result := &log.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -91,14 +125,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-startViolatio
 */
 func (protocol *LogProtocol) StartViolationsReport(
 	params *log.StartViolationsReportParams,
+) <-chan *log.StartViolationsReportResult {
+	return protocol.StartViolationsReportContext(context.Background(), params)
+}
+
+/*
+StartViolationsReportContext is the context.Context-aware version of
+StartViolationsReport.
+*/
+func (protocol *LogProtocol) StartViolationsReportContext(
+	ctx context.Context,
+	params *log.StartViolationsReportParams,
 ) <-chan *log.StartViolationsReportResult {
 	resultChan := make(chan *log.StartViolationsReportResult)
 	command := NewCommand(protocol.Socket, "Log.startViolationsReport", params)
 	result := &log.StartViolationsReportResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -114,13 +161,25 @@ StopViolationsReport stops violation reporting.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-stopViolationsReport
 */
 func (protocol *LogProtocol) StopViolationsReport() <-chan *log.StopViolationsReportResult {
+	return protocol.StopViolationsReportContext(context.Background())
+}
+
+/*
+StopViolationsReportContext is the context.Context-aware version of
+StopViolationsReport.
+*/
+func (protocol *LogProtocol) StopViolationsReportContext(
+	ctx context.Context,
+) <-chan *log.StopViolationsReportResult {
 	resultChan := make(chan *log.StopViolationsReportResult)
 	command := NewCommand(protocol.Socket, "Log.stopViolationsReport", nil)
 	result := &log.StopViolationsReportResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -138,7 +197,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Log/#event-entryAdded
 */
 func (protocol *LogProtocol) OnEntryAdded(
 	callback func(event *log.EntryAddedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Log.entryAdded",
+		func(response *Response) {
+			event := &log.EntryAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+EntryAddedEvents returns a channel that receives each Log.entryAdded event. The
+channel is closed when the context is done or the socket stops listening. See
+OnEntryAdded.
+*/
+func (protocol *LogProtocol) EntryAddedEvents(
+	ctx context.Context,
+) <-chan *log.EntryAddedEvent {
+	eventChan := make(chan *log.EntryAddedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Log.entryAdded")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &log.EntryAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForEntryAdded subscribes to the Log.entryAdded event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *LogProtocol) WaitForEntryAdded(
+	ctx context.Context,
+	predicate func(event *log.EntryAddedEvent) bool,
+) <-chan *log.EntryAddedEvent {
+	eventChan := make(chan *log.EntryAddedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Log.entryAdded",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &log.EntryAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &log.EntryAddedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnEntryAddedOnce adds a handler to the Log.entryAdded event that is removed
+after the first event is received. See OnEntryAdded.
+*/
+func (protocol *LogProtocol) OnEntryAddedOnce(
+	callback func(event *log.EntryAddedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Log.entryAdded",
 		func(response *Response) {
@@ -150,5 +300,5 @@ func (protocol *LogProtocol) OnEntryAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,8 @@
 package socket
 
 import (
+	"context"
+
 	"github.com/mkenney/go-chrome/tot/memory"
 )
 
@@ -21,14 +23,26 @@ EXPERIMENTAL.
 */
 func (protocol *MemoryProtocol) GetDOMCounters(
 	params *memory.GetDOMCountersParams,
+) <-chan *memory.GetDOMCountersResult {
+	return protocol.GetDOMCountersContext(context.Background(), params)
+}
+
+/*
+GetDOMCountersContext is the context.Context-aware version of GetDOMCounters.
+*/
+func (protocol *MemoryProtocol) GetDOMCountersContext(
+	ctx context.Context,
+	params *memory.GetDOMCountersParams,
 ) <-chan *memory.GetDOMCountersResult {
 	resultChan := make(chan *memory.GetDOMCountersResult)
 	command := NewCommand(protocol.Socket, "Memory.getDOMCounters", params)
 	result := &memory.GetDOMCountersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +59,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-prepareFor
 EXPERIMENTAL.
 */
 func (protocol *MemoryProtocol) PrepareForLeakDetection() <-chan *memory.PrepareForLeakDetectionResult {
+	return protocol.PrepareForLeakDetectionContext(context.Background())
+}
+
+/*
+PrepareForLeakDetectionContext is the context.Context-aware version of
+PrepareForLeakDetection.
+*/
+func (protocol *MemoryProtocol) PrepareForLeakDetectionContext(
+	ctx context.Context,
+) <-chan *memory.PrepareForLeakDetectionResult {
 	resultChan := make(chan *memory.PrepareForLeakDetectionResult)
 	command := NewCommand(protocol.Socket, "Memory.prepareForLeakDetection", nil)
 	result := &memory.PrepareForLeakDetectionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -69,14 +95,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-setPressur
 */
 func (protocol *MemoryProtocol) SetPressureNotificationsSuppressed(
 	params *memory.SetPressureNotificationsSuppressedParams,
+) <-chan *memory.SetPressureNotificationsSuppressedResult {
+	return protocol.SetPressureNotificationsSuppressedContext(context.Background(), params)
+}
+
+/*
+SetPressureNotificationsSuppressedContext is the context.Context-aware version
+of SetPressureNotificationsSuppressed.
+*/
+func (protocol *MemoryProtocol) SetPressureNotificationsSuppressedContext(
+	ctx context.Context,
+	params *memory.SetPressureNotificationsSuppressedParams,
 ) <-chan *memory.SetPressureNotificationsSuppressedResult {
 	resultChan := make(chan *memory.SetPressureNotificationsSuppressedResult)
 	command := NewCommand(protocol.Socket, "Memory.setPressureNotificationsSuppressed", params)
 	result := &memory.SetPressureNotificationsSuppressedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,14 +133,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-simulatePr
 */
 func (protocol *MemoryProtocol) SimulatePressureNotification(
 	params *memory.SimulatePressureNotificationParams,
+) <-chan *memory.SimulatePressureNotificationResult {
+	return protocol.SimulatePressureNotificationContext(context.Background(), params)
+}
+
+/*
+SimulatePressureNotificationContext is the context.Context-aware version of
+SimulatePressureNotification.
+*/
+func (protocol *MemoryProtocol) SimulatePressureNotificationContext(
+	ctx context.Context,
+	params *memory.SimulatePressureNotificationParams,
 ) <-chan *memory.SimulatePressureNotificationResult {
 	resultChan := make(chan *memory.SimulatePressureNotificationResult)
 	command := NewCommand(protocol.Socket, "Memory.simulatePressureNotification", params)
 	result := &memory.SimulatePressureNotificationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/network"
@@ -25,13 +26,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearB
 DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanClearBrowserCache() <-chan *network.CanClearBrowserCacheResult {
+	return protocol.CanClearBrowserCacheContext(context.Background())
+}
+
+/*
+CanClearBrowserCacheContext is the context.Context-aware version of
+CanClearBrowserCache.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCacheContext(
+	ctx context.Context,
+) <-chan *network.CanClearBrowserCacheResult {
 	resultChan := make(chan *network.CanClearBrowserCacheResult)
 	command := NewCommand(protocol.Socket, "Network.canClearBrowserCache", nil)
 	result := &network.CanClearBrowserCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -50,13 +63,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearB
 DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanClearBrowserCookies() <-chan *network.CanClearBrowserCookiesResult {
+	return protocol.CanClearBrowserCookiesContext(context.Background())
+}
+
+/*
+CanClearBrowserCookiesContext is the context.Context-aware version of
+CanClearBrowserCookies.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCookiesContext(
+	ctx context.Context,
+) <-chan *network.CanClearBrowserCookiesResult {
 	resultChan := make(chan *network.CanClearBrowserCookiesResult)
 	command := NewCommand(protocol.Socket, "Network.canClearBrowserCookies", nil)
 	result := &network.CanClearBrowserCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -75,13 +100,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canEmulat
 DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanEmulateConditions() <-chan *network.CanEmulateConditionsResult {
+	return protocol.CanEmulateConditionsContext(context.Background())
+}
+
+/*
+CanEmulateConditionsContext is the context.Context-aware version of
+CanEmulateConditions.
+*/
+func (protocol *NetworkProtocol) CanEmulateConditionsContext(
+	ctx context.Context,
+) <-chan *network.CanEmulateConditionsResult {
 	resultChan := make(chan *network.CanEmulateConditionsResult)
 	command := NewCommand(protocol.Socket, "Network.canEmulateNetworkConditions", nil)
 	result := &network.CanEmulateConditionsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -99,13 +136,25 @@ ClearBrowserCache clears browser cache.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCache
 */
 func (protocol *NetworkProtocol) ClearBrowserCache() <-chan *network.ClearBrowserCacheResult {
+	return protocol.ClearBrowserCacheContext(context.Background())
+}
+
+/*
+ClearBrowserCacheContext is the context.Context-aware version of
+ClearBrowserCache.
+*/
+func (protocol *NetworkProtocol) ClearBrowserCacheContext(
+	ctx context.Context,
+) <-chan *network.ClearBrowserCacheResult {
 	resultChan := make(chan *network.ClearBrowserCacheResult)
 	command := NewCommand(protocol.Socket, "Network.clearBrowserCache", nil)
 	result := &network.ClearBrowserCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -121,13 +170,25 @@ ClearBrowserCookies clears browser cookies.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCookies
 */
 func (protocol *NetworkProtocol) ClearBrowserCookies() <-chan *network.ClearBrowserCookiesResult {
+	return protocol.ClearBrowserCookiesContext(context.Background())
+}
+
+/*
+ClearBrowserCookiesContext is the context.Context-aware version of
+ClearBrowserCookies.
+*/
+func (protocol *NetworkProtocol) ClearBrowserCookiesContext(
+	ctx context.Context,
+) <-chan *network.ClearBrowserCookiesResult {
 	resultChan := make(chan *network.ClearBrowserCookiesResult)
 	result := &network.ClearBrowserCookiesResult{}
 	command := NewCommand(protocol.Socket, "Network.clearBrowserCookies", nil)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -149,14 +210,27 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) ContinueInterceptedRequest(
 	params *network.ContinueInterceptedRequestParams,
+) <-chan *network.ContinueInterceptedRequestResult {
+	return protocol.ContinueInterceptedRequestContext(context.Background(), params)
+}
+
+/*
+ContinueInterceptedRequestContext is the context.Context-aware version of
+ContinueInterceptedRequest.
+*/
+func (protocol *NetworkProtocol) ContinueInterceptedRequestContext(
+	ctx context.Context,
+	params *network.ContinueInterceptedRequestParams,
 ) <-chan *network.ContinueInterceptedRequestResult {
 	resultChan := make(chan *network.ContinueInterceptedRequestResult)
 	result := &network.ContinueInterceptedRequestResult{}
 	command := NewCommand(protocol.Socket, "Network.continueInterceptedRequest", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -174,14 +248,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-deleteCoo
 */
 func (protocol *NetworkProtocol) DeleteCookies(
 	params *network.DeleteCookiesParams,
+) <-chan *network.DeleteCookiesResult {
+	return protocol.DeleteCookiesContext(context.Background(), params)
+}
+
+/*
+DeleteCookiesContext is the context.Context-aware version of DeleteCookies.
+*/
+func (protocol *NetworkProtocol) DeleteCookiesContext(
+	ctx context.Context,
+	params *network.DeleteCookiesParams,
 ) <-chan *network.DeleteCookiesResult {
 	resultChan := make(chan *network.DeleteCookiesResult)
 	result := &network.DeleteCookiesResult{}
 	command := NewCommand(protocol.Socket, "Network.deleteCookies", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -198,13 +284,24 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-disable
 */
 func (protocol *NetworkProtocol) Disable() <-chan *network.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *NetworkProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *network.DisableResult {
 	resultChan := make(chan *network.DisableResult)
 	result := &network.DisableResult{}
 	command := NewCommand(protocol.Socket, "Network.disable", nil)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -221,14 +318,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-emulateNe
 */
 func (protocol *NetworkProtocol) EmulateConditions(
 	params *network.EmulateConditionsParams,
+) <-chan *network.EmulateConditionsResult {
+	return protocol.EmulateConditionsContext(context.Background(), params)
+}
+
+/*
+EmulateConditionsContext is the context.Context-aware version of
+EmulateConditions.
+*/
+func (protocol *NetworkProtocol) EmulateConditionsContext(
+	ctx context.Context,
+	params *network.EmulateConditionsParams,
 ) <-chan *network.EmulateConditionsResult {
 	resultChan := make(chan *network.EmulateConditionsResult)
 	result := &network.EmulateConditionsResult{}
 	command := NewCommand(protocol.Socket, "Network.emulateNetworkConditions", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -246,14 +356,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enable
 */
 func (protocol *NetworkProtocol) Enable(
 	params *network.EnableParams,
+) <-chan *network.EnableResult {
+	return protocol.EnableContext(context.Background(), params)
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *NetworkProtocol) EnableContext(
+	ctx context.Context,
+	params *network.EnableParams,
 ) <-chan *network.EnableResult {
 	resultChan := make(chan *network.EnableResult)
 	result := &network.EnableResult{}
 	command := NewCommand(protocol.Socket, "Network.enable", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -270,13 +392,24 @@ will return detailed cookie information in the `cookies` field.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getAllCookies
 */
 func (protocol *NetworkProtocol) GetAllCookies() <-chan *network.GetAllCookiesResult {
+	return protocol.GetAllCookiesContext(context.Background())
+}
+
+/*
+GetAllCookiesContext is the context.Context-aware version of GetAllCookies.
+*/
+func (protocol *NetworkProtocol) GetAllCookiesContext(
+	ctx context.Context,
+) <-chan *network.GetAllCookiesResult {
 	resultChan := make(chan *network.GetAllCookiesResult)
 	command := NewCommand(protocol.Socket, "Network.getAllCookies", nil)
 	result := &network.GetAllCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -296,14 +429,26 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) GetCertificate(
 	params *network.GetCertificateParams,
+) <-chan *network.GetCertificateResult {
+	return protocol.GetCertificateContext(context.Background(), params)
+}
+
+/*
+GetCertificateContext is the context.Context-aware version of GetCertificate.
+*/
+func (protocol *NetworkProtocol) GetCertificateContext(
+	ctx context.Context,
+	params *network.GetCertificateParams,
 ) <-chan *network.GetCertificateResult {
 	resultChan := make(chan *network.GetCertificateResult)
 	command := NewCommand(protocol.Socket, "Network.getCertificate", params)
 	result := &network.GetCertificateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -323,14 +468,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCookie
 */
 func (protocol *NetworkProtocol) GetCookies(
 	params *network.GetCookiesParams,
+) <-chan *network.GetCookiesResult {
+	return protocol.GetCookiesContext(context.Background(), params)
+}
+
+/*
+GetCookiesContext is the context.Context-aware version of GetCookies.
+*/
+func (protocol *NetworkProtocol) GetCookiesContext(
+	ctx context.Context,
+	params *network.GetCookiesParams,
 ) <-chan *network.GetCookiesResult {
 	resultChan := make(chan *network.GetCookiesResult)
 	command := NewCommand(protocol.Socket, "Network.getCookies", params)
 	result := &network.GetCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -349,14 +506,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getRespon
 */
 func (protocol *NetworkProtocol) GetResponseBody(
 	params *network.GetResponseBodyParams,
+) <-chan *network.GetResponseBodyResult {
+	return protocol.GetResponseBodyContext(context.Background(), params)
+}
+
+/*
+GetResponseBodyContext is the context.Context-aware version of GetResponseBody.
+*/
+func (protocol *NetworkProtocol) GetResponseBodyContext(
+	ctx context.Context,
+	params *network.GetResponseBodyParams,
 ) <-chan *network.GetResponseBodyResult {
 	resultChan := make(chan *network.GetResponseBodyResult)
 	command := NewCommand(protocol.Socket, "Network.getResponseBody", params)
 	result := &network.GetResponseBodyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -377,14 +546,27 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) GetResponseBodyForInterception(
 	params *network.GetResponseBodyForInterceptionParams,
+) <-chan *network.GetResponseBodyForInterceptionResult {
+	return protocol.GetResponseBodyForInterceptionContext(context.Background(), params)
+}
+
+/*
+GetResponseBodyForInterceptionContext is the context.Context-aware version of
+GetResponseBodyForInterception.
+*/
+func (protocol *NetworkProtocol) GetResponseBodyForInterceptionContext(
+	ctx context.Context,
+	params *network.GetResponseBodyForInterceptionParams,
 ) <-chan *network.GetResponseBodyForInterceptionResult {
 	resultChan := make(chan *network.GetResponseBodyForInterceptionResult)
 	command := NewCommand(protocol.Socket, "Network.getResponseBodyForInterception", params)
 	result := &network.GetResponseBodyForInterceptionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -405,14 +587,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-replayXHR
 */
 func (protocol *NetworkProtocol) ReplayXHR(
 	params *network.ReplayXHRParams,
+) <-chan *network.ReplayXHRResult {
+	return protocol.ReplayXHRContext(context.Background(), params)
+}
+
+/*
+ReplayXHRContext is the context.Context-aware version of ReplayXHR.
+*/
+func (protocol *NetworkProtocol) ReplayXHRContext(
+	ctx context.Context,
+	params *network.ReplayXHRParams,
 ) <-chan *network.ReplayXHRResult {
 	resultChan := make(chan *network.ReplayXHRResult)
 	result := &network.ReplayXHRResult{}
 	command := NewCommand(protocol.Socket, "Network.replayXHR", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -430,14 +624,27 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SearchInResponseBody(
 	params *network.SearchInResponseBodyParams,
+) <-chan *network.SearchInResponseBodyResult {
+	return protocol.SearchInResponseBodyContext(context.Background(), params)
+}
+
+/*
+SearchInResponseBodyContext is the context.Context-aware version of
+SearchInResponseBody.
+*/
+func (protocol *NetworkProtocol) SearchInResponseBodyContext(
+	ctx context.Context,
+	params *network.SearchInResponseBodyParams,
 ) <-chan *network.SearchInResponseBodyResult {
 	resultChan := make(chan *network.SearchInResponseBodyResult)
 	command := NewCommand(protocol.Socket, "Network.searchInResponseBody", params)
 	result := &network.SearchInResponseBodyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -457,14 +664,26 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SetBlockedURLs(
 	params *network.SetBlockedURLsParams,
+) <-chan *network.SetBlockedURLsResult {
+	return protocol.SetBlockedURLsContext(context.Background(), params)
+}
+
+/*
+SetBlockedURLsContext is the context.Context-aware version of SetBlockedURLs.
+*/
+func (protocol *NetworkProtocol) SetBlockedURLsContext(
+	ctx context.Context,
+	params *network.SetBlockedURLsParams,
 ) <-chan *network.SetBlockedURLsResult {
 	resultChan := make(chan *network.SetBlockedURLsResult)
 	result := &network.SetBlockedURLsResult{}
 	command := NewCommand(protocol.Socket, "Network.setBlockedURLs", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -482,14 +701,27 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SetBypassServiceWorker(
 	params *network.SetBypassServiceWorkerParams,
+) <-chan *network.SetBypassServiceWorkerResult {
+	return protocol.SetBypassServiceWorkerContext(context.Background(), params)
+}
+
+/*
+SetBypassServiceWorkerContext is the context.Context-aware version of
+SetBypassServiceWorker.
+*/
+func (protocol *NetworkProtocol) SetBypassServiceWorkerContext(
+	ctx context.Context,
+	params *network.SetBypassServiceWorkerParams,
 ) <-chan *network.SetBypassServiceWorkerResult {
 	resultChan := make(chan *network.SetBypassServiceWorkerResult)
 	result := &network.SetBypassServiceWorkerResult{}
 	command := NewCommand(protocol.Socket, "Network.setBypassServiceWorker", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -507,14 +739,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCacheD
 */
 func (protocol *NetworkProtocol) SetCacheDisabled(
 	params *network.SetCacheDisabledParams,
+) <-chan *network.SetCacheDisabledResult {
+	return protocol.SetCacheDisabledContext(context.Background(), params)
+}
+
+/*
+SetCacheDisabledContext is the context.Context-aware version of
+SetCacheDisabled.
+*/
+func (protocol *NetworkProtocol) SetCacheDisabledContext(
+	ctx context.Context,
+	params *network.SetCacheDisabledParams,
 ) <-chan *network.SetCacheDisabledResult {
 	resultChan := make(chan *network.SetCacheDisabledResult)
 	result := &network.SetCacheDisabledResult{}
 	command := NewCommand(protocol.Socket, "Network.setCacheDisabled", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -532,14 +777,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
 */
 func (protocol *NetworkProtocol) SetCookie(
 	params *network.SetCookieParams,
+) <-chan *network.SetCookieResult {
+	return protocol.SetCookieContext(context.Background(), params)
+}
+
+/*
+SetCookieContext is the context.Context-aware version of SetCookie.
+*/
+func (protocol *NetworkProtocol) SetCookieContext(
+	ctx context.Context,
+	params *network.SetCookieParams,
 ) <-chan *network.SetCookieResult {
 	resultChan := make(chan *network.SetCookieResult)
 	command := NewCommand(protocol.Socket, "Network.setCookie", params)
 	result := &network.SetCookieResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -558,14 +815,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
 */
 func (protocol *NetworkProtocol) SetCookies(
 	params *network.SetCookiesParams,
+) <-chan *network.SetCookiesResult {
+	return protocol.SetCookiesContext(context.Background(), params)
+}
+
+/*
+SetCookiesContext is the context.Context-aware version of SetCookies.
+*/
+func (protocol *NetworkProtocol) SetCookiesContext(
+	ctx context.Context,
+	params *network.SetCookiesParams,
 ) <-chan *network.SetCookiesResult {
 	resultChan := make(chan *network.SetCookiesResult)
 	result := &network.SetCookiesResult{}
 	command := NewCommand(protocol.Socket, "Network.setCookies", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -583,14 +852,27 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SetDataSizeLimitsForTest(
 	params *network.SetDataSizeLimitsForTestParams,
+) <-chan *network.SetDataSizeLimitsForTestResult {
+	return protocol.SetDataSizeLimitsForTestContext(context.Background(), params)
+}
+
+/*
+SetDataSizeLimitsForTestContext is the context.Context-aware version of
+SetDataSizeLimitsForTest.
+*/
+func (protocol *NetworkProtocol) SetDataSizeLimitsForTestContext(
+	ctx context.Context,
+	params *network.SetDataSizeLimitsForTestParams,
 ) <-chan *network.SetDataSizeLimitsForTestResult {
 	resultChan := make(chan *network.SetDataSizeLimitsForTestResult)
 	result := &network.SetDataSizeLimitsForTestResult{}
 	command := NewCommand(protocol.Socket, "Network.setDataSizeLimitsForTest", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -608,14 +890,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraH
 */
 func (protocol *NetworkProtocol) SetExtraHTTPHeaders(
 	params *network.SetExtraHTTPHeadersParams,
+) <-chan *network.SetExtraHTTPHeadersResult {
+	return protocol.SetExtraHTTPHeadersContext(context.Background(), params)
+}
+
+/*
+SetExtraHTTPHeadersContext is the context.Context-aware version of
+SetExtraHTTPHeaders.
+*/
+func (protocol *NetworkProtocol) SetExtraHTTPHeadersContext(
+	ctx context.Context,
+	params *network.SetExtraHTTPHeadersParams,
 ) <-chan *network.SetExtraHTTPHeadersResult {
 	resultChan := make(chan *network.SetExtraHTTPHeadersResult)
 	result := &network.SetExtraHTTPHeadersResult{}
 	command := NewCommand(protocol.Socket, "Network.setExtraHTTPHeaders", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -634,14 +929,27 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SetRequestInterception(
 	params *network.SetRequestInterceptionParams,
+) <-chan *network.SetRequestInterceptionResult {
+	return protocol.SetRequestInterceptionContext(context.Background(), params)
+}
+
+/*
+SetRequestInterceptionContext is the context.Context-aware version of
+SetRequestInterception.
+*/
+func (protocol *NetworkProtocol) SetRequestInterceptionContext(
+	ctx context.Context,
+	params *network.SetRequestInterceptionParams,
 ) <-chan *network.SetRequestInterceptionResult {
 	resultChan := make(chan *network.SetRequestInterceptionResult)
 	result := &network.SetRequestInterceptionResult{}
 	command := NewCommand(protocol.Socket, "Network.setRequestInterception", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -658,14 +966,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setUserAg
 */
 func (protocol *NetworkProtocol) SetUserAgentOverride(
 	params *network.SetUserAgentOverrideParams,
+) <-chan *network.SetUserAgentOverrideResult {
+	return protocol.SetUserAgentOverrideContext(context.Background(), params)
+}
+
+/*
+SetUserAgentOverrideContext is the context.Context-aware version of
+SetUserAgentOverride.
+*/
+func (protocol *NetworkProtocol) SetUserAgentOverrideContext(
+	ctx context.Context,
+	params *network.SetUserAgentOverrideParams,
 ) <-chan *network.SetUserAgentOverrideResult {
 	resultChan := make(chan *network.SetUserAgentOverrideResult)
 	result := &network.SetUserAgentOverrideResult{}
 	command := NewCommand(protocol.Socket, "Network.setUserAgentOverride", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -683,7 +1004,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-dataReceiv
 */
 func (protocol *NetworkProtocol) OnDataReceived(
 	callback func(event *network.DataReceivedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.dataReceived",
 		func(response *Response) {
@@ -695,69 +1016,90 @@ func (protocol *NetworkProtocol) OnDataReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnEventSourceMessageReceived adds a handler to the Network.eventSourceMessageReceived
-event. Network.eventSourceMessageReceived fires when EventSource message is
-received.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-eventSourceMessageReceived
+DataReceivedEvents returns a channel that receives each Network.dataReceived
+event. The channel is closed when the context is done or the socket stops
+listening. See OnDataReceived.
 */
-func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
-	callback func(event *network.EventSourceMessageReceivedEvent),
-) {
-	handler := NewEventHandler(
-		"Network.eventSourceMessageReceived",
-		func(response *Response) {
-			event := &network.EventSourceMessageReceivedEvent{}
+func (protocol *NetworkProtocol) DataReceivedEvents(
+	ctx context.Context,
+) <-chan *network.DataReceivedEvent {
+	eventChan := make(chan *network.DataReceivedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.dataReceived")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.DataReceivedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
-		},
-	)
-	protocol.Socket.AddEventHandler(handler)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnLoadingFailed adds a handler to the Network.loadingFailed event. Network.loadingFailed
-fires when HTTP request has failed to load.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFailed
+WaitForDataReceived subscribes to the Network.dataReceived event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
 */
-func (protocol *NetworkProtocol) OnLoadingFailed(
-	callback func(event *network.LoadingFailedEvent),
-) {
-	handler := NewEventHandler(
-		"Network.loadingFailed",
-		func(response *Response) {
-			event := &network.LoadingFailedEvent{}
-			json.Unmarshal([]byte(response.Params), event)
-			if nil != response.Error && 0 != response.Error.Code {
-				event.Err = response.Error
+func (protocol *NetworkProtocol) WaitForDataReceived(
+	ctx context.Context,
+	predicate func(event *network.DataReceivedEvent) bool,
+) <-chan *network.DataReceivedEvent {
+	eventChan := make(chan *network.DataReceivedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.dataReceived",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
 			}
-			callback(event)
+			event := &network.DataReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &network.DataReceivedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnLoadingFinished adds a handler to the Network.loadingFinished event.
-Network.loadingFinished fires when HTTP request has finished loading.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFinished
+OnDataReceivedOnce adds a handler to the Network.dataReceived event that is
+removed after the first event is received. See OnDataReceived.
 */
-func (protocol *NetworkProtocol) OnLoadingFinished(
-	callback func(event *network.LoadingFinishedEvent),
-) {
+func (protocol *NetworkProtocol) OnDataReceivedOnce(
+	callback func(event *network.DataReceivedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Network.loadingFinished",
+		"Network.dataReceived",
 		func(response *Response) {
-			event := &network.LoadingFinishedEvent{}
+			event := &network.DataReceivedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -765,24 +1107,23 @@ func (protocol *NetworkProtocol) OnLoadingFinished(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnRequestIntercepted adds a handler to the Network.requestIntercepted event.
-Network.requestIntercepted fires when a HTTP request is intercepted and returns
-details, which must be either allowed, blocked, modified or mocked.
+OnEventSourceMessageReceived adds a handler to the Network.eventSourceMessageReceived
+event. Network.eventSourceMessageReceived fires when EventSource message is
+received.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestIntercepted
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-eventSourceMessageReceived
 */
-func (protocol *NetworkProtocol) OnRequestIntercepted(
-	callback func(event *network.RequestInterceptedEvent),
-) {
+func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
+	callback func(event *network.EventSourceMessageReceivedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Network.requestIntercepted",
+		"Network.eventSourceMessageReceived",
 		func(response *Response) {
-			event := &network.RequestInterceptedEvent{}
+			event := &network.EventSourceMessageReceivedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -790,46 +1131,91 @@ func (protocol *NetworkProtocol) OnRequestIntercepted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnRequestServedFromCache adds a handler to the Network.requestServedFromCache
-event. Network.requestServedFromCache fires when request ended up loading from
-cache.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestServedFromCache
+EventSourceMessageReceivedEvents returns a channel that receives each
+Network.eventSourceMessageReceived event. The channel is closed when the context
+is done or the socket stops listening. See OnEventSourceMessageReceived.
 */
-func (protocol *NetworkProtocol) OnRequestServedFromCache(
-	callback func(event *network.RequestServedFromCacheEvent),
-) {
-	handler := NewEventHandler(
-		"Network.requestServedFromCache",
-		func(response *Response) {
-			event := &network.RequestServedFromCacheEvent{}
+func (protocol *NetworkProtocol) EventSourceMessageReceivedEvents(
+	ctx context.Context,
+) <-chan *network.EventSourceMessageReceivedEvent {
+	eventChan := make(chan *network.EventSourceMessageReceivedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.eventSourceMessageReceived")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.EventSourceMessageReceivedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForEventSourceMessageReceived subscribes to the
+Network.eventSourceMessageReceived event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForEventSourceMessageReceived(
+	ctx context.Context,
+	predicate func(event *network.EventSourceMessageReceivedEvent) bool,
+) <-chan *network.EventSourceMessageReceivedEvent {
+	eventChan := make(chan *network.EventSourceMessageReceivedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.eventSourceMessageReceived",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.EventSourceMessageReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &network.EventSourceMessageReceivedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnRequestWillBeSent adds a handler to the Network.requestWillBeSent event.
-Network.requestWillBeSent fires when the page is about to send HTTP request.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestWillBeSent
+OnEventSourceMessageReceivedOnce adds a handler to the
+Network.eventSourceMessageReceived event that is removed after the first event
+is received. See OnEventSourceMessageReceived.
 */
-func (protocol *NetworkProtocol) OnRequestWillBeSent(
-	callback func(event *network.RequestWillBeSentEvent),
-) {
+func (protocol *NetworkProtocol) OnEventSourceMessageReceivedOnce(
+	callback func(event *network.EventSourceMessageReceivedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Network.requestWillBeSent",
+		"Network.eventSourceMessageReceived",
 		func(response *Response) {
-			event := &network.RequestWillBeSentEvent{}
+			event := &network.EventSourceMessageReceivedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -837,7 +1223,581 @@ func (protocol *NetworkProtocol) OnRequestWillBeSent(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFailed adds a handler to the Network.loadingFailed event. Network.loadingFailed
+fires when HTTP request has failed to load.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFailed
+*/
+func (protocol *NetworkProtocol) OnLoadingFailed(
+	callback func(event *network.LoadingFailedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.loadingFailed",
+		func(response *Response) {
+			event := &network.LoadingFailedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+LoadingFailedEvents returns a channel that receives each Network.loadingFailed
+event. The channel is closed when the context is done or the socket stops
+listening. See OnLoadingFailed.
+*/
+func (protocol *NetworkProtocol) LoadingFailedEvents(
+	ctx context.Context,
+) <-chan *network.LoadingFailedEvent {
+	eventChan := make(chan *network.LoadingFailedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.loadingFailed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.LoadingFailedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForLoadingFailed subscribes to the Network.loadingFailed event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForLoadingFailed(
+	ctx context.Context,
+	predicate func(event *network.LoadingFailedEvent) bool,
+) <-chan *network.LoadingFailedEvent {
+	eventChan := make(chan *network.LoadingFailedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.loadingFailed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.LoadingFailedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.LoadingFailedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnLoadingFailedOnce adds a handler to the Network.loadingFailed event that is
+removed after the first event is received. See OnLoadingFailed.
+*/
+func (protocol *NetworkProtocol) OnLoadingFailedOnce(
+	callback func(event *network.LoadingFailedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.loadingFailed",
+		func(response *Response) {
+			event := &network.LoadingFailedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFinished adds a handler to the Network.loadingFinished event.
+Network.loadingFinished fires when HTTP request has finished loading.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFinished
+*/
+func (protocol *NetworkProtocol) OnLoadingFinished(
+	callback func(event *network.LoadingFinishedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.loadingFinished",
+		func(response *Response) {
+			event := &network.LoadingFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+LoadingFinishedEvents returns a channel that receives each
+Network.loadingFinished event. The channel is closed when the context is done or
+the socket stops listening. See OnLoadingFinished.
+*/
+func (protocol *NetworkProtocol) LoadingFinishedEvents(
+	ctx context.Context,
+) <-chan *network.LoadingFinishedEvent {
+	eventChan := make(chan *network.LoadingFinishedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.loadingFinished")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.LoadingFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForLoadingFinished subscribes to the Network.loadingFinished event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForLoadingFinished(
+	ctx context.Context,
+	predicate func(event *network.LoadingFinishedEvent) bool,
+) <-chan *network.LoadingFinishedEvent {
+	eventChan := make(chan *network.LoadingFinishedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.loadingFinished",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.LoadingFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.LoadingFinishedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnLoadingFinishedOnce adds a handler to the Network.loadingFinished event that
+is removed after the first event is received. See OnLoadingFinished.
+*/
+func (protocol *NetworkProtocol) OnLoadingFinishedOnce(
+	callback func(event *network.LoadingFinishedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.loadingFinished",
+		func(response *Response) {
+			event := &network.LoadingFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnRequestIntercepted adds a handler to the Network.requestIntercepted event.
+Network.requestIntercepted fires when a HTTP request is intercepted and returns
+details, which must be either allowed, blocked, modified or mocked.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestIntercepted
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) OnRequestIntercepted(
+	callback func(event *network.RequestInterceptedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestIntercepted",
+		func(response *Response) {
+			event := &network.RequestInterceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+RequestInterceptedEvents returns a channel that receives each
+Network.requestIntercepted event. The channel is closed when the context is done
+or the socket stops listening. See OnRequestIntercepted.
+*/
+func (protocol *NetworkProtocol) RequestInterceptedEvents(
+	ctx context.Context,
+) <-chan *network.RequestInterceptedEvent {
+	eventChan := make(chan *network.RequestInterceptedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.requestIntercepted")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.RequestInterceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForRequestIntercepted subscribes to the Network.requestIntercepted event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForRequestIntercepted(
+	ctx context.Context,
+	predicate func(event *network.RequestInterceptedEvent) bool,
+) <-chan *network.RequestInterceptedEvent {
+	eventChan := make(chan *network.RequestInterceptedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.requestIntercepted",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.RequestInterceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.RequestInterceptedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnRequestInterceptedOnce adds a handler to the Network.requestIntercepted event
+that is removed after the first event is received. See OnRequestIntercepted.
+*/
+func (protocol *NetworkProtocol) OnRequestInterceptedOnce(
+	callback func(event *network.RequestInterceptedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestIntercepted",
+		func(response *Response) {
+			event := &network.RequestInterceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnRequestServedFromCache adds a handler to the Network.requestServedFromCache
+event. Network.requestServedFromCache fires when request ended up loading from
+cache.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestServedFromCache
+*/
+func (protocol *NetworkProtocol) OnRequestServedFromCache(
+	callback func(event *network.RequestServedFromCacheEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestServedFromCache",
+		func(response *Response) {
+			event := &network.RequestServedFromCacheEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+RequestServedFromCacheEvents returns a channel that receives each
+Network.requestServedFromCache event. The channel is closed when the context is
+done or the socket stops listening. See OnRequestServedFromCache.
+*/
+func (protocol *NetworkProtocol) RequestServedFromCacheEvents(
+	ctx context.Context,
+) <-chan *network.RequestServedFromCacheEvent {
+	eventChan := make(chan *network.RequestServedFromCacheEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.requestServedFromCache")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.RequestServedFromCacheEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForRequestServedFromCache subscribes to the Network.requestServedFromCache
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForRequestServedFromCache(
+	ctx context.Context,
+	predicate func(event *network.RequestServedFromCacheEvent) bool,
+) <-chan *network.RequestServedFromCacheEvent {
+	eventChan := make(chan *network.RequestServedFromCacheEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.requestServedFromCache",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.RequestServedFromCacheEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.RequestServedFromCacheEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnRequestServedFromCacheOnce adds a handler to the
+Network.requestServedFromCache event that is removed after the first event is
+received. See OnRequestServedFromCache.
+*/
+func (protocol *NetworkProtocol) OnRequestServedFromCacheOnce(
+	callback func(event *network.RequestServedFromCacheEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestServedFromCache",
+		func(response *Response) {
+			event := &network.RequestServedFromCacheEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnRequestWillBeSent adds a handler to the Network.requestWillBeSent event.
+Network.requestWillBeSent fires when the page is about to send HTTP request.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestWillBeSent
+*/
+func (protocol *NetworkProtocol) OnRequestWillBeSent(
+	callback func(event *network.RequestWillBeSentEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestWillBeSent",
+		func(response *Response) {
+			event := &network.RequestWillBeSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+RequestWillBeSentEvents returns a channel that receives each
+Network.requestWillBeSent event. The channel is closed when the context is done
+or the socket stops listening. See OnRequestWillBeSent.
+*/
+func (protocol *NetworkProtocol) RequestWillBeSentEvents(
+	ctx context.Context,
+) <-chan *network.RequestWillBeSentEvent {
+	eventChan := make(chan *network.RequestWillBeSentEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.requestWillBeSent")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.RequestWillBeSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForRequestWillBeSent subscribes to the Network.requestWillBeSent event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForRequestWillBeSent(
+	ctx context.Context,
+	predicate func(event *network.RequestWillBeSentEvent) bool,
+) <-chan *network.RequestWillBeSentEvent {
+	eventChan := make(chan *network.RequestWillBeSentEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.requestWillBeSent",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.RequestWillBeSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.RequestWillBeSentEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnRequestWillBeSentOnce adds a handler to the Network.requestWillBeSent event
+that is removed after the first event is received. See OnRequestWillBeSent.
+*/
+func (protocol *NetworkProtocol) OnRequestWillBeSentOnce(
+	callback func(event *network.RequestWillBeSentEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestWillBeSent",
+		func(response *Response) {
+			event := &network.RequestWillBeSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -845,16 +1805,450 @@ OnResourceChangedPriority adds a handler to the Network.resourceChangedPriority
 event. Network.resourceChangedPriority fires when resource loading priority is
 changed.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-resourceChangedPriority
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-resourceChangedPriority
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) OnResourceChangedPriority(
+	callback func(event *network.ResourceChangedPriorityEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.resourceChangedPriority",
+		func(response *Response) {
+			event := &network.ResourceChangedPriorityEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ResourceChangedPriorityEvents returns a channel that receives each
+Network.resourceChangedPriority event. The channel is closed when the context is
+done or the socket stops listening. See OnResourceChangedPriority.
+*/
+func (protocol *NetworkProtocol) ResourceChangedPriorityEvents(
+	ctx context.Context,
+) <-chan *network.ResourceChangedPriorityEvent {
+	eventChan := make(chan *network.ResourceChangedPriorityEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.resourceChangedPriority")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.ResourceChangedPriorityEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForResourceChangedPriority subscribes to the Network.resourceChangedPriority
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForResourceChangedPriority(
+	ctx context.Context,
+	predicate func(event *network.ResourceChangedPriorityEvent) bool,
+) <-chan *network.ResourceChangedPriorityEvent {
+	eventChan := make(chan *network.ResourceChangedPriorityEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.resourceChangedPriority",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.ResourceChangedPriorityEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.ResourceChangedPriorityEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnResourceChangedPriorityOnce adds a handler to the
+Network.resourceChangedPriority event that is removed after the first event is
+received. See OnResourceChangedPriority.
+*/
+func (protocol *NetworkProtocol) OnResourceChangedPriorityOnce(
+	callback func(event *network.ResourceChangedPriorityEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.resourceChangedPriority",
+		func(response *Response) {
+			event := &network.ResourceChangedPriorityEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnResponseReceived adds a handler to the Network.responseReceived event.
+Network.responseReceived fires when HTTP response is available.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceived
+*/
+func (protocol *NetworkProtocol) OnResponseReceived(
+	callback func(event *network.ResponseReceivedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.responseReceived",
+		func(response *Response) {
+			event := &network.ResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ResponseReceivedEvents returns a channel that receives each
+Network.responseReceived event. The channel is closed when the context is done
+or the socket stops listening. See OnResponseReceived.
+*/
+func (protocol *NetworkProtocol) ResponseReceivedEvents(
+	ctx context.Context,
+) <-chan *network.ResponseReceivedEvent {
+	eventChan := make(chan *network.ResponseReceivedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.responseReceived")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.ResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForResponseReceived subscribes to the Network.responseReceived event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForResponseReceived(
+	ctx context.Context,
+	predicate func(event *network.ResponseReceivedEvent) bool,
+) <-chan *network.ResponseReceivedEvent {
+	eventChan := make(chan *network.ResponseReceivedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.responseReceived",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.ResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.ResponseReceivedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnResponseReceivedOnce adds a handler to the Network.responseReceived event that
+is removed after the first event is received. See OnResponseReceived.
+*/
+func (protocol *NetworkProtocol) OnResponseReceivedOnce(
+	callback func(event *network.ResponseReceivedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.responseReceived",
+		func(response *Response) {
+			event := &network.ResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketClosed adds a handler to the Network.webSocketClosed event.
+Network.webSocketClosed fires when WebSocket is closed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketClosed
+*/
+func (protocol *NetworkProtocol) OnWebSocketClosed(
+	callback func(event *network.WebSocketClosedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketClosed",
+		func(response *Response) {
+			event := &network.WebSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WebSocketClosedEvents returns a channel that receives each
+Network.webSocketClosed event. The channel is closed when the context is done or
+the socket stops listening. See OnWebSocketClosed.
+*/
+func (protocol *NetworkProtocol) WebSocketClosedEvents(
+	ctx context.Context,
+) <-chan *network.WebSocketClosedEvent {
+	eventChan := make(chan *network.WebSocketClosedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.webSocketClosed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.WebSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWebSocketClosed subscribes to the Network.webSocketClosed event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForWebSocketClosed(
+	ctx context.Context,
+	predicate func(event *network.WebSocketClosedEvent) bool,
+) <-chan *network.WebSocketClosedEvent {
+	eventChan := make(chan *network.WebSocketClosedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.webSocketClosed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.WebSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.WebSocketClosedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWebSocketClosedOnce adds a handler to the Network.webSocketClosed event that
+is removed after the first event is received. See OnWebSocketClosed.
+*/
+func (protocol *NetworkProtocol) OnWebSocketClosedOnce(
+	callback func(event *network.WebSocketClosedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketClosed",
+		func(response *Response) {
+			event := &network.WebSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketCreated adds a handler to the Network.webSocketCreated event.
+Network.webSocketCreated fires upon WebSocket creation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketCreated
+*/
+func (protocol *NetworkProtocol) OnWebSocketCreated(
+	callback func(event *network.WebSocketCreatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketCreated",
+		func(response *Response) {
+			event := &network.WebSocketCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WebSocketCreatedEvents returns a channel that receives each
+Network.webSocketCreated event. The channel is closed when the context is done
+or the socket stops listening. See OnWebSocketCreated.
+*/
+func (protocol *NetworkProtocol) WebSocketCreatedEvents(
+	ctx context.Context,
+) <-chan *network.WebSocketCreatedEvent {
+	eventChan := make(chan *network.WebSocketCreatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.webSocketCreated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.WebSocketCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWebSocketCreated subscribes to the Network.webSocketCreated event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForWebSocketCreated(
+	ctx context.Context,
+	predicate func(event *network.WebSocketCreatedEvent) bool,
+) <-chan *network.WebSocketCreatedEvent {
+	eventChan := make(chan *network.WebSocketCreatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.webSocketCreated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.WebSocketCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.WebSocketCreatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWebSocketCreatedOnce adds a handler to the Network.webSocketCreated event that
+is removed after the first event is received. See OnWebSocketCreated.
 */
-func (protocol *NetworkProtocol) OnResourceChangedPriority(
-	callback func(event *network.ResourceChangedPriorityEvent),
-) {
+func (protocol *NetworkProtocol) OnWebSocketCreatedOnce(
+	callback func(event *network.WebSocketCreatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Network.resourceChangedPriority",
+		"Network.webSocketCreated",
 		func(response *Response) {
-			event := &network.ResourceChangedPriorityEvent{}
+			event := &network.WebSocketCreatedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -862,22 +2256,22 @@ func (protocol *NetworkProtocol) OnResourceChangedPriority(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnResponseReceived adds a handler to the Network.responseReceived event.
-Network.responseReceived fires when HTTP response is available.
+OnWebSocketFrameError adds a handler to the Network.webSocketFrameError event.
+Network.webSocketFrameError fires when a WebSocket frame error occurs.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceived
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameError
 */
-func (protocol *NetworkProtocol) OnResponseReceived(
-	callback func(event *network.ResponseReceivedEvent),
-) {
+func (protocol *NetworkProtocol) OnWebSocketFrameError(
+	callback func(event *network.WebSocketFrameErrorEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Network.responseReceived",
+		"Network.webSocketFrameError",
 		func(response *Response) {
-			event := &network.ResponseReceivedEvent{}
+			event := &network.WebSocketFrameErrorEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -885,45 +2279,91 @@ func (protocol *NetworkProtocol) OnResponseReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnWebSocketClosed adds a handler to the Network.webSocketClosed event.
-Network.webSocketClosed fires when WebSocket is closed.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketClosed
+WebSocketFrameErrorEvents returns a channel that receives each
+Network.webSocketFrameError event. The channel is closed when the context is
+done or the socket stops listening. See OnWebSocketFrameError.
 */
-func (protocol *NetworkProtocol) OnWebSocketClosed(
-	callback func(event *network.WebSocketClosedEvent),
-) {
-	handler := NewEventHandler(
-		"Network.webSocketClosed",
-		func(response *Response) {
-			event := &network.WebSocketClosedEvent{}
+func (protocol *NetworkProtocol) WebSocketFrameErrorEvents(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameErrorEvent {
+	eventChan := make(chan *network.WebSocketFrameErrorEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.webSocketFrameError")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.WebSocketFrameErrorEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWebSocketFrameError subscribes to the Network.webSocketFrameError event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForWebSocketFrameError(
+	ctx context.Context,
+	predicate func(event *network.WebSocketFrameErrorEvent) bool,
+) <-chan *network.WebSocketFrameErrorEvent {
+	eventChan := make(chan *network.WebSocketFrameErrorEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.webSocketFrameError",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.WebSocketFrameErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &network.WebSocketFrameErrorEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnWebSocketCreated adds a handler to the Network.webSocketCreated event.
-Network.webSocketCreated fires upon WebSocket creation.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketCreated
+OnWebSocketFrameErrorOnce adds a handler to the Network.webSocketFrameError
+event that is removed after the first event is received. See
+OnWebSocketFrameError.
 */
-func (protocol *NetworkProtocol) OnWebSocketCreated(
-	callback func(event *network.WebSocketCreatedEvent),
-) {
+func (protocol *NetworkProtocol) OnWebSocketFrameErrorOnce(
+	callback func(event *network.WebSocketFrameErrorEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Network.webSocketCreated",
+		"Network.webSocketFrameError",
 		func(response *Response) {
-			event := &network.WebSocketCreatedEvent{}
+			event := &network.WebSocketFrameErrorEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -931,22 +2371,22 @@ func (protocol *NetworkProtocol) OnWebSocketCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnWebSocketFrameError adds a handler to the Network.webSocketFrameError event.
-Network.webSocketFrameError fires when a WebSocket frame error occurs.
+OnWebSocketFrameReceived adds a handler to the Network.webSocketFrameReceived
+event. Network.webSocketFrameReceived fires when WebSocket frame is received.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameError
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameReceived
 */
-func (protocol *NetworkProtocol) OnWebSocketFrameError(
-	callback func(event *network.WebSocketFrameErrorEvent),
-) {
+func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
+	callback func(event *network.WebSocketFrameReceivedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Network.webSocketFrameError",
+		"Network.webSocketFrameReceived",
 		func(response *Response) {
-			event := &network.WebSocketFrameErrorEvent{}
+			event := &network.WebSocketFrameReceivedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -954,18 +2394,87 @@ func (protocol *NetworkProtocol) OnWebSocketFrameError(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnWebSocketFrameReceived adds a handler to the Network.webSocketFrameReceived
-event. Network.webSocketFrameReceived fires when WebSocket frame is received.
+WebSocketFrameReceivedEvents returns a channel that receives each
+Network.webSocketFrameReceived event. The channel is closed when the context is
+done or the socket stops listening. See OnWebSocketFrameReceived.
+*/
+func (protocol *NetworkProtocol) WebSocketFrameReceivedEvents(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameReceivedEvent {
+	eventChan := make(chan *network.WebSocketFrameReceivedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.webSocketFrameReceived")
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameReceived
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.WebSocketFrameReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWebSocketFrameReceived subscribes to the Network.webSocketFrameReceived
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
 */
-func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
+func (protocol *NetworkProtocol) WaitForWebSocketFrameReceived(
+	ctx context.Context,
+	predicate func(event *network.WebSocketFrameReceivedEvent) bool,
+) <-chan *network.WebSocketFrameReceivedEvent {
+	eventChan := make(chan *network.WebSocketFrameReceivedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.webSocketFrameReceived",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.WebSocketFrameReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.WebSocketFrameReceivedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWebSocketFrameReceivedOnce adds a handler to the
+Network.webSocketFrameReceived event that is removed after the first event is
+received. See OnWebSocketFrameReceived.
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameReceivedOnce(
 	callback func(event *network.WebSocketFrameReceivedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketFrameReceived",
 		func(response *Response) {
@@ -977,7 +2486,7 @@ func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -988,7 +2497,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketF
 */
 func (protocol *NetworkProtocol) OnWebSocketFrameSent(
 	callback func(event *network.WebSocketFrameSentEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketFrameSent",
+		func(response *Response) {
+			event := &network.WebSocketFrameSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WebSocketFrameSentEvents returns a channel that receives each
+Network.webSocketFrameSent event. The channel is closed when the context is done
+or the socket stops listening. See OnWebSocketFrameSent.
+*/
+func (protocol *NetworkProtocol) WebSocketFrameSentEvents(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameSentEvent {
+	eventChan := make(chan *network.WebSocketFrameSentEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.webSocketFrameSent")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.WebSocketFrameSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWebSocketFrameSent subscribes to the Network.webSocketFrameSent event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForWebSocketFrameSent(
+	ctx context.Context,
+	predicate func(event *network.WebSocketFrameSentEvent) bool,
+) <-chan *network.WebSocketFrameSentEvent {
+	eventChan := make(chan *network.WebSocketFrameSentEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.webSocketFrameSent",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.WebSocketFrameSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.WebSocketFrameSentEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWebSocketFrameSentOnce adds a handler to the Network.webSocketFrameSent event
+that is removed after the first event is received. See OnWebSocketFrameSent.
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameSentOnce(
+	callback func(event *network.WebSocketFrameSentEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketFrameSent",
 		func(response *Response) {
@@ -1000,7 +2600,7 @@ func (protocol *NetworkProtocol) OnWebSocketFrameSent(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -1012,7 +2612,100 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketH
 */
 func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
 	callback func(event *network.WebSocketHandshakeResponseReceivedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketHandshakeResponseReceived",
+		func(response *Response) {
+			event := &network.WebSocketHandshakeResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WebSocketHandshakeResponseReceivedEvents returns a channel that receives each
+Network.webSocketHandshakeResponseReceived event. The channel is closed when the
+context is done or the socket stops listening. See
+OnWebSocketHandshakeResponseReceived.
+*/
+func (protocol *NetworkProtocol) WebSocketHandshakeResponseReceivedEvents(
+	ctx context.Context,
+) <-chan *network.WebSocketHandshakeResponseReceivedEvent {
+	eventChan := make(chan *network.WebSocketHandshakeResponseReceivedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.webSocketHandshakeResponseReceived")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.WebSocketHandshakeResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWebSocketHandshakeResponseReceived subscribes to the
+Network.webSocketHandshakeResponseReceived event and returns a channel that
+receives the first event for which the predicate returns true. A nil predicate
+matches any event. If the context is done or the socket stops listening first,
+an event containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForWebSocketHandshakeResponseReceived(
+	ctx context.Context,
+	predicate func(event *network.WebSocketHandshakeResponseReceivedEvent) bool,
+) <-chan *network.WebSocketHandshakeResponseReceivedEvent {
+	eventChan := make(chan *network.WebSocketHandshakeResponseReceivedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.webSocketHandshakeResponseReceived",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.WebSocketHandshakeResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.WebSocketHandshakeResponseReceivedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWebSocketHandshakeResponseReceivedOnce adds a handler to the
+Network.webSocketHandshakeResponseReceived event that is removed after the first
+event is received. See OnWebSocketHandshakeResponseReceived.
+*/
+func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceivedOnce(
+	callback func(event *network.WebSocketHandshakeResponseReceivedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketHandshakeResponseReceived",
 		func(response *Response) {
@@ -1024,7 +2717,7 @@ func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -1036,7 +2729,100 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketW
 */
 func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
 	callback func(event *network.WebSocketWillSendHandshakeRequestEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketWillSendHandshakeRequest",
+		func(response *Response) {
+			event := &network.WebSocketWillSendHandshakeRequestEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WebSocketWillSendHandshakeRequestEvents returns a channel that receives each
+Network.webSocketWillSendHandshakeRequest event. The channel is closed when the
+context is done or the socket stops listening. See
+OnWebSocketWillSendHandshakeRequest.
+*/
+func (protocol *NetworkProtocol) WebSocketWillSendHandshakeRequestEvents(
+	ctx context.Context,
+) <-chan *network.WebSocketWillSendHandshakeRequestEvent {
+	eventChan := make(chan *network.WebSocketWillSendHandshakeRequestEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Network.webSocketWillSendHandshakeRequest")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &network.WebSocketWillSendHandshakeRequestEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWebSocketWillSendHandshakeRequest subscribes to the
+Network.webSocketWillSendHandshakeRequest event and returns a channel that
+receives the first event for which the predicate returns true. A nil predicate
+matches any event. If the context is done or the socket stops listening first,
+an event containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *NetworkProtocol) WaitForWebSocketWillSendHandshakeRequest(
+	ctx context.Context,
+	predicate func(event *network.WebSocketWillSendHandshakeRequestEvent) bool,
+) <-chan *network.WebSocketWillSendHandshakeRequestEvent {
+	eventChan := make(chan *network.WebSocketWillSendHandshakeRequestEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Network.webSocketWillSendHandshakeRequest",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &network.WebSocketWillSendHandshakeRequestEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &network.WebSocketWillSendHandshakeRequestEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWebSocketWillSendHandshakeRequestOnce adds a handler to the
+Network.webSocketWillSendHandshakeRequest event that is removed after the first
+event is received. See OnWebSocketWillSendHandshakeRequest.
+*/
+func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequestOnce(
+	callback func(event *network.WebSocketWillSendHandshakeRequestEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketWillSendHandshakeRequest",
 		func(response *Response) {
@@ -1048,5 +2834,5 @@ func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
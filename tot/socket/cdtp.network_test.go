@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"testing"
@@ -1980,3 +1981,42 @@ func TestNetworkOnWebSocketWillSendHandshakeRequest(t *testing.T) {
 		t.Errorf("Expected error, got success")
 	}
 }
+
+func TestNetworkWaitForLoadingFinished(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestNetworkWaitForLoadingFinished")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	eventChan := mockSocket.Network().WaitForLoadingFinished(
+		context.Background(),
+		func(event *network.LoadingFinishedEvent) bool {
+			return network.RequestID("request-2") == event.RequestID
+		},
+	)
+	for _, requestID := range []string{"request-1", "request-2"} {
+		mockResultBytes, _ := json.Marshal(&network.LoadingFinishedEvent{
+			RequestID: network.RequestID(requestID),
+		})
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: "Network.loadingFinished",
+			Params: mockResultBytes,
+		})
+	}
+	result := <-eventChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if network.RequestID("request-2") != result.RequestID {
+		t.Errorf("Expected request-2, got %s", result.RequestID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result = <-mockSocket.Network().WaitForLoadingFinished(ctx, nil)
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/overlay"
@@ -23,13 +24,24 @@ Disable disables domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-disable
 */
 func (protocol *OverlayProtocol) Disable() <-chan *overlay.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *OverlayProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *overlay.DisableResult {
 	resultChan := make(chan *overlay.DisableResult)
 	command := NewCommand(protocol.Socket, "Overlay.disable", nil)
 	result := &overlay.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +57,24 @@ Enable enables domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-enable
 */
 func (protocol *OverlayProtocol) Enable() <-chan *overlay.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *OverlayProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *overlay.EnableResult {
 	resultChan := make(chan *overlay.EnableResult)
 	command := NewCommand(protocol.Socket, "Overlay.enable", nil)
 	result := &overlay.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,14 +91,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getHighli
 */
 func (protocol *OverlayProtocol) GetHighlightObjectForTest(
 	params *overlay.GetHighlightObjectForTestParams,
+) <-chan *overlay.GetHighlightObjectForTestResult {
+	return protocol.GetHighlightObjectForTestContext(context.Background(), params)
+}
+
+/*
+GetHighlightObjectForTestContext is the context.Context-aware version of
+GetHighlightObjectForTest.
+*/
+func (protocol *OverlayProtocol) GetHighlightObjectForTestContext(
+	ctx context.Context,
+	params *overlay.GetHighlightObjectForTestParams,
 ) <-chan *overlay.GetHighlightObjectForTestResult {
 	resultChan := make(chan *overlay.GetHighlightObjectForTestResult)
 	command := NewCommand(protocol.Socket, "Overlay.getHighlightObjectForTest", params)
 	result := &overlay.GetHighlightObjectForTestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -93,13 +129,24 @@ HideHighlight hides any highlight.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-hideHighlight
 */
 func (protocol *OverlayProtocol) HideHighlight() <-chan *overlay.HideHighlightResult {
+	return protocol.HideHighlightContext(context.Background())
+}
+
+/*
+HideHighlightContext is the context.Context-aware version of HideHighlight.
+*/
+func (protocol *OverlayProtocol) HideHighlightContext(
+	ctx context.Context,
+) <-chan *overlay.HideHighlightResult {
 	resultChan := make(chan *overlay.HideHighlightResult)
 	command := NewCommand(protocol.Socket, "Overlay.hideHighlight", nil)
 	result := &overlay.HideHighlightResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -116,14 +163,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 */
 func (protocol *OverlayProtocol) HighlightFrame(
 	params *overlay.HighlightFrameParams,
+) <-chan *overlay.HighlightFrameResult {
+	return protocol.HighlightFrameContext(context.Background(), params)
+}
+
+/*
+HighlightFrameContext is the context.Context-aware version of HighlightFrame.
+*/
+func (protocol *OverlayProtocol) HighlightFrameContext(
+	ctx context.Context,
+	params *overlay.HighlightFrameParams,
 ) <-chan *overlay.HighlightFrameResult {
 	resultChan := make(chan *overlay.HighlightFrameResult)
 	command := NewCommand(protocol.Socket, "Overlay.highlightFrame", params)
 	result := &overlay.HighlightFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -141,14 +200,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 */
 func (protocol *OverlayProtocol) HighlightNode(
 	params *overlay.HighlightNodeParams,
+) <-chan *overlay.HighlightNodeResult {
+	return protocol.HighlightNodeContext(context.Background(), params)
+}
+
+/*
+HighlightNodeContext is the context.Context-aware version of HighlightNode.
+*/
+func (protocol *OverlayProtocol) HighlightNodeContext(
+	ctx context.Context,
+	params *overlay.HighlightNodeParams,
 ) <-chan *overlay.HighlightNodeResult {
 	resultChan := make(chan *overlay.HighlightNodeResult)
 	command := NewCommand(protocol.Socket, "Overlay.highlightNode", params)
 	result := &overlay.HighlightNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -166,14 +237,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 */
 func (protocol *OverlayProtocol) HighlightQuad(
 	params *overlay.HighlightQuadParams,
+) <-chan *overlay.HighlightQuadResult {
+	return protocol.HighlightQuadContext(context.Background(), params)
+}
+
+/*
+HighlightQuadContext is the context.Context-aware version of HighlightQuad.
+*/
+func (protocol *OverlayProtocol) HighlightQuadContext(
+	ctx context.Context,
+	params *overlay.HighlightQuadParams,
 ) <-chan *overlay.HighlightQuadResult {
 	resultChan := make(chan *overlay.HighlightQuadResult)
 	command := NewCommand(protocol.Socket, "Overlay.highlightQuad", params)
 	result := &overlay.HighlightQuadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -191,14 +274,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 */
 func (protocol *OverlayProtocol) HighlightRect(
 	params *overlay.HighlightRectParams,
+) <-chan *overlay.HighlightRectResult {
+	return protocol.HighlightRectContext(context.Background(), params)
+}
+
+/*
+HighlightRectContext is the context.Context-aware version of HighlightRect.
+*/
+func (protocol *OverlayProtocol) HighlightRectContext(
+	ctx context.Context,
+	params *overlay.HighlightRectParams,
 ) <-chan *overlay.HighlightRectResult {
 	resultChan := make(chan *overlay.HighlightRectResult)
 	command := NewCommand(protocol.Socket, "Overlay.highlightRect", params)
 	result := &overlay.HighlightRectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -217,14 +312,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setInspec
 */
 func (protocol *OverlayProtocol) SetInspectMode(
 	params *overlay.SetInspectModeParams,
+) <-chan *overlay.SetInspectModeResult {
+	return protocol.SetInspectModeContext(context.Background(), params)
+}
+
+/*
+SetInspectModeContext is the context.Context-aware version of SetInspectMode.
+*/
+func (protocol *OverlayProtocol) SetInspectModeContext(
+	ctx context.Context,
+	params *overlay.SetInspectModeParams,
 ) <-chan *overlay.SetInspectModeResult {
 	resultChan := make(chan *overlay.SetInspectModeResult)
 	command := NewCommand(protocol.Socket, "Overlay.setInspectMode", params)
 	result := &overlay.SetInspectModeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -241,14 +348,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setPaused
 */
 func (protocol *OverlayProtocol) SetPausedInDebuggerMessage(
 	params *overlay.SetPausedInDebuggerMessageParams,
+) <-chan *overlay.SetPausedInDebuggerMessageResult {
+	return protocol.SetPausedInDebuggerMessageContext(context.Background(), params)
+}
+
+/*
+SetPausedInDebuggerMessageContext is the context.Context-aware version of
+SetPausedInDebuggerMessage.
+*/
+func (protocol *OverlayProtocol) SetPausedInDebuggerMessageContext(
+	ctx context.Context,
+	params *overlay.SetPausedInDebuggerMessageParams,
 ) <-chan *overlay.SetPausedInDebuggerMessageResult {
 	resultChan := make(chan *overlay.SetPausedInDebuggerMessageResult)
 	command := NewCommand(protocol.Socket, "Overlay.setPausedInDebuggerMessage", params)
 	result := &overlay.SetPausedInDebuggerMessageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -265,14 +385,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowDe
 */
 func (protocol *OverlayProtocol) SetShowDebugBorders(
 	params *overlay.SetShowDebugBordersParams,
+) <-chan *overlay.SetShowDebugBordersResult {
+	return protocol.SetShowDebugBordersContext(context.Background(), params)
+}
+
+/*
+SetShowDebugBordersContext is the context.Context-aware version of
+SetShowDebugBorders.
+*/
+func (protocol *OverlayProtocol) SetShowDebugBordersContext(
+	ctx context.Context,
+	params *overlay.SetShowDebugBordersParams,
 ) <-chan *overlay.SetShowDebugBordersResult {
 	resultChan := make(chan *overlay.SetShowDebugBordersResult)
 	command := NewCommand(protocol.Socket, "Overlay.setShowDebugBorders", params)
 	result := &overlay.SetShowDebugBordersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -289,14 +422,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFP
 */
 func (protocol *OverlayProtocol) SetShowFPSCounter(
 	params *overlay.SetShowFPSCounterParams,
+) <-chan *overlay.SetShowFPSCounterResult {
+	return protocol.SetShowFPSCounterContext(context.Background(), params)
+}
+
+/*
+SetShowFPSCounterContext is the context.Context-aware version of
+SetShowFPSCounter.
+*/
+func (protocol *OverlayProtocol) SetShowFPSCounterContext(
+	ctx context.Context,
+	params *overlay.SetShowFPSCounterParams,
 ) <-chan *overlay.SetShowFPSCounterResult {
 	resultChan := make(chan *overlay.SetShowFPSCounterResult)
 	command := NewCommand(protocol.Socket, "Overlay.setShowFPSCounter", params)
 	result := &overlay.SetShowFPSCounterResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -313,14 +459,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowPa
 */
 func (protocol *OverlayProtocol) SetShowPaintRects(
 	params *overlay.SetShowPaintRectsParams,
+) <-chan *overlay.SetShowPaintRectsResult {
+	return protocol.SetShowPaintRectsContext(context.Background(), params)
+}
+
+/*
+SetShowPaintRectsContext is the context.Context-aware version of
+SetShowPaintRects.
+*/
+func (protocol *OverlayProtocol) SetShowPaintRectsContext(
+	ctx context.Context,
+	params *overlay.SetShowPaintRectsParams,
 ) <-chan *overlay.SetShowPaintRectsResult {
 	resultChan := make(chan *overlay.SetShowPaintRectsResult)
 	command := NewCommand(protocol.Socket, "Overlay.setShowPaintRects", params)
 	result := &overlay.SetShowPaintRectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -337,14 +496,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowSc
 */
 func (protocol *OverlayProtocol) SetShowScrollBottleneckRects(
 	params *overlay.SetShowScrollBottleneckRectsParams,
+) <-chan *overlay.SetShowScrollBottleneckRectsResult {
+	return protocol.SetShowScrollBottleneckRectsContext(context.Background(), params)
+}
+
+/*
+SetShowScrollBottleneckRectsContext is the context.Context-aware version of
+SetShowScrollBottleneckRects.
+*/
+func (protocol *OverlayProtocol) SetShowScrollBottleneckRectsContext(
+	ctx context.Context,
+	params *overlay.SetShowScrollBottleneckRectsParams,
 ) <-chan *overlay.SetShowScrollBottleneckRectsResult {
 	resultChan := make(chan *overlay.SetShowScrollBottleneckRectsResult)
 	command := NewCommand(protocol.Socket, "Overlay.setShowScrollBottleneckRects", params)
 	result := &overlay.SetShowScrollBottleneckRectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -361,14 +533,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowVi
 */
 func (protocol *OverlayProtocol) SetShowViewportSizeOnResize(
 	params *overlay.SetShowViewportSizeOnResizeParams,
+) <-chan *overlay.SetShowViewportSizeOnResizeResult {
+	return protocol.SetShowViewportSizeOnResizeContext(context.Background(), params)
+}
+
+/*
+SetShowViewportSizeOnResizeContext is the context.Context-aware version of
+SetShowViewportSizeOnResize.
+*/
+func (protocol *OverlayProtocol) SetShowViewportSizeOnResizeContext(
+	ctx context.Context,
+	params *overlay.SetShowViewportSizeOnResizeParams,
 ) <-chan *overlay.SetShowViewportSizeOnResizeResult {
 	resultChan := make(chan *overlay.SetShowViewportSizeOnResizeResult)
 	command := NewCommand(protocol.Socket, "Overlay.setShowViewportSizeOnResize", params)
 	result := &overlay.SetShowViewportSizeOnResizeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -385,14 +570,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setSuspen
 */
 func (protocol *OverlayProtocol) SetSuspended(
 	params *overlay.SetSuspendedParams,
+) <-chan *overlay.SetSuspendedResult {
+	return protocol.SetSuspendedContext(context.Background(), params)
+}
+
+/*
+SetSuspendedContext is the context.Context-aware version of SetSuspended.
+*/
+func (protocol *OverlayProtocol) SetSuspendedContext(
+	ctx context.Context,
+	params *overlay.SetSuspendedParams,
 ) <-chan *overlay.SetSuspendedResult {
 	resultChan := make(chan *overlay.SetSuspendedResult)
 	command := NewCommand(protocol.Socket, "Overlay.setSuspended", params)
 	result := &overlay.SetSuspendedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -412,7 +609,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-inspectNod
 */
 func (protocol *OverlayProtocol) OnInspectNodeRequested(
 	callback func(event *overlay.InspectNodeRequestedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Overlay.inspectNodeRequested",
+		func(response *Response) {
+			event := &overlay.InspectNodeRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+InspectNodeRequestedEvents returns a channel that receives each
+Overlay.inspectNodeRequested event. The channel is closed when the context is
+done or the socket stops listening. See OnInspectNodeRequested.
+*/
+func (protocol *OverlayProtocol) InspectNodeRequestedEvents(
+	ctx context.Context,
+) <-chan *overlay.InspectNodeRequestedEvent {
+	eventChan := make(chan *overlay.InspectNodeRequestedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Overlay.inspectNodeRequested")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &overlay.InspectNodeRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForInspectNodeRequested subscribes to the Overlay.inspectNodeRequested event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *OverlayProtocol) WaitForInspectNodeRequested(
+	ctx context.Context,
+	predicate func(event *overlay.InspectNodeRequestedEvent) bool,
+) <-chan *overlay.InspectNodeRequestedEvent {
+	eventChan := make(chan *overlay.InspectNodeRequestedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Overlay.inspectNodeRequested",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &overlay.InspectNodeRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &overlay.InspectNodeRequestedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnInspectNodeRequestedOnce adds a handler to the Overlay.inspectNodeRequested
+event that is removed after the first event is received. See
+OnInspectNodeRequested.
+*/
+func (protocol *OverlayProtocol) OnInspectNodeRequestedOnce(
+	callback func(event *overlay.InspectNodeRequestedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Overlay.inspectNodeRequested",
 		func(response *Response) {
@@ -424,7 +713,7 @@ func (protocol *OverlayProtocol) OnInspectNodeRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -436,7 +725,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-nodeHighli
 */
 func (protocol *OverlayProtocol) OnNodeHighlightRequested(
 	callback func(event *overlay.NodeHighlightRequestedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Overlay.nodeHighlightRequested",
+		func(response *Response) {
+			event := &overlay.NodeHighlightRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+NodeHighlightRequestedEvents returns a channel that receives each
+Overlay.nodeHighlightRequested event. The channel is closed when the context is
+done or the socket stops listening. See OnNodeHighlightRequested.
+*/
+func (protocol *OverlayProtocol) NodeHighlightRequestedEvents(
+	ctx context.Context,
+) <-chan *overlay.NodeHighlightRequestedEvent {
+	eventChan := make(chan *overlay.NodeHighlightRequestedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Overlay.nodeHighlightRequested")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &overlay.NodeHighlightRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForNodeHighlightRequested subscribes to the Overlay.nodeHighlightRequested
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *OverlayProtocol) WaitForNodeHighlightRequested(
+	ctx context.Context,
+	predicate func(event *overlay.NodeHighlightRequestedEvent) bool,
+) <-chan *overlay.NodeHighlightRequestedEvent {
+	eventChan := make(chan *overlay.NodeHighlightRequestedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Overlay.nodeHighlightRequested",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &overlay.NodeHighlightRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &overlay.NodeHighlightRequestedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnNodeHighlightRequestedOnce adds a handler to the
+Overlay.nodeHighlightRequested event that is removed after the first event is
+received. See OnNodeHighlightRequested.
+*/
+func (protocol *OverlayProtocol) OnNodeHighlightRequestedOnce(
+	callback func(event *overlay.NodeHighlightRequestedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Overlay.nodeHighlightRequested",
 		func(response *Response) {
@@ -448,7 +829,7 @@ func (protocol *OverlayProtocol) OnNodeHighlightRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -460,7 +841,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-screenshot
 */
 func (protocol *OverlayProtocol) OnScreenshotRequested(
 	callback func(event *overlay.ScreenshotRequestedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Overlay.screenshotRequested",
+		func(response *Response) {
+			event := &overlay.ScreenshotRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ScreenshotRequestedEvents returns a channel that receives each
+Overlay.screenshotRequested event. The channel is closed when the context is
+done or the socket stops listening. See OnScreenshotRequested.
+*/
+func (protocol *OverlayProtocol) ScreenshotRequestedEvents(
+	ctx context.Context,
+) <-chan *overlay.ScreenshotRequestedEvent {
+	eventChan := make(chan *overlay.ScreenshotRequestedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Overlay.screenshotRequested")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &overlay.ScreenshotRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForScreenshotRequested subscribes to the Overlay.screenshotRequested event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *OverlayProtocol) WaitForScreenshotRequested(
+	ctx context.Context,
+	predicate func(event *overlay.ScreenshotRequestedEvent) bool,
+) <-chan *overlay.ScreenshotRequestedEvent {
+	eventChan := make(chan *overlay.ScreenshotRequestedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Overlay.screenshotRequested",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &overlay.ScreenshotRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &overlay.ScreenshotRequestedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnScreenshotRequestedOnce adds a handler to the Overlay.screenshotRequested
+event that is removed after the first event is received. See
+OnScreenshotRequested.
+*/
+func (protocol *OverlayProtocol) OnScreenshotRequestedOnce(
+	callback func(event *overlay.ScreenshotRequestedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Overlay.screenshotRequested",
 		func(response *Response) {
@@ -472,5 +945,5 @@ func (protocol *OverlayProtocol) OnScreenshotRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
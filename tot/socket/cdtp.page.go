@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/page"
@@ -26,14 +27,27 @@ EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *PageProtocol) AddScriptToEvaluateOnLoad(
 	params *page.AddScriptToEvaluateOnLoadParams,
+) <-chan *page.AddScriptToEvaluateOnLoadResult {
+	return protocol.AddScriptToEvaluateOnLoadContext(context.Background(), params)
+}
+
+/*
+AddScriptToEvaluateOnLoadContext is the context.Context-aware version of
+AddScriptToEvaluateOnLoad.
+*/
+func (protocol *PageProtocol) AddScriptToEvaluateOnLoadContext(
+	ctx context.Context,
+	params *page.AddScriptToEvaluateOnLoadParams,
 ) <-chan *page.AddScriptToEvaluateOnLoadResult {
 	resultChan := make(chan *page.AddScriptToEvaluateOnLoadResult)
 	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnLoad", params)
 	result := &page.AddScriptToEvaluateOnLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -53,14 +67,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToE
 */
 func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocument(
 	params *page.AddScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
+	return protocol.AddScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+/*
+AddScriptToEvaluateOnNewDocumentContext is the context.Context-aware version of
+AddScriptToEvaluateOnNewDocument.
+*/
+func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocumentContext(
+	ctx context.Context,
+	params *page.AddScriptToEvaluateOnNewDocumentParams,
 ) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
 	resultChan := make(chan *page.AddScriptToEvaluateOnNewDocumentResult)
 	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnNewDocument", params)
 	result := &page.AddScriptToEvaluateOnNewDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -78,13 +105,24 @@ BringToFront brings page to front (activates tab).
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-bringToFront
 */
 func (protocol *PageProtocol) BringToFront() <-chan *page.BringToFrontResult {
+	return protocol.BringToFrontContext(context.Background())
+}
+
+/*
+BringToFrontContext is the context.Context-aware version of BringToFront.
+*/
+func (protocol *PageProtocol) BringToFrontContext(
+	ctx context.Context,
+) <-chan *page.BringToFrontResult {
 	resultChan := make(chan *page.BringToFrontResult)
 	command := NewCommand(protocol.Socket, "Page.bringToFront", nil)
 	result := &page.BringToFrontResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -101,14 +139,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScree
 */
 func (protocol *PageProtocol) CaptureScreenshot(
 	params *page.CaptureScreenshotParams,
+) <-chan *page.CaptureScreenshotResult {
+	return protocol.CaptureScreenshotContext(context.Background(), params)
+}
+
+/*
+CaptureScreenshotContext is the context.Context-aware version of
+CaptureScreenshot.
+*/
+func (protocol *PageProtocol) CaptureScreenshotContext(
+	ctx context.Context,
+	params *page.CaptureScreenshotParams,
 ) <-chan *page.CaptureScreenshotResult {
 	resultChan := make(chan *page.CaptureScreenshotResult)
 	command := NewCommand(protocol.Socket, "Page.captureScreenshot", params)
 	result := &page.CaptureScreenshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -127,14 +178,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-createIsolat
 */
 func (protocol *PageProtocol) CreateIsolatedWorld(
 	params *page.CreateIsolatedWorldParams,
+) <-chan *page.CreateIsolatedWorldResult {
+	return protocol.CreateIsolatedWorldContext(context.Background(), params)
+}
+
+/*
+CreateIsolatedWorldContext is the context.Context-aware version of
+CreateIsolatedWorld.
+*/
+func (protocol *PageProtocol) CreateIsolatedWorldContext(
+	ctx context.Context,
+	params *page.CreateIsolatedWorldParams,
 ) <-chan *page.CreateIsolatedWorldResult {
 	resultChan := make(chan *page.CreateIsolatedWorldResult)
 	command := NewCommand(protocol.Socket, "Page.createIsolatedWorld", params)
 	result := &page.CreateIsolatedWorldResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -152,13 +216,24 @@ Disable disables page domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-disable
 */
 func (protocol *PageProtocol) Disable() <-chan *page.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *PageProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *page.DisableResult {
 	resultChan := make(chan *page.DisableResult)
 	command := NewCommand(protocol.Socket, "Page.disable", nil)
 	result := &page.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -174,13 +249,24 @@ Enable Ennables page domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-enable
 */
 func (protocol *PageProtocol) Enable() <-chan *page.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *PageProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *page.EnableResult {
 	resultChan := make(chan *page.EnableResult)
 	command := NewCommand(protocol.Socket, "Page.enable", nil)
 	result := &page.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -197,14 +283,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppManife
 */
 func (protocol *PageProtocol) GetAppManifest(
 	params *page.GetAppManifestParams,
+) <-chan *page.GetAppManifestResult {
+	return protocol.GetAppManifestContext(context.Background(), params)
+}
+
+/*
+GetAppManifestContext is the context.Context-aware version of GetAppManifest.
+*/
+func (protocol *PageProtocol) GetAppManifestContext(
+	ctx context.Context,
+	params *page.GetAppManifestParams,
 ) <-chan *page.GetAppManifestResult {
 	resultChan := make(chan *page.GetAppManifestResult)
 	command := NewCommand(protocol.Socket, "Page.getAppManifest", params)
 	result := &page.GetAppManifestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -220,13 +318,24 @@ GetFrameTree returns present frame tree structure.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getFrameTree
 */
 func (protocol *PageProtocol) GetFrameTree() <-chan *page.GetFrameTreeResult {
+	return protocol.GetFrameTreeContext(context.Background())
+}
+
+/*
+GetFrameTreeContext is the context.Context-aware version of GetFrameTree.
+*/
+func (protocol *PageProtocol) GetFrameTreeContext(
+	ctx context.Context,
+) <-chan *page.GetFrameTreeResult {
 	resultChan := make(chan *page.GetFrameTreeResult)
 	command := NewCommand(protocol.Socket, "Page.getFrameTree", nil)
 	result := &page.GetFrameTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -245,13 +354,25 @@ viewport bounds/scale.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getLayoutMetrics
 */
 func (protocol *PageProtocol) GetLayoutMetrics() <-chan *page.GetLayoutMetricsResult {
+	return protocol.GetLayoutMetricsContext(context.Background())
+}
+
+/*
+GetLayoutMetricsContext is the context.Context-aware version of
+GetLayoutMetrics.
+*/
+func (protocol *PageProtocol) GetLayoutMetricsContext(
+	ctx context.Context,
+) <-chan *page.GetLayoutMetricsResult {
 	resultChan := make(chan *page.GetLayoutMetricsResult)
 	command := NewCommand(protocol.Socket, "Page.getLayoutMetrics", nil)
 	result := &page.GetLayoutMetricsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -269,13 +390,25 @@ GetNavigationHistory returns navigation history for the current page.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getNavigationHistory
 */
 func (protocol *PageProtocol) GetNavigationHistory() <-chan *page.GetNavigationHistoryResult {
+	return protocol.GetNavigationHistoryContext(context.Background())
+}
+
+/*
+GetNavigationHistoryContext is the context.Context-aware version of
+GetNavigationHistory.
+*/
+func (protocol *PageProtocol) GetNavigationHistoryContext(
+	ctx context.Context,
+) <-chan *page.GetNavigationHistoryResult {
 	resultChan := make(chan *page.GetNavigationHistoryResult)
 	command := NewCommand(protocol.Socket, "Page.getNavigationHistory", nil)
 	result := &page.GetNavigationHistoryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -295,14 +428,27 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) GetResourceContent(
 	params *page.GetResourceContentParams,
+) <-chan *page.GetResourceContentResult {
+	return protocol.GetResourceContentContext(context.Background(), params)
+}
+
+/*
+GetResourceContentContext is the context.Context-aware version of
+GetResourceContent.
+*/
+func (protocol *PageProtocol) GetResourceContentContext(
+	ctx context.Context,
+	params *page.GetResourceContentParams,
 ) <-chan *page.GetResourceContentResult {
 	resultChan := make(chan *page.GetResourceContentResult)
 	command := NewCommand(protocol.Socket, "Page.getResourceContent", params)
 	result := &page.GetResourceContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -321,13 +467,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceT
 EXPERIMENTAL.
 */
 func (protocol *PageProtocol) GetResourceTree() <-chan *page.GetResourceTreeResult {
+	return protocol.GetResourceTreeContext(context.Background())
+}
+
+/*
+GetResourceTreeContext is the context.Context-aware version of GetResourceTree.
+*/
+func (protocol *PageProtocol) GetResourceTreeContext(
+	ctx context.Context,
+) <-chan *page.GetResourceTreeResult {
 	resultChan := make(chan *page.GetResourceTreeResult)
 	command := NewCommand(protocol.Socket, "Page.getResourceTree", nil)
 	result := &page.GetResourceTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -347,14 +504,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-handleJavaSc
 */
 func (protocol *PageProtocol) HandleJavaScriptDialog(
 	params *page.HandleJavaScriptDialogParams,
+) <-chan *page.HandleJavaScriptDialogResult {
+	return protocol.HandleJavaScriptDialogContext(context.Background(), params)
+}
+
+/*
+HandleJavaScriptDialogContext is the context.Context-aware version of
+HandleJavaScriptDialog.
+*/
+func (protocol *PageProtocol) HandleJavaScriptDialogContext(
+	ctx context.Context,
+	params *page.HandleJavaScriptDialogParams,
 ) <-chan *page.HandleJavaScriptDialogResult {
 	resultChan := make(chan *page.HandleJavaScriptDialogResult)
 	command := NewCommand(protocol.Socket, "Page.handleJavaScriptDialog", params)
 	result := &page.HandleJavaScriptDialogResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -371,14 +541,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigate
 */
 func (protocol *PageProtocol) Navigate(
 	params *page.NavigateParams,
+) <-chan *page.NavigateResult {
+	return protocol.NavigateContext(context.Background(), params)
+}
+
+/*
+NavigateContext is the context.Context-aware version of Navigate.
+*/
+func (protocol *PageProtocol) NavigateContext(
+	ctx context.Context,
+	params *page.NavigateParams,
 ) <-chan *page.NavigateResult {
 	resultChan := make(chan *page.NavigateResult)
 	command := NewCommand(protocol.Socket, "Page.navigate", params)
 	result := &page.NavigateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -397,14 +579,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigateToHi
 */
 func (protocol *PageProtocol) NavigateToHistoryEntry(
 	params *page.NavigateToHistoryEntryParams,
+) <-chan *page.NavigateToHistoryEntryResult {
+	return protocol.NavigateToHistoryEntryContext(context.Background(), params)
+}
+
+/*
+NavigateToHistoryEntryContext is the context.Context-aware version of
+NavigateToHistoryEntry.
+*/
+func (protocol *PageProtocol) NavigateToHistoryEntryContext(
+	ctx context.Context,
+	params *page.NavigateToHistoryEntryParams,
 ) <-chan *page.NavigateToHistoryEntryResult {
 	resultChan := make(chan *page.NavigateToHistoryEntryResult)
 	command := NewCommand(protocol.Socket, "Page.navigateToHistoryEntry", params)
 	result := &page.NavigateToHistoryEntryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -421,14 +616,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-printToPDF
 */
 func (protocol *PageProtocol) PrintToPDF(
 	params *page.PrintToPDFParams,
+) <-chan *page.PrintToPDFResult {
+	return protocol.PrintToPDFContext(context.Background(), params)
+}
+
+/*
+PrintToPDFContext is the context.Context-aware version of PrintToPDF.
+*/
+func (protocol *PageProtocol) PrintToPDFContext(
+	ctx context.Context,
+	params *page.PrintToPDFParams,
 ) <-chan *page.PrintToPDFResult {
 	resultChan := make(chan *page.PrintToPDFResult)
 	command := NewCommand(protocol.Socket, "Page.printToPDF", params)
 	result := &page.PrintToPDFResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -447,14 +654,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-reload
 */
 func (protocol *PageProtocol) Reload(
 	params *page.ReloadParams,
+) <-chan *page.ReloadResult {
+	return protocol.ReloadContext(context.Background(), params)
+}
+
+/*
+ReloadContext is the context.Context-aware version of Reload.
+*/
+func (protocol *PageProtocol) ReloadContext(
+	ctx context.Context,
+	params *page.ReloadParams,
 ) <-chan *page.ReloadResult {
 	resultChan := make(chan *page.ReloadResult)
 	command := NewCommand(protocol.Socket, "Page.reload", params)
 	result := &page.ReloadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -473,14 +692,27 @@ EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *PageProtocol) RemoveScriptToEvaluateOnLoad(
 	params *page.RemoveScriptToEvaluateOnLoadParams,
+) <-chan *page.RemoveScriptToEvaluateOnLoadResult {
+	return protocol.RemoveScriptToEvaluateOnLoadContext(context.Background(), params)
+}
+
+/*
+RemoveScriptToEvaluateOnLoadContext is the context.Context-aware version of
+RemoveScriptToEvaluateOnLoad.
+*/
+func (protocol *PageProtocol) RemoveScriptToEvaluateOnLoadContext(
+	ctx context.Context,
+	params *page.RemoveScriptToEvaluateOnLoadParams,
 ) <-chan *page.RemoveScriptToEvaluateOnLoadResult {
 	resultChan := make(chan *page.RemoveScriptToEvaluateOnLoadResult)
 	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnLoad", params)
 	result := &page.RemoveScriptToEvaluateOnLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -497,14 +729,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScript
 */
 func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocument(
 	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
+	return protocol.RemoveScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+/*
+RemoveScriptToEvaluateOnNewDocumentContext is the context.Context-aware version
+of RemoveScriptToEvaluateOnNewDocument.
+*/
+func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocumentContext(
+	ctx context.Context,
+	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
 ) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
 	resultChan := make(chan *page.RemoveScriptToEvaluateOnNewDocumentResult)
 	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnNewDocument", params)
 	result := &page.RemoveScriptToEvaluateOnNewDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -520,13 +765,25 @@ RequestAppBanner is experimental.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-requestAppBanner EXPERIMENTAL.
 */
 func (protocol *PageProtocol) RequestAppBanner() <-chan *page.RequestAppBannerResult {
+	return protocol.RequestAppBannerContext(context.Background())
+}
+
+/*
+RequestAppBannerContext is the context.Context-aware version of
+RequestAppBanner.
+*/
+func (protocol *PageProtocol) RequestAppBannerContext(
+	ctx context.Context,
+) <-chan *page.RequestAppBannerResult {
 	resultChan := make(chan *page.RequestAppBannerResult)
 	command := NewCommand(protocol.Socket, "Page.requestAppBanner", nil)
 	result := &page.RequestAppBannerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -544,14 +801,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-screencastFr
 */
 func (protocol *PageProtocol) ScreencastFrameAck(
 	params *page.ScreencastFrameAckParams,
+) <-chan *page.ScreencastFrameAckResult {
+	return protocol.ScreencastFrameAckContext(context.Background(), params)
+}
+
+/*
+ScreencastFrameAckContext is the context.Context-aware version of
+ScreencastFrameAck.
+*/
+func (protocol *PageProtocol) ScreencastFrameAckContext(
+	ctx context.Context,
+	params *page.ScreencastFrameAckParams,
 ) <-chan *page.ScreencastFrameAckResult {
 	resultChan := make(chan *page.ScreencastFrameAckResult)
 	command := NewCommand(protocol.Socket, "Page.screencastFrameAck", params)
 	result := &page.ScreencastFrameAckResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -569,14 +839,27 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) SearchInResource(
 	params *page.SearchInResourceParams,
+) <-chan *page.SearchInResourceResult {
+	return protocol.SearchInResourceContext(context.Background(), params)
+}
+
+/*
+SearchInResourceContext is the context.Context-aware version of
+SearchInResource.
+*/
+func (protocol *PageProtocol) SearchInResourceContext(
+	ctx context.Context,
+	params *page.SearchInResourceParams,
 ) <-chan *page.SearchInResourceResult {
 	resultChan := make(chan *page.SearchInResourceResult)
 	command := NewCommand(protocol.Socket, "Page.searchInResource", params)
 	result := &page.SearchInResourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -596,14 +879,27 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) SetAdBlockingEnabled(
 	params *page.SetAdBlockingEnabledParams,
+) <-chan *page.SetAdBlockingEnabledResult {
+	return protocol.SetAdBlockingEnabledContext(context.Background(), params)
+}
+
+/*
+SetAdBlockingEnabledContext is the context.Context-aware version of
+SetAdBlockingEnabled.
+*/
+func (protocol *PageProtocol) SetAdBlockingEnabledContext(
+	ctx context.Context,
+	params *page.SetAdBlockingEnabledParams,
 ) <-chan *page.SetAdBlockingEnabledResult {
 	resultChan := make(chan *page.SetAdBlockingEnabledResult)
 	command := NewCommand(protocol.Socket, "Page.setAdBlockingEnabled", params)
 	result := &page.SetAdBlockingEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -622,14 +918,27 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) SetAutoAttachToCreatedPages(
 	params *page.SetAutoAttachToCreatedPagesParams,
+) <-chan *page.SetAutoAttachToCreatedPagesResult {
+	return protocol.SetAutoAttachToCreatedPagesContext(context.Background(), params)
+}
+
+/*
+SetAutoAttachToCreatedPagesContext is the context.Context-aware version of
+SetAutoAttachToCreatedPages.
+*/
+func (protocol *PageProtocol) SetAutoAttachToCreatedPagesContext(
+	ctx context.Context,
+	params *page.SetAutoAttachToCreatedPagesParams,
 ) <-chan *page.SetAutoAttachToCreatedPagesResult {
 	resultChan := make(chan *page.SetAutoAttachToCreatedPagesResult)
 	command := NewCommand(protocol.Socket, "Page.setAutoAttachToCreatedPages", params)
 	result := &page.SetAutoAttachToCreatedPagesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -646,14 +955,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDocumentC
 */
 func (protocol *PageProtocol) SetDocumentContent(
 	params *page.SetDocumentContentParams,
+) <-chan *page.SetDocumentContentResult {
+	return protocol.SetDocumentContentContext(context.Background(), params)
+}
+
+/*
+SetDocumentContentContext is the context.Context-aware version of
+SetDocumentContent.
+*/
+func (protocol *PageProtocol) SetDocumentContentContext(
+	ctx context.Context,
+	params *page.SetDocumentContentParams,
 ) <-chan *page.SetDocumentContentResult {
 	resultChan := make(chan *page.SetDocumentContentResult)
 	command := NewCommand(protocol.Socket, "Page.setDocumentContent", params)
 	result := &page.SetDocumentContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -671,14 +993,27 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) SetDownloadBehavior(
 	params *page.SetDownloadBehaviorParams,
+) <-chan *page.SetDownloadBehaviorResult {
+	return protocol.SetDownloadBehaviorContext(context.Background(), params)
+}
+
+/*
+SetDownloadBehaviorContext is the context.Context-aware version of
+SetDownloadBehavior.
+*/
+func (protocol *PageProtocol) SetDownloadBehaviorContext(
+	ctx context.Context,
+	params *page.SetDownloadBehaviorParams,
 ) <-chan *page.SetDownloadBehaviorResult {
 	resultChan := make(chan *page.SetDownloadBehaviorResult)
 	command := NewCommand(protocol.Socket, "Page.setDownloadBehavior", params)
 	result := &page.SetDownloadBehaviorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -696,14 +1031,27 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) SetLifecycleEventsEnabled(
 	params *page.SetLifecycleEventsEnabledParams,
+) <-chan *page.SetLifecycleEventsEnabledResult {
+	return protocol.SetLifecycleEventsEnabledContext(context.Background(), params)
+}
+
+/*
+SetLifecycleEventsEnabledContext is the context.Context-aware version of
+SetLifecycleEventsEnabled.
+*/
+func (protocol *PageProtocol) SetLifecycleEventsEnabledContext(
+	ctx context.Context,
+	params *page.SetLifecycleEventsEnabledParams,
 ) <-chan *page.SetLifecycleEventsEnabledResult {
 	resultChan := make(chan *page.SetLifecycleEventsEnabledResult)
 	command := NewCommand(protocol.Socket, "Page.setLifecycleEventsEnabled", params)
 	result := &page.SetLifecycleEventsEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -721,14 +1069,26 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) StartScreencast(
 	params *page.StartScreencastParams,
+) <-chan *page.StartScreencastResult {
+	return protocol.StartScreencastContext(context.Background(), params)
+}
+
+/*
+StartScreencastContext is the context.Context-aware version of StartScreencast.
+*/
+func (protocol *PageProtocol) StartScreencastContext(
+	ctx context.Context,
+	params *page.StartScreencastParams,
 ) <-chan *page.StartScreencastResult {
 	resultChan := make(chan *page.StartScreencastResult)
 	command := NewCommand(protocol.Socket, "Page.startScreencast", params)
 	result := &page.StartScreencastResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -744,13 +1104,24 @@ StopLoading force the page stop all navigations and pending resource fetches.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopLoading
 */
 func (protocol *PageProtocol) StopLoading() <-chan *page.StopLoadingResult {
+	return protocol.StopLoadingContext(context.Background())
+}
+
+/*
+StopLoadingContext is the context.Context-aware version of StopLoading.
+*/
+func (protocol *PageProtocol) StopLoadingContext(
+	ctx context.Context,
+) <-chan *page.StopLoadingResult {
 	resultChan := make(chan *page.StopLoadingResult)
 	command := NewCommand(protocol.Socket, "Page.stopLoading", nil)
 	result := &page.StopLoadingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -767,13 +1138,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopScreenca
 EXPERIMENTAL.
 */
 func (protocol *PageProtocol) StopScreencast() <-chan *page.StopScreencastResult {
+	return protocol.StopScreencastContext(context.Background())
+}
+
+/*
+StopScreencastContext is the context.Context-aware version of StopScreencast.
+*/
+func (protocol *PageProtocol) StopScreencastContext(
+	ctx context.Context,
+) <-chan *page.StopScreencastResult {
 	resultChan := make(chan *page.StopScreencastResult)
 	command := NewCommand(protocol.Socket, "Page.stopScreencast", nil)
 	result := &page.StopScreencastResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -791,7 +1173,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-domContentEve
 */
 func (protocol *PageProtocol) OnDOMContentEventFired(
 	callback func(event *page.DOMContentEventFiredEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.domContentEventFired",
 		func(response *Response) {
@@ -803,70 +1185,90 @@ func (protocol *PageProtocol) OnDOMContentEventFired(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnFrameAttached adds a handler to the Page.frameAttached event. Page.frameAttached
-fires when a frame has been attached to its parent.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameAttached
+DOMContentEventFiredEvents returns a channel that receives each
+Page.domContentEventFired event. The channel is closed when the context is done
+or the socket stops listening. See OnDOMContentEventFired.
 */
-func (protocol *PageProtocol) OnFrameAttached(
-	callback func(event *page.FrameAttachedEvent),
-) {
-	handler := NewEventHandler(
-		"Page.frameAttached",
-		func(response *Response) {
-			event := &page.FrameAttachedEvent{}
+func (protocol *PageProtocol) DOMContentEventFiredEvents(
+	ctx context.Context,
+) <-chan *page.DOMContentEventFiredEvent {
+	eventChan := make(chan *page.DOMContentEventFiredEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.domContentEventFired")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.DOMContentEventFiredEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
-		},
-	)
-	protocol.Socket.AddEventHandler(handler)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnFrameClearedScheduledNavigation adds a handler to the Page.frameClearedScheduledNavigation
-event. Page.frameClearedScheduledNavigation fires when a frame no longer has a
-scheduled navigation.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameClearedScheduledNavigation
-EXPERIMENTAL.
+WaitForDOMContentEventFired subscribes to the Page.domContentEventFired event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
 */
-func (protocol *PageProtocol) OnFrameClearedScheduledNavigation(
-	callback func(event *page.FrameClearedScheduledNavigationEvent),
-) {
-	handler := NewEventHandler(
-		"Page.frameClearedScheduledNavigation",
-		func(response *Response) {
-			event := &page.FrameClearedScheduledNavigationEvent{}
-			json.Unmarshal([]byte(response.Params), event)
-			if nil != response.Error && 0 != response.Error.Code {
-				event.Err = response.Error
+func (protocol *PageProtocol) WaitForDOMContentEventFired(
+	ctx context.Context,
+	predicate func(event *page.DOMContentEventFiredEvent) bool,
+) <-chan *page.DOMContentEventFiredEvent {
+	eventChan := make(chan *page.DOMContentEventFiredEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.domContentEventFired",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
 			}
-			callback(event)
+			event := &page.DOMContentEventFiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &page.DOMContentEventFiredEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnFrameDetached adds a handler to the Page.frameDetached event. Page.frameDetached
-fires when a frame has been detached from its parent.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameDetached
+OnDOMContentEventFiredOnce adds a handler to the Page.domContentEventFired event
+that is removed after the first event is received. See OnDOMContentEventFired.
 */
-func (protocol *PageProtocol) OnFrameDetached(
-	callback func(event *page.FrameDetachedEvent),
-) {
+func (protocol *PageProtocol) OnDOMContentEventFiredOnce(
+	callback func(event *page.DOMContentEventFiredEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.frameDetached",
+		"Page.domContentEventFired",
 		func(response *Response) {
-			event := &page.FrameDetachedEvent{}
+			event := &page.DOMContentEventFiredEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -874,23 +1276,22 @@ func (protocol *PageProtocol) OnFrameDetached(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnFrameNavigated adds a handler to the Page.frameNavigated event. Page.frameNavigated
-fires once navigation of the frame has completed. Frame is now associated with
-the new loader.
+OnFrameAttached adds a handler to the Page.frameAttached event. Page.frameAttached
+fires when a frame has been attached to its parent.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameNavigated
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameAttached
 */
-func (protocol *PageProtocol) OnFrameNavigated(
-	callback func(event *page.FrameNavigatedEvent),
-) {
+func (protocol *PageProtocol) OnFrameAttached(
+	callback func(event *page.FrameAttachedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.frameNavigated",
+		"Page.frameAttached",
 		func(response *Response) {
-			event := &page.FrameNavigatedEvent{}
+			event := &page.FrameAttachedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -898,48 +1299,1382 @@ func (protocol *PageProtocol) OnFrameNavigated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnFrameResized adds a handler to the Page.frameResized event. Page.frameResized
-fires when frame is resized.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameResized
-EXPERIMENTAL.
+FrameAttachedEvents returns a channel that receives each Page.frameAttached
+event. The channel is closed when the context is done or the socket stops
+listening. See OnFrameAttached.
 */
-func (protocol *PageProtocol) OnFrameResized(
-	callback func(event *page.FrameResizedEvent),
-) {
-	handler := NewEventHandler(
-		"Page.frameResized",
-		func(response *Response) {
-			event := &page.FrameResizedEvent{}
+func (protocol *PageProtocol) FrameAttachedEvents(
+	ctx context.Context,
+) <-chan *page.FrameAttachedEvent {
+	eventChan := make(chan *page.FrameAttachedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameAttached")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameAttachedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
-		},
-	)
-	protocol.Socket.AddEventHandler(handler)
-}
-
-/*
-OnFrameScheduledNavigation adds a handler to the Page.frameScheduledNavigation
-event. Page.frameScheduledNavigation fires when frame schedules a potential
-navigation.
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameScheduledNavigation
+	return eventChan
+}
+
+/*
+WaitForFrameAttached subscribes to the Page.frameAttached event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameAttached(
+	ctx context.Context,
+	predicate func(event *page.FrameAttachedEvent) bool,
+) <-chan *page.FrameAttachedEvent {
+	eventChan := make(chan *page.FrameAttachedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameAttached",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameAttachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameAttachedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameAttachedOnce adds a handler to the Page.frameAttached event that is
+removed after the first event is received. See OnFrameAttached.
+*/
+func (protocol *PageProtocol) OnFrameAttachedOnce(
+	callback func(event *page.FrameAttachedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameAttached",
+		func(response *Response) {
+			event := &page.FrameAttachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnFrameClearedScheduledNavigation adds a handler to the Page.frameClearedScheduledNavigation
+event. Page.frameClearedScheduledNavigation fires when a frame no longer has a
+scheduled navigation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameClearedScheduledNavigation
 EXPERIMENTAL.
 */
-func (protocol *PageProtocol) OnFrameScheduledNavigation(
-	callback func(event *page.FrameScheduledNavigationEvent),
-) {
+func (protocol *PageProtocol) OnFrameClearedScheduledNavigation(
+	callback func(event *page.FrameClearedScheduledNavigationEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.frameScheduledNavigation",
+		"Page.frameClearedScheduledNavigation",
 		func(response *Response) {
-			event := &page.FrameScheduledNavigationEvent{}
+			event := &page.FrameClearedScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FrameClearedScheduledNavigationEvents returns a channel that receives each
+Page.frameClearedScheduledNavigation event. The channel is closed when the
+context is done or the socket stops listening. See
+OnFrameClearedScheduledNavigation.
+*/
+func (protocol *PageProtocol) FrameClearedScheduledNavigationEvents(
+	ctx context.Context,
+) <-chan *page.FrameClearedScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameClearedScheduledNavigationEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameClearedScheduledNavigation")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameClearedScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFrameClearedScheduledNavigation subscribes to the
+Page.frameClearedScheduledNavigation event and returns a channel that receives
+the first event for which the predicate returns true. A nil predicate matches
+any event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameClearedScheduledNavigation(
+	ctx context.Context,
+	predicate func(event *page.FrameClearedScheduledNavigationEvent) bool,
+) <-chan *page.FrameClearedScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameClearedScheduledNavigationEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameClearedScheduledNavigation",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameClearedScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameClearedScheduledNavigationEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameClearedScheduledNavigationOnce adds a handler to the
+Page.frameClearedScheduledNavigation event that is removed after the first event
+is received. See OnFrameClearedScheduledNavigation.
+*/
+func (protocol *PageProtocol) OnFrameClearedScheduledNavigationOnce(
+	callback func(event *page.FrameClearedScheduledNavigationEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameClearedScheduledNavigation",
+		func(response *Response) {
+			event := &page.FrameClearedScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnFrameDetached adds a handler to the Page.frameDetached event. Page.frameDetached
+fires when a frame has been detached from its parent.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameDetached
+*/
+func (protocol *PageProtocol) OnFrameDetached(
+	callback func(event *page.FrameDetachedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameDetached",
+		func(response *Response) {
+			event := &page.FrameDetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FrameDetachedEvents returns a channel that receives each Page.frameDetached
+event. The channel is closed when the context is done or the socket stops
+listening. See OnFrameDetached.
+*/
+func (protocol *PageProtocol) FrameDetachedEvents(
+	ctx context.Context,
+) <-chan *page.FrameDetachedEvent {
+	eventChan := make(chan *page.FrameDetachedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameDetached")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameDetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFrameDetached subscribes to the Page.frameDetached event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameDetached(
+	ctx context.Context,
+	predicate func(event *page.FrameDetachedEvent) bool,
+) <-chan *page.FrameDetachedEvent {
+	eventChan := make(chan *page.FrameDetachedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameDetached",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameDetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameDetachedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameDetachedOnce adds a handler to the Page.frameDetached event that is
+removed after the first event is received. See OnFrameDetached.
+*/
+func (protocol *PageProtocol) OnFrameDetachedOnce(
+	callback func(event *page.FrameDetachedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameDetached",
+		func(response *Response) {
+			event := &page.FrameDetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnFrameNavigated adds a handler to the Page.frameNavigated event. Page.frameNavigated
+fires once navigation of the frame has completed. Frame is now associated with
+the new loader.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameNavigated
+*/
+func (protocol *PageProtocol) OnFrameNavigated(
+	callback func(event *page.FrameNavigatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameNavigated",
+		func(response *Response) {
+			event := &page.FrameNavigatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FrameNavigatedEvents returns a channel that receives each Page.frameNavigated
+event. The channel is closed when the context is done or the socket stops
+listening. See OnFrameNavigated.
+*/
+func (protocol *PageProtocol) FrameNavigatedEvents(
+	ctx context.Context,
+) <-chan *page.FrameNavigatedEvent {
+	eventChan := make(chan *page.FrameNavigatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameNavigated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameNavigatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFrameNavigated subscribes to the Page.frameNavigated event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameNavigated(
+	ctx context.Context,
+	predicate func(event *page.FrameNavigatedEvent) bool,
+) <-chan *page.FrameNavigatedEvent {
+	eventChan := make(chan *page.FrameNavigatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameNavigated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameNavigatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameNavigatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameNavigatedOnce adds a handler to the Page.frameNavigated event that is
+removed after the first event is received. See OnFrameNavigated.
+*/
+func (protocol *PageProtocol) OnFrameNavigatedOnce(
+	callback func(event *page.FrameNavigatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameNavigated",
+		func(response *Response) {
+			event := &page.FrameNavigatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnFrameResized adds a handler to the Page.frameResized event. Page.frameResized
+fires when frame is resized.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameResized
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameResized(
+	callback func(event *page.FrameResizedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameResized",
+		func(response *Response) {
+			event := &page.FrameResizedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FrameResizedEvents returns a channel that receives each Page.frameResized event.
+The channel is closed when the context is done or the socket stops listening.
+See OnFrameResized.
+*/
+func (protocol *PageProtocol) FrameResizedEvents(
+	ctx context.Context,
+) <-chan *page.FrameResizedEvent {
+	eventChan := make(chan *page.FrameResizedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameResized")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameResizedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFrameResized subscribes to the Page.frameResized event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameResized(
+	ctx context.Context,
+	predicate func(event *page.FrameResizedEvent) bool,
+) <-chan *page.FrameResizedEvent {
+	eventChan := make(chan *page.FrameResizedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameResized",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameResizedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameResizedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameResizedOnce adds a handler to the Page.frameResized event that is removed
+after the first event is received. See OnFrameResized.
+*/
+func (protocol *PageProtocol) OnFrameResizedOnce(
+	callback func(event *page.FrameResizedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameResized",
+		func(response *Response) {
+			event := &page.FrameResizedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnFrameScheduledNavigation adds a handler to the Page.frameScheduledNavigation
+event. Page.frameScheduledNavigation fires when frame schedules a potential
+navigation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameScheduledNavigation
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameScheduledNavigation(
+	callback func(event *page.FrameScheduledNavigationEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameScheduledNavigation",
+		func(response *Response) {
+			event := &page.FrameScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FrameScheduledNavigationEvents returns a channel that receives each
+Page.frameScheduledNavigation event. The channel is closed when the context is
+done or the socket stops listening. See OnFrameScheduledNavigation.
+*/
+func (protocol *PageProtocol) FrameScheduledNavigationEvents(
+	ctx context.Context,
+) <-chan *page.FrameScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameScheduledNavigationEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameScheduledNavigation")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFrameScheduledNavigation subscribes to the Page.frameScheduledNavigation
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameScheduledNavigation(
+	ctx context.Context,
+	predicate func(event *page.FrameScheduledNavigationEvent) bool,
+) <-chan *page.FrameScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameScheduledNavigationEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameScheduledNavigation",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameScheduledNavigationEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameScheduledNavigationOnce adds a handler to the
+Page.frameScheduledNavigation event that is removed after the first event is
+received. See OnFrameScheduledNavigation.
+*/
+func (protocol *PageProtocol) OnFrameScheduledNavigationOnce(
+	callback func(event *page.FrameScheduledNavigationEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameScheduledNavigation",
+		func(response *Response) {
+			event := &page.FrameScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnFrameStartedLoading adds a handler to the Page.frameStartedLoading event.
+Page.frameStartedLoading fires when frame has started loading.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStartedLoading
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameStartedLoading(
+	callback func(event *page.FrameStartedLoadingEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameStartedLoading",
+		func(response *Response) {
+			event := &page.FrameStartedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FrameStartedLoadingEvents returns a channel that receives each
+Page.frameStartedLoading event. The channel is closed when the context is done
+or the socket stops listening. See OnFrameStartedLoading.
+*/
+func (protocol *PageProtocol) FrameStartedLoadingEvents(
+	ctx context.Context,
+) <-chan *page.FrameStartedLoadingEvent {
+	eventChan := make(chan *page.FrameStartedLoadingEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameStartedLoading")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameStartedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFrameStartedLoading subscribes to the Page.frameStartedLoading event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameStartedLoading(
+	ctx context.Context,
+	predicate func(event *page.FrameStartedLoadingEvent) bool,
+) <-chan *page.FrameStartedLoadingEvent {
+	eventChan := make(chan *page.FrameStartedLoadingEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameStartedLoading",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameStartedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameStartedLoadingEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameStartedLoadingOnce adds a handler to the Page.frameStartedLoading event
+that is removed after the first event is received. See OnFrameStartedLoading.
+*/
+func (protocol *PageProtocol) OnFrameStartedLoadingOnce(
+	callback func(event *page.FrameStartedLoadingEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameStartedLoading",
+		func(response *Response) {
+			event := &page.FrameStartedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnFrameStoppedLoading adds a handler to the Page.frameStoppedLoading event.
+Page.frameStoppedLoading fires when frame has stopped loading.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStoppedLoading
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameStoppedLoading(
+	callback func(event *page.FrameStoppedLoadingEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameStoppedLoading",
+		func(response *Response) {
+			event := &page.FrameStoppedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+FrameStoppedLoadingEvents returns a channel that receives each
+Page.frameStoppedLoading event. The channel is closed when the context is done
+or the socket stops listening. See OnFrameStoppedLoading.
+*/
+func (protocol *PageProtocol) FrameStoppedLoadingEvents(
+	ctx context.Context,
+) <-chan *page.FrameStoppedLoadingEvent {
+	eventChan := make(chan *page.FrameStoppedLoadingEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.frameStoppedLoading")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.FrameStoppedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForFrameStoppedLoading subscribes to the Page.frameStoppedLoading event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForFrameStoppedLoading(
+	ctx context.Context,
+	predicate func(event *page.FrameStoppedLoadingEvent) bool,
+) <-chan *page.FrameStoppedLoadingEvent {
+	eventChan := make(chan *page.FrameStoppedLoadingEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.frameStoppedLoading",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.FrameStoppedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.FrameStoppedLoadingEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnFrameStoppedLoadingOnce adds a handler to the Page.frameStoppedLoading event
+that is removed after the first event is received. See OnFrameStoppedLoading.
+*/
+func (protocol *PageProtocol) OnFrameStoppedLoadingOnce(
+	callback func(event *page.FrameStoppedLoadingEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameStoppedLoading",
+		func(response *Response) {
+			event := &page.FrameStoppedLoadingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialHidden adds a handler to the Page.interstitialHidden event.
+Page.interstitialHidden fires when interstitial page was hidden.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialHidden
+*/
+func (protocol *PageProtocol) OnInterstitialHidden(
+	callback func(event *page.InterstitialHiddenEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.interstitialHidden",
+		func(response *Response) {
+			event := &page.InterstitialHiddenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+InterstitialHiddenEvents returns a channel that receives each
+Page.interstitialHidden event. The channel is closed when the context is done or
+the socket stops listening. See OnInterstitialHidden.
+*/
+func (protocol *PageProtocol) InterstitialHiddenEvents(
+	ctx context.Context,
+) <-chan *page.InterstitialHiddenEvent {
+	eventChan := make(chan *page.InterstitialHiddenEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.interstitialHidden")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.InterstitialHiddenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForInterstitialHidden subscribes to the Page.interstitialHidden event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForInterstitialHidden(
+	ctx context.Context,
+	predicate func(event *page.InterstitialHiddenEvent) bool,
+) <-chan *page.InterstitialHiddenEvent {
+	eventChan := make(chan *page.InterstitialHiddenEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.interstitialHidden",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.InterstitialHiddenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.InterstitialHiddenEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnInterstitialHiddenOnce adds a handler to the Page.interstitialHidden event
+that is removed after the first event is received. See OnInterstitialHidden.
+*/
+func (protocol *PageProtocol) OnInterstitialHiddenOnce(
+	callback func(event *page.InterstitialHiddenEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.interstitialHidden",
+		func(response *Response) {
+			event := &page.InterstitialHiddenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialShown adds a handler to the Page.interstitialShown event.
+Page.interstitialShown fires when interstitial page was shown.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialShown
+*/
+func (protocol *PageProtocol) OnInterstitialShown(
+	callback func(event *page.InterstitialShownEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.interstitialShown",
+		func(response *Response) {
+			event := &page.InterstitialShownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+InterstitialShownEvents returns a channel that receives each
+Page.interstitialShown event. The channel is closed when the context is done or
+the socket stops listening. See OnInterstitialShown.
+*/
+func (protocol *PageProtocol) InterstitialShownEvents(
+	ctx context.Context,
+) <-chan *page.InterstitialShownEvent {
+	eventChan := make(chan *page.InterstitialShownEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.interstitialShown")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.InterstitialShownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForInterstitialShown subscribes to the Page.interstitialShown event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForInterstitialShown(
+	ctx context.Context,
+	predicate func(event *page.InterstitialShownEvent) bool,
+) <-chan *page.InterstitialShownEvent {
+	eventChan := make(chan *page.InterstitialShownEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.interstitialShown",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.InterstitialShownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.InterstitialShownEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnInterstitialShownOnce adds a handler to the Page.interstitialShown event that
+is removed after the first event is received. See OnInterstitialShown.
+*/
+func (protocol *PageProtocol) OnInterstitialShownOnce(
+	callback func(event *page.InterstitialShownEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.interstitialShown",
+		func(response *Response) {
+			event := &page.InterstitialShownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogClosed adds a handler to the Page.javascriptDialogClosed
+event. Page.javascriptDialogClosed fires when a JavaScript initiated dialog
+(alert, confirm, prompt, or onbeforeunload) has been closed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogClosed
+*/
+func (protocol *PageProtocol) OnJavascriptDialogClosed(
+	callback func(event *page.JavascriptDialogClosedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.javascriptDialogClosed",
+		func(response *Response) {
+			event := &page.JavascriptDialogClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+JavascriptDialogClosedEvents returns a channel that receives each
+Page.javascriptDialogClosed event. The channel is closed when the context is
+done or the socket stops listening. See OnJavascriptDialogClosed.
+*/
+func (protocol *PageProtocol) JavascriptDialogClosedEvents(
+	ctx context.Context,
+) <-chan *page.JavascriptDialogClosedEvent {
+	eventChan := make(chan *page.JavascriptDialogClosedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.javascriptDialogClosed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.JavascriptDialogClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForJavascriptDialogClosed subscribes to the Page.javascriptDialogClosed
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForJavascriptDialogClosed(
+	ctx context.Context,
+	predicate func(event *page.JavascriptDialogClosedEvent) bool,
+) <-chan *page.JavascriptDialogClosedEvent {
+	eventChan := make(chan *page.JavascriptDialogClosedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.javascriptDialogClosed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.JavascriptDialogClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.JavascriptDialogClosedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnJavascriptDialogClosedOnce adds a handler to the Page.javascriptDialogClosed
+event that is removed after the first event is received. See
+OnJavascriptDialogClosed.
+*/
+func (protocol *PageProtocol) OnJavascriptDialogClosedOnce(
+	callback func(event *page.JavascriptDialogClosedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.javascriptDialogClosed",
+		func(response *Response) {
+			event := &page.JavascriptDialogClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogOpening adds a handler to the Page.javascriptDialogOpening
+event. Page.javascriptDialogOpening fires when a JavaScript initiated dialog
+(alert, confirm, prompt, or onbeforeunload) is about to open.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogOpening
+*/
+func (protocol *PageProtocol) OnJavascriptDialogOpening(
+	callback func(event *page.JavascriptDialogOpeningEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.javascriptDialogOpening",
+		func(response *Response) {
+			event := &page.JavascriptDialogOpeningEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+JavascriptDialogOpeningEvents returns a channel that receives each
+Page.javascriptDialogOpening event. The channel is closed when the context is
+done or the socket stops listening. See OnJavascriptDialogOpening.
+*/
+func (protocol *PageProtocol) JavascriptDialogOpeningEvents(
+	ctx context.Context,
+) <-chan *page.JavascriptDialogOpeningEvent {
+	eventChan := make(chan *page.JavascriptDialogOpeningEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.javascriptDialogOpening")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.JavascriptDialogOpeningEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForJavascriptDialogOpening subscribes to the Page.javascriptDialogOpening
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForJavascriptDialogOpening(
+	ctx context.Context,
+	predicate func(event *page.JavascriptDialogOpeningEvent) bool,
+) <-chan *page.JavascriptDialogOpeningEvent {
+	eventChan := make(chan *page.JavascriptDialogOpeningEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.javascriptDialogOpening",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.JavascriptDialogOpeningEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.JavascriptDialogOpeningEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnJavascriptDialogOpeningOnce adds a handler to the Page.javascriptDialogOpening
+event that is removed after the first event is received. See
+OnJavascriptDialogOpening.
+*/
+func (protocol *PageProtocol) OnJavascriptDialogOpeningOnce(
+	callback func(event *page.JavascriptDialogOpeningEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.javascriptDialogOpening",
+		func(response *Response) {
+			event := &page.JavascriptDialogOpeningEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
+}
+
+/*
+OnLifecycleEvent adds a handler to the Page.lifecycleEvent event. Page.lifecycleEvent
+fires for top level page lifecycle events such as navigation, load, paint, etc.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-lifecycleEvent
+*/
+func (protocol *PageProtocol) OnLifecycleEvent(
+	callback func(event *page.LifecycleEventEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.lifecycleEvent",
+		func(response *Response) {
+			event := &page.LifecycleEventEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -947,47 +2682,90 @@ func (protocol *PageProtocol) OnFrameScheduledNavigation(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnFrameStartedLoading adds a handler to the Page.frameStartedLoading event.
-Page.frameStartedLoading fires when frame has started loading.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStartedLoading
-EXPERIMENTAL.
+LifecycleEventEvents returns a channel that receives each Page.lifecycleEvent
+event. The channel is closed when the context is done or the socket stops
+listening. See OnLifecycleEvent.
 */
-func (protocol *PageProtocol) OnFrameStartedLoading(
-	callback func(event *page.FrameStartedLoadingEvent),
-) {
-	handler := NewEventHandler(
-		"Page.frameStartedLoading",
-		func(response *Response) {
-			event := &page.FrameStartedLoadingEvent{}
+func (protocol *PageProtocol) LifecycleEventEvents(
+	ctx context.Context,
+) <-chan *page.LifecycleEventEvent {
+	eventChan := make(chan *page.LifecycleEventEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.lifecycleEvent")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.LifecycleEventEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForLifecycleEvent subscribes to the Page.lifecycleEvent event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForLifecycleEvent(
+	ctx context.Context,
+	predicate func(event *page.LifecycleEventEvent) bool,
+) <-chan *page.LifecycleEventEvent {
+	eventChan := make(chan *page.LifecycleEventEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.lifecycleEvent",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.LifecycleEventEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &page.LifecycleEventEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnFrameStoppedLoading adds a handler to the Page.frameStoppedLoading event.
-Page.frameStoppedLoading fires when frame has stopped loading.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStoppedLoading
-EXPERIMENTAL.
+OnLifecycleEventOnce adds a handler to the Page.lifecycleEvent event that is
+removed after the first event is received. See OnLifecycleEvent.
 */
-func (protocol *PageProtocol) OnFrameStoppedLoading(
-	callback func(event *page.FrameStoppedLoadingEvent),
-) {
+func (protocol *PageProtocol) OnLifecycleEventOnce(
+	callback func(event *page.LifecycleEventEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.frameStoppedLoading",
+		"Page.lifecycleEvent",
 		func(response *Response) {
-			event := &page.FrameStoppedLoadingEvent{}
+			event := &page.LifecycleEventEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -995,22 +2773,22 @@ func (protocol *PageProtocol) OnFrameStoppedLoading(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnInterstitialHidden adds a handler to the Page.interstitialHidden event.
-Page.interstitialHidden fires when interstitial page was hidden.
+OnLoadEventFired adds a handler to the Page.loadEventFired event. Page.loadEventFired
+fires when the page has finished loading.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialHidden
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-loadEventFired
 */
-func (protocol *PageProtocol) OnInterstitialHidden(
-	callback func(event *page.InterstitialHiddenEvent),
-) {
+func (protocol *PageProtocol) OnLoadEventFired(
+	callback func(event *page.LoadEventFiredEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.interstitialHidden",
+		"Page.loadEventFired",
 		func(response *Response) {
-			event := &page.InterstitialHiddenEvent{}
+			event := &page.LoadEventFiredEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1018,46 +2796,90 @@ func (protocol *PageProtocol) OnInterstitialHidden(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnInterstitialShown adds a handler to the Page.interstitialShown event.
-Page.interstitialShown fires when interstitial page was shown.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialShown
+LoadEventFiredEvents returns a channel that receives each Page.loadEventFired
+event. The channel is closed when the context is done or the socket stops
+listening. See OnLoadEventFired.
 */
-func (protocol *PageProtocol) OnInterstitialShown(
-	callback func(event *page.InterstitialShownEvent),
-) {
-	handler := NewEventHandler(
-		"Page.interstitialShown",
-		func(response *Response) {
-			event := &page.InterstitialShownEvent{}
+func (protocol *PageProtocol) LoadEventFiredEvents(
+	ctx context.Context,
+) <-chan *page.LoadEventFiredEvent {
+	eventChan := make(chan *page.LoadEventFiredEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.loadEventFired")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.LoadEventFiredEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForLoadEventFired subscribes to the Page.loadEventFired event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForLoadEventFired(
+	ctx context.Context,
+	predicate func(event *page.LoadEventFiredEvent) bool,
+) <-chan *page.LoadEventFiredEvent {
+	eventChan := make(chan *page.LoadEventFiredEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.loadEventFired",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.LoadEventFiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &page.LoadEventFiredEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnJavascriptDialogClosed adds a handler to the Page.javascriptDialogClosed
-event. Page.javascriptDialogClosed fires when a JavaScript initiated dialog
-(alert, confirm, prompt, or onbeforeunload) has been closed.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogClosed
+OnLoadEventFiredOnce adds a handler to the Page.loadEventFired event that is
+removed after the first event is received. See OnLoadEventFired.
 */
-func (protocol *PageProtocol) OnJavascriptDialogClosed(
-	callback func(event *page.JavascriptDialogClosedEvent),
-) {
+func (protocol *PageProtocol) OnLoadEventFiredOnce(
+	callback func(event *page.LoadEventFiredEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.javascriptDialogClosed",
+		"Page.loadEventFired",
 		func(response *Response) {
-			event := &page.JavascriptDialogClosedEvent{}
+			event := &page.LoadEventFiredEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1065,23 +2887,23 @@ func (protocol *PageProtocol) OnJavascriptDialogClosed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnJavascriptDialogOpening adds a handler to the Page.javascriptDialogOpening
-event. Page.javascriptDialogOpening fires when a JavaScript initiated dialog
-(alert, confirm, prompt, or onbeforeunload) is about to open.
+OnScreencastFrame adds a handler to the Page.screencastFrame event. Page.screencastFrame
+fires when compressed image data is requested by the `startScreencast` method.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogOpening
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastFrame
+EXPERIMENTAL.
 */
-func (protocol *PageProtocol) OnJavascriptDialogOpening(
-	callback func(event *page.JavascriptDialogOpeningEvent),
-) {
+func (protocol *PageProtocol) OnScreencastFrame(
+	callback func(event *page.ScreencastFrameEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.javascriptDialogOpening",
+		"Page.screencastFrame",
 		func(response *Response) {
-			event := &page.JavascriptDialogOpeningEvent{}
+			event := &page.ScreencastFrameEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1089,45 +2911,90 @@ func (protocol *PageProtocol) OnJavascriptDialogOpening(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnLifecycleEvent adds a handler to the Page.lifecycleEvent event. Page.lifecycleEvent
-fires for top level page lifecycle events such as navigation, load, paint, etc.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-lifecycleEvent
+ScreencastFrameEvents returns a channel that receives each Page.screencastFrame
+event. The channel is closed when the context is done or the socket stops
+listening. See OnScreencastFrame.
 */
-func (protocol *PageProtocol) OnLifecycleEvent(
-	callback func(event *page.LifecycleEventEvent),
-) {
-	handler := NewEventHandler(
-		"Page.lifecycleEvent",
-		func(response *Response) {
-			event := &page.LifecycleEventEvent{}
+func (protocol *PageProtocol) ScreencastFrameEvents(
+	ctx context.Context,
+) <-chan *page.ScreencastFrameEvent {
+	eventChan := make(chan *page.ScreencastFrameEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.screencastFrame")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.ScreencastFrameEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
 			}
-			callback(event)
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForScreencastFrame subscribes to the Page.screencastFrame event and returns
+a channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForScreencastFrame(
+	ctx context.Context,
+	predicate func(event *page.ScreencastFrameEvent) bool,
+) <-chan *page.ScreencastFrameEvent {
+	eventChan := make(chan *page.ScreencastFrameEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.screencastFrame",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.ScreencastFrameEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+
+	go func() {
+		response := <-responseChan
+		event := &page.ScreencastFrameEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
 }
 
 /*
-OnLoadEventFired adds a handler to the Page.loadEventFired event. Page.loadEventFired
-fires when the page has finished loading.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-loadEventFired
+OnScreencastFrameOnce adds a handler to the Page.screencastFrame event that is
+removed after the first event is received. See OnScreencastFrame.
 */
-func (protocol *PageProtocol) OnLoadEventFired(
-	callback func(event *page.LoadEventFiredEvent),
-) {
+func (protocol *PageProtocol) OnScreencastFrameOnce(
+	callback func(event *page.ScreencastFrameEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.loadEventFired",
+		"Page.screencastFrame",
 		func(response *Response) {
-			event := &page.LoadEventFiredEvent{}
+			event := &page.ScreencastFrameEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1135,23 +3002,24 @@ func (protocol *PageProtocol) OnLoadEventFired(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
-OnScreencastFrame adds a handler to the Page.screencastFrame event. Page.screencastFrame
-fires when compressed image data is requested by the `startScreencast` method.
+OnScreencastVisibilityChanged adds a handler to the Page.screencastVisibilityChanged
+event. Page.screencastVisibilityChanged fires when the page with currently
+enabled screencast was shown or hidden.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastFrame
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastVisibilityChanged
 EXPERIMENTAL.
 */
-func (protocol *PageProtocol) OnScreencastFrame(
-	callback func(event *page.ScreencastFrameEvent),
-) {
+func (protocol *PageProtocol) OnScreencastVisibilityChanged(
+	callback func(event *page.ScreencastVisibilityChangedEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"Page.screencastFrame",
+		"Page.screencastVisibilityChanged",
 		func(response *Response) {
-			event := &page.ScreencastFrameEvent{}
+			event := &page.ScreencastVisibilityChangedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -1159,20 +3027,87 @@ func (protocol *PageProtocol) OnScreencastFrame(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
 }
 
 /*
-OnScreencastVisibilityChanged adds a handler to the Page.screencastVisibilityChanged
-event. Page.screencastVisibilityChanged fires when the page with currently
-enabled screencast was shown or hidden.
+ScreencastVisibilityChangedEvents returns a channel that receives each
+Page.screencastVisibilityChanged event. The channel is closed when the context
+is done or the socket stops listening. See OnScreencastVisibilityChanged.
+*/
+func (protocol *PageProtocol) ScreencastVisibilityChangedEvents(
+	ctx context.Context,
+) <-chan *page.ScreencastVisibilityChangedEvent {
+	eventChan := make(chan *page.ScreencastVisibilityChangedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.screencastVisibilityChanged")
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastVisibilityChanged
-EXPERIMENTAL.
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.ScreencastVisibilityChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForScreencastVisibilityChanged subscribes to the
+Page.screencastVisibilityChanged event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
 */
-func (protocol *PageProtocol) OnScreencastVisibilityChanged(
+func (protocol *PageProtocol) WaitForScreencastVisibilityChanged(
+	ctx context.Context,
+	predicate func(event *page.ScreencastVisibilityChangedEvent) bool,
+) <-chan *page.ScreencastVisibilityChangedEvent {
+	eventChan := make(chan *page.ScreencastVisibilityChangedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.screencastVisibilityChanged",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.ScreencastVisibilityChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.ScreencastVisibilityChangedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnScreencastVisibilityChangedOnce adds a handler to the
+Page.screencastVisibilityChanged event that is removed after the first event is
+received. See OnScreencastVisibilityChanged.
+*/
+func (protocol *PageProtocol) OnScreencastVisibilityChangedOnce(
 	callback func(event *page.ScreencastVisibilityChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.screencastVisibilityChanged",
 		func(response *Response) {
@@ -1184,7 +3119,7 @@ func (protocol *PageProtocol) OnScreencastVisibilityChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -1196,7 +3131,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-windowOpen
 */
 func (protocol *PageProtocol) OnWindowOpen(
 	callback func(event *page.WindowOpenEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.windowOpen",
+		func(response *Response) {
+			event := &page.WindowOpenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WindowOpenEvents returns a channel that receives each Page.windowOpen event. The
+channel is closed when the context is done or the socket stops listening. See
+OnWindowOpen.
+*/
+func (protocol *PageProtocol) WindowOpenEvents(
+	ctx context.Context,
+) <-chan *page.WindowOpenEvent {
+	eventChan := make(chan *page.WindowOpenEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Page.windowOpen")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &page.WindowOpenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWindowOpen subscribes to the Page.windowOpen event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PageProtocol) WaitForWindowOpen(
+	ctx context.Context,
+	predicate func(event *page.WindowOpenEvent) bool,
+) <-chan *page.WindowOpenEvent {
+	eventChan := make(chan *page.WindowOpenEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Page.windowOpen",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &page.WindowOpenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &page.WindowOpenEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWindowOpenOnce adds a handler to the Page.windowOpen event that is removed
+after the first event is received. See OnWindowOpen.
+*/
+func (protocol *PageProtocol) OnWindowOpenOnce(
+	callback func(event *page.WindowOpenEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.windowOpen",
 		func(response *Response) {
@@ -1208,5 +3234,5 @@ func (protocol *PageProtocol) OnWindowOpen(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
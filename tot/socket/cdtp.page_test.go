@@ -1,7 +1,9 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/url"
 	"testing"
 	"time"
@@ -671,6 +673,36 @@ func TestPageNavigate(t *testing.T) {
 	}
 }
 
+func TestPageNavigateContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageNavigateContext")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	params := &page.NavigateParams{
+		URL: "http://some.url",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	resultChan := mockSocket.Page().NavigateContext(ctx, params)
+	result := <-resultChan
+	var timeoutErr *TimeoutError
+	if !errors.As(result.Err, &timeoutErr) {
+		t.Errorf("Expected TimeoutError, got '%v'", result.Err)
+	}
+	if !errors.Is(result.Err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got '%v'", result.Err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	resultChan = mockSocket.Page().NavigateContext(ctx, params)
+	result = <-resultChan
+	if context.Canceled != result.Err {
+		t.Errorf("Expected context.Canceled, got '%v'", result.Err)
+	}
+}
+
 func TestPageNavigateToHistoryEntry(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestPageNavigateToHistoryEntry")
 	mockSocket := NewMock(socketURL)
@@ -2000,6 +2032,91 @@ func TestPageOnLoadEventFired(t *testing.T) {
 	}
 }
 
+func TestPageLoadEventFiredEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageLoadEventFiredEvents")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().LoadEventFiredEvents(ctx)
+	mockResult := &page.LoadEventFiredEvent{
+		Timestamp: page.MonotonicTime(time.Now().Unix()),
+	}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.loadEventFired",
+		Params: mockResultBytes,
+	})
+	result := <-eventChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if mockResult.Timestamp != result.Timestamp {
+		t.Errorf("Expected %d, got %d", mockResult.Timestamp, result.Timestamp)
+	}
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.loadEventFired",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected closed channel, got event")
+	}
+}
+
+func TestPageOnLoadEventFiredOnce(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnLoadEventFiredOnce")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.LoadEventFiredEvent, 2)
+	subscription := mockSocket.Page().OnLoadEventFiredOnce(func(eventData *page.LoadEventFiredEvent) {
+		resultChan <- eventData
+	})
+	if "Page.loadEventFired" != subscription.Name() {
+		t.Errorf("Expected 'Page.loadEventFired', got '%s'", subscription.Name())
+	}
+	mockResult := &page.LoadEventFiredEvent{
+		Timestamp: page.MonotonicTime(time.Now().Unix()),
+	}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.loadEventFired",
+		Params: mockResultBytes,
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.loadEventFired",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if mockResult.Timestamp != result.Timestamp {
+		t.Errorf("Expected %d, got %d", mockResult.Timestamp, result.Timestamp)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if 0 != len(resultChan) {
+		t.Errorf("Expected a single event, got %d more", len(resultChan))
+	}
+}
+
 func TestPageOnScreencastFrame(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestPageOnScreencastFrame")
 	mockSocket := NewMock(socketURL)
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/performance"
@@ -22,13 +23,24 @@ Disable disables collecting and reporting metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-disable
 */
 func (protocol *PerformanceProtocol) Disable() <-chan *performance.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *PerformanceProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *performance.DisableResult {
 	resultChan := make(chan *performance.DisableResult)
 	command := NewCommand(protocol.Socket, "Performance.disable", nil)
 	result := &performance.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -44,13 +56,24 @@ Enable enables collecting and reporting metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-enable
 */
 func (protocol *PerformanceProtocol) Enable() <-chan *performance.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *PerformanceProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *performance.EnableResult {
 	resultChan := make(chan *performance.EnableResult)
 	command := NewCommand(protocol.Socket, "Performance.enable", nil)
 	result := &performance.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -66,13 +89,24 @@ GetMetrics retrieves current values of run-time metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-getMetrics
 */
 func (protocol *PerformanceProtocol) GetMetrics() <-chan *performance.GetMetricsResult {
+	return protocol.GetMetricsContext(context.Background())
+}
+
+/*
+GetMetricsContext is the context.Context-aware version of GetMetrics.
+*/
+func (protocol *PerformanceProtocol) GetMetricsContext(
+	ctx context.Context,
+) <-chan *performance.GetMetricsResult {
 	resultChan := make(chan *performance.GetMetricsResult)
 	command := NewCommand(protocol.Socket, "Performance.getMetrics", nil)
 	result := &performance.GetMetricsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -92,7 +126,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Performance/#event-metric
 */
 func (protocol *PerformanceProtocol) OnMetrics(
 	callback func(event *performance.MetricsEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Performance.metrics",
+		func(response *Response) {
+			event := &performance.MetricsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+MetricsEvents returns a channel that receives each Performance.metrics event.
+The channel is closed when the context is done or the socket stops listening.
+See OnMetrics.
+*/
+func (protocol *PerformanceProtocol) MetricsEvents(
+	ctx context.Context,
+) <-chan *performance.MetricsEvent {
+	eventChan := make(chan *performance.MetricsEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Performance.metrics")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &performance.MetricsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForMetrics subscribes to the Performance.metrics event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *PerformanceProtocol) WaitForMetrics(
+	ctx context.Context,
+	predicate func(event *performance.MetricsEvent) bool,
+) <-chan *performance.MetricsEvent {
+	eventChan := make(chan *performance.MetricsEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Performance.metrics",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &performance.MetricsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &performance.MetricsEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnMetricsOnce adds a handler to the Performance.metrics event that is removed
+after the first event is received. See OnMetrics.
+*/
+func (protocol *PerformanceProtocol) OnMetricsOnce(
+	callback func(event *performance.MetricsEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Performance.metrics",
 		func(response *Response) {
@@ -104,5 +229,5 @@ func (protocol *PerformanceProtocol) OnMetrics(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/profiler"
@@ -21,13 +22,24 @@ Disable disables profiling.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-disable
 */
 func (protocol *ProfilerProtocol) Disable() <-chan *profiler.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *ProfilerProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *profiler.DisableResult {
 	resultChan := make(chan *profiler.DisableResult)
 	command := NewCommand(protocol.Socket, "Profiler.disable", nil)
 	result := &profiler.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,13 +55,24 @@ Enable enables profiling.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-enable
 */
 func (protocol *ProfilerProtocol) Enable() <-chan *profiler.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *ProfilerProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *profiler.EnableResult {
 	resultChan := make(chan *profiler.EnableResult)
 	command := NewCommand(protocol.Socket, "Profiler.enable", nil)
 	result := &profiler.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -66,13 +89,25 @@ coverage data may be incomplete due to garbage collection.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-getBestEffortCoverage
 */
 func (protocol *ProfilerProtocol) GetBestEffortCoverage() <-chan *profiler.GetBestEffortCoverageResult {
+	return protocol.GetBestEffortCoverageContext(context.Background())
+}
+
+/*
+GetBestEffortCoverageContext is the context.Context-aware version of
+GetBestEffortCoverage.
+*/
+func (protocol *ProfilerProtocol) GetBestEffortCoverageContext(
+	ctx context.Context,
+) <-chan *profiler.GetBestEffortCoverageResult {
 	resultChan := make(chan *profiler.GetBestEffortCoverageResult)
 	command := NewCommand(protocol.Socket, "Profiler.getBestEffortCoverage", nil)
 	result := &profiler.GetBestEffortCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -92,14 +127,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-setSampl
 */
 func (protocol *ProfilerProtocol) SetSamplingInterval(
 	params *profiler.SetSamplingIntervalParams,
+) <-chan *profiler.SetSamplingIntervalResult {
+	return protocol.SetSamplingIntervalContext(context.Background(), params)
+}
+
+/*
+SetSamplingIntervalContext is the context.Context-aware version of
+SetSamplingInterval.
+*/
+func (protocol *ProfilerProtocol) SetSamplingIntervalContext(
+	ctx context.Context,
+	params *profiler.SetSamplingIntervalParams,
 ) <-chan *profiler.SetSamplingIntervalResult {
 	resultChan := make(chan *profiler.SetSamplingIntervalResult)
 	command := NewCommand(protocol.Socket, "Profiler.setSamplingInterval", params)
 	result := &profiler.SetSamplingIntervalResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -115,13 +163,24 @@ Start starts profiling.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-start
 */
 func (protocol *ProfilerProtocol) Start() <-chan *profiler.StartResult {
+	return protocol.StartContext(context.Background())
+}
+
+/*
+StartContext is the context.Context-aware version of Start.
+*/
+func (protocol *ProfilerProtocol) StartContext(
+	ctx context.Context,
+) <-chan *profiler.StartResult {
 	resultChan := make(chan *profiler.StartResult)
 	command := NewCommand(protocol.Socket, "Profiler.start", nil)
 	result := &profiler.StartResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -140,14 +199,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startPre
 */
 func (protocol *ProfilerProtocol) StartPreciseCoverage(
 	params *profiler.StartPreciseCoverageParams,
+) <-chan *profiler.StartPreciseCoverageResult {
+	return protocol.StartPreciseCoverageContext(context.Background(), params)
+}
+
+/*
+StartPreciseCoverageContext is the context.Context-aware version of
+StartPreciseCoverage.
+*/
+func (protocol *ProfilerProtocol) StartPreciseCoverageContext(
+	ctx context.Context,
+	params *profiler.StartPreciseCoverageParams,
 ) <-chan *profiler.StartPreciseCoverageResult {
 	resultChan := make(chan *profiler.StartPreciseCoverageResult)
 	command := NewCommand(protocol.Socket, "Profiler.startPreciseCoverage", params)
 	result := &profiler.StartPreciseCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -164,13 +236,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startTyp
 EXPERIMENTAL.
 */
 func (protocol *ProfilerProtocol) StartTypeProfile() <-chan *profiler.StartTypeProfileResult {
+	return protocol.StartTypeProfileContext(context.Background())
+}
+
+/*
+StartTypeProfileContext is the context.Context-aware version of
+StartTypeProfile.
+*/
+func (protocol *ProfilerProtocol) StartTypeProfileContext(
+	ctx context.Context,
+) <-chan *profiler.StartTypeProfileResult {
 	resultChan := make(chan *profiler.StartTypeProfileResult)
 	command := NewCommand(protocol.Socket, "Profiler.startTypeProfile", nil)
 	result := &profiler.StartTypeProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -186,13 +270,24 @@ Stop stops profiling.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stop
 */
 func (protocol *ProfilerProtocol) Stop() <-chan *profiler.StopResult {
+	return protocol.StopContext(context.Background())
+}
+
+/*
+StopContext is the context.Context-aware version of Stop.
+*/
+func (protocol *ProfilerProtocol) StopContext(
+	ctx context.Context,
+) <-chan *profiler.StopResult {
 	resultChan := make(chan *profiler.StopResult)
 	command := NewCommand(protocol.Socket, "Profiler.stop", nil)
 	result := &profiler.StopResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -211,13 +306,25 @@ unnecessary execution count records and allows executing optimized code.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopPreciseCoverage
 */
 func (protocol *ProfilerProtocol) StopPreciseCoverage() <-chan *profiler.StopPreciseCoverageResult {
+	return protocol.StopPreciseCoverageContext(context.Background())
+}
+
+/*
+StopPreciseCoverageContext is the context.Context-aware version of
+StopPreciseCoverage.
+*/
+func (protocol *ProfilerProtocol) StopPreciseCoverageContext(
+	ctx context.Context,
+) <-chan *profiler.StopPreciseCoverageResult {
 	resultChan := make(chan *profiler.StopPreciseCoverageResult)
 	command := NewCommand(protocol.Socket, "Profiler.stopPreciseCoverage", nil)
 	result := &profiler.StopPreciseCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -235,13 +342,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopType
 EXPERIMENTAL.
 */
 func (protocol *ProfilerProtocol) StopTypeProfile() <-chan *profiler.StopTypeProfileResult {
+	return protocol.StopTypeProfileContext(context.Background())
+}
+
+/*
+StopTypeProfileContext is the context.Context-aware version of StopTypeProfile.
+*/
+func (protocol *ProfilerProtocol) StopTypeProfileContext(
+	ctx context.Context,
+) <-chan *profiler.StopTypeProfileResult {
 	resultChan := make(chan *profiler.StopTypeProfileResult)
 	command := NewCommand(protocol.Socket, "Profiler.stopTypeProfile", nil)
 	result := &profiler.StopTypeProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -258,13 +376,25 @@ execution counters. Precise code coverage needs to have started.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-takePreciseCoverage
 */
 func (protocol *ProfilerProtocol) TakePreciseCoverage() <-chan *profiler.TakePreciseCoverageResult {
+	return protocol.TakePreciseCoverageContext(context.Background())
+}
+
+/*
+TakePreciseCoverageContext is the context.Context-aware version of
+TakePreciseCoverage.
+*/
+func (protocol *ProfilerProtocol) TakePreciseCoverageContext(
+	ctx context.Context,
+) <-chan *profiler.TakePreciseCoverageResult {
 	resultChan := make(chan *profiler.TakePreciseCoverageResult)
 	command := NewCommand(protocol.Socket, "Profiler.takePreciseCoverage", nil)
 	result := &profiler.TakePreciseCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -283,13 +413,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-takeType
 EXPERIMENTAL.
 */
 func (protocol *ProfilerProtocol) TakeTypeProfile() <-chan *profiler.TakeTypeProfileResult {
+	return protocol.TakeTypeProfileContext(context.Background())
+}
+
+/*
+TakeTypeProfileContext is the context.Context-aware version of TakeTypeProfile.
+*/
+func (protocol *ProfilerProtocol) TakeTypeProfileContext(
+	ctx context.Context,
+) <-chan *profiler.TakeTypeProfileResult {
 	resultChan := make(chan *profiler.TakeTypeProfileResult)
 	command := NewCommand(protocol.Socket, "Profiler.takeTypeProfile", nil)
 	result := &profiler.TakeTypeProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -309,7 +450,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-consolePr
 */
 func (protocol *ProfilerProtocol) OnConsoleProfileFinished(
 	callback func(event *profiler.ConsoleProfileFinishedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Profiler.consoleProfileFinished",
+		func(response *Response) {
+			event := &profiler.ConsoleProfileFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ConsoleProfileFinishedEvents returns a channel that receives each
+Profiler.consoleProfileFinished event. The channel is closed when the context is
+done or the socket stops listening. See OnConsoleProfileFinished.
+*/
+func (protocol *ProfilerProtocol) ConsoleProfileFinishedEvents(
+	ctx context.Context,
+) <-chan *profiler.ConsoleProfileFinishedEvent {
+	eventChan := make(chan *profiler.ConsoleProfileFinishedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Profiler.consoleProfileFinished")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &profiler.ConsoleProfileFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForConsoleProfileFinished subscribes to the Profiler.consoleProfileFinished
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *ProfilerProtocol) WaitForConsoleProfileFinished(
+	ctx context.Context,
+	predicate func(event *profiler.ConsoleProfileFinishedEvent) bool,
+) <-chan *profiler.ConsoleProfileFinishedEvent {
+	eventChan := make(chan *profiler.ConsoleProfileFinishedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Profiler.consoleProfileFinished",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &profiler.ConsoleProfileFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &profiler.ConsoleProfileFinishedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnConsoleProfileFinishedOnce adds a handler to the
+Profiler.consoleProfileFinished event that is removed after the first event is
+received. See OnConsoleProfileFinished.
+*/
+func (protocol *ProfilerProtocol) OnConsoleProfileFinishedOnce(
+	callback func(event *profiler.ConsoleProfileFinishedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Profiler.consoleProfileFinished",
 		func(response *Response) {
@@ -321,7 +554,7 @@ func (protocol *ProfilerProtocol) OnConsoleProfileFinished(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -333,7 +566,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-consolePr
 */
 func (protocol *ProfilerProtocol) OnConsoleProfileStarted(
 	callback func(event *profiler.ConsoleProfileStartedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Profiler.consoleProfileStarted",
+		func(response *Response) {
+			event := &profiler.ConsoleProfileStartedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ConsoleProfileStartedEvents returns a channel that receives each
+Profiler.consoleProfileStarted event. The channel is closed when the context is
+done or the socket stops listening. See OnConsoleProfileStarted.
+*/
+func (protocol *ProfilerProtocol) ConsoleProfileStartedEvents(
+	ctx context.Context,
+) <-chan *profiler.ConsoleProfileStartedEvent {
+	eventChan := make(chan *profiler.ConsoleProfileStartedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Profiler.consoleProfileStarted")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &profiler.ConsoleProfileStartedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForConsoleProfileStarted subscribes to the Profiler.consoleProfileStarted
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *ProfilerProtocol) WaitForConsoleProfileStarted(
+	ctx context.Context,
+	predicate func(event *profiler.ConsoleProfileStartedEvent) bool,
+) <-chan *profiler.ConsoleProfileStartedEvent {
+	eventChan := make(chan *profiler.ConsoleProfileStartedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Profiler.consoleProfileStarted",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &profiler.ConsoleProfileStartedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &profiler.ConsoleProfileStartedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnConsoleProfileStartedOnce adds a handler to the Profiler.consoleProfileStarted
+event that is removed after the first event is received. See
+OnConsoleProfileStarted.
+*/
+func (protocol *ProfilerProtocol) OnConsoleProfileStartedOnce(
+	callback func(event *profiler.ConsoleProfileStartedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Profiler.consoleProfileStarted",
 		func(response *Response) {
@@ -345,5 +670,5 @@ func (protocol *ProfilerProtocol) OnConsoleProfileStarted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
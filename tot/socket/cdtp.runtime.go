@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/runtime"
@@ -28,14 +29,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-awaitProm
 */
 func (protocol *RuntimeProtocol) AwaitPromise(
 	params *runtime.AwaitPromiseParams,
+) <-chan *runtime.AwaitPromiseResult {
+	return protocol.AwaitPromiseContext(context.Background(), params)
+}
+
+/*
+AwaitPromiseContext is the context.Context-aware version of AwaitPromise.
+*/
+func (protocol *RuntimeProtocol) AwaitPromiseContext(
+	ctx context.Context,
+	params *runtime.AwaitPromiseParams,
 ) <-chan *runtime.AwaitPromiseResult {
 	resultChan := make(chan *runtime.AwaitPromiseResult)
 	command := NewCommand(protocol.Socket, "Runtime.awaitPromise", params)
 	result := &runtime.AwaitPromiseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -55,14 +68,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-callFunct
 */
 func (protocol *RuntimeProtocol) CallFunctionOn(
 	params *runtime.CallFunctionOnParams,
+) <-chan *runtime.CallFunctionOnResult {
+	return protocol.CallFunctionOnContext(context.Background(), params)
+}
+
+/*
+CallFunctionOnContext is the context.Context-aware version of CallFunctionOn.
+*/
+func (protocol *RuntimeProtocol) CallFunctionOnContext(
+	ctx context.Context,
+	params *runtime.CallFunctionOnParams,
 ) <-chan *runtime.CallFunctionOnResult {
 	resultChan := make(chan *runtime.CallFunctionOnResult)
 	command := NewCommand(protocol.Socket, "Runtime.callFunctionOn", params)
 	result := &runtime.CallFunctionOnResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -81,14 +106,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-compileSc
 */
 func (protocol *RuntimeProtocol) CompileScript(
 	params *runtime.CompileScriptParams,
+) <-chan *runtime.CompileScriptResult {
+	return protocol.CompileScriptContext(context.Background(), params)
+}
+
+/*
+CompileScriptContext is the context.Context-aware version of CompileScript.
+*/
+func (protocol *RuntimeProtocol) CompileScriptContext(
+	ctx context.Context,
+	params *runtime.CompileScriptParams,
 ) <-chan *runtime.CompileScriptResult {
 	resultChan := make(chan *runtime.CompileScriptResult)
 	command := NewCommand(protocol.Socket, "Runtime.compileScript", params)
 	result := &runtime.CompileScriptResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -106,13 +143,24 @@ Disable disables reporting of execution contexts creation.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-disable
 */
 func (protocol *RuntimeProtocol) Disable() <-chan *runtime.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *RuntimeProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *runtime.DisableResult {
 	resultChan := make(chan *runtime.DisableResult)
 	command := NewCommand(protocol.Socket, "Runtime.disable", nil)
 	result := &runtime.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -128,13 +176,25 @@ DiscardConsoleEntries discards collected exceptions and console API calls.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-discardConsoleEntries
 */
 func (protocol *RuntimeProtocol) DiscardConsoleEntries() <-chan *runtime.DiscardConsoleEntriesResult {
+	return protocol.DiscardConsoleEntriesContext(context.Background())
+}
+
+/*
+DiscardConsoleEntriesContext is the context.Context-aware version of
+DiscardConsoleEntries.
+*/
+func (protocol *RuntimeProtocol) DiscardConsoleEntriesContext(
+	ctx context.Context,
+) <-chan *runtime.DiscardConsoleEntriesResult {
 	resultChan := make(chan *runtime.DiscardConsoleEntriesResult)
 	command := NewCommand(protocol.Socket, "Runtime.discardConsoleEntries", nil)
 	result := &runtime.DiscardConsoleEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -152,13 +212,24 @@ will be sent immediately for each existing execution context.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-enable
 */
 func (protocol *RuntimeProtocol) Enable() <-chan *runtime.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *RuntimeProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *runtime.EnableResult {
 	resultChan := make(chan *runtime.EnableResult)
 	command := NewCommand(protocol.Socket, "Runtime.enable", nil)
 	result := &runtime.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -175,14 +246,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-evaluate
 */
 func (protocol *RuntimeProtocol) Evaluate(
 	params *runtime.EvaluateParams,
+) <-chan *runtime.EvaluateResult {
+	return protocol.EvaluateContext(context.Background(), params)
+}
+
+/*
+EvaluateContext is the context.Context-aware version of Evaluate.
+*/
+func (protocol *RuntimeProtocol) EvaluateContext(
+	ctx context.Context,
+	params *runtime.EvaluateParams,
 ) <-chan *runtime.EvaluateResult {
 	resultChan := make(chan *runtime.EvaluateResult)
 	command := NewCommand(protocol.Socket, "Runtime.evaluate", params)
 	result := &runtime.EvaluateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -202,14 +285,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getProper
 */
 func (protocol *RuntimeProtocol) GetProperties(
 	params *runtime.GetPropertiesParams,
+) <-chan *runtime.GetPropertiesResult {
+	return protocol.GetPropertiesContext(context.Background(), params)
+}
+
+/*
+GetPropertiesContext is the context.Context-aware version of GetProperties.
+*/
+func (protocol *RuntimeProtocol) GetPropertiesContext(
+	ctx context.Context,
+	params *runtime.GetPropertiesParams,
 ) <-chan *runtime.GetPropertiesResult {
 	resultChan := make(chan *runtime.GetPropertiesResult)
 	command := NewCommand(protocol.Socket, "Runtime.getProperties", params)
 	result := &runtime.GetPropertiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -229,14 +324,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-globalLex
 */
 func (protocol *RuntimeProtocol) GlobalLexicalScopeNames(
 	params *runtime.GlobalLexicalScopeNamesParams,
+) <-chan *runtime.GlobalLexicalScopeNamesResult {
+	return protocol.GlobalLexicalScopeNamesContext(context.Background(), params)
+}
+
+/*
+GlobalLexicalScopeNamesContext is the context.Context-aware version of
+GlobalLexicalScopeNames.
+*/
+func (protocol *RuntimeProtocol) GlobalLexicalScopeNamesContext(
+	ctx context.Context,
+	params *runtime.GlobalLexicalScopeNamesParams,
 ) <-chan *runtime.GlobalLexicalScopeNamesResult {
 	resultChan := make(chan *runtime.GlobalLexicalScopeNamesResult)
 	command := NewCommand(protocol.Socket, "Runtime.globalLexicalScopeNames", params)
 	result := &runtime.GlobalLexicalScopeNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -255,14 +363,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-queryObje
 */
 func (protocol *RuntimeProtocol) QueryObjects(
 	params *runtime.QueryObjectsParams,
+) <-chan *runtime.QueryObjectsResult {
+	return protocol.QueryObjectsContext(context.Background(), params)
+}
+
+/*
+QueryObjectsContext is the context.Context-aware version of QueryObjects.
+*/
+func (protocol *RuntimeProtocol) QueryObjectsContext(
+	ctx context.Context,
+	params *runtime.QueryObjectsParams,
 ) <-chan *runtime.QueryObjectsResult {
 	resultChan := make(chan *runtime.QueryObjectsResult)
 	command := NewCommand(protocol.Socket, "Runtime.queryObjects", params)
 	result := &runtime.QueryObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -281,14 +401,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseOb
 */
 func (protocol *RuntimeProtocol) ReleaseObject(
 	params *runtime.ReleaseObjectParams,
+) <-chan *runtime.ReleaseObjectResult {
+	return protocol.ReleaseObjectContext(context.Background(), params)
+}
+
+/*
+ReleaseObjectContext is the context.Context-aware version of ReleaseObject.
+*/
+func (protocol *RuntimeProtocol) ReleaseObjectContext(
+	ctx context.Context,
+	params *runtime.ReleaseObjectParams,
 ) <-chan *runtime.ReleaseObjectResult {
 	resultChan := make(chan *runtime.ReleaseObjectResult)
 	command := NewCommand(protocol.Socket, "Runtime.releaseObject", params)
 	result := &runtime.ReleaseObjectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -305,14 +437,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseOb
 */
 func (protocol *RuntimeProtocol) ReleaseObjectGroup(
 	params *runtime.ReleaseObjectGroupParams,
+) <-chan *runtime.ReleaseObjectGroupResult {
+	return protocol.ReleaseObjectGroupContext(context.Background(), params)
+}
+
+/*
+ReleaseObjectGroupContext is the context.Context-aware version of
+ReleaseObjectGroup.
+*/
+func (protocol *RuntimeProtocol) ReleaseObjectGroupContext(
+	ctx context.Context,
+	params *runtime.ReleaseObjectGroupParams,
 ) <-chan *runtime.ReleaseObjectGroupResult {
 	resultChan := make(chan *runtime.ReleaseObjectGroupResult)
 	command := NewCommand(protocol.Socket, "Runtime.releaseObjectGroup", params)
 	result := &runtime.ReleaseObjectGroupResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -329,13 +474,25 @@ debugger to attach.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runIfWaitingForDebugger
 */
 func (protocol *RuntimeProtocol) RunIfWaitingForDebugger() <-chan *runtime.RunIfWaitingForDebuggerResult {
+	return protocol.RunIfWaitingForDebuggerContext(context.Background())
+}
+
+/*
+RunIfWaitingForDebuggerContext is the context.Context-aware version of
+RunIfWaitingForDebugger.
+*/
+func (protocol *RuntimeProtocol) RunIfWaitingForDebuggerContext(
+	ctx context.Context,
+) <-chan *runtime.RunIfWaitingForDebuggerResult {
 	resultChan := make(chan *runtime.RunIfWaitingForDebuggerResult)
 	command := NewCommand(protocol.Socket, "Runtime.runIfWaitingForDebugger", nil)
 	result := &runtime.RunIfWaitingForDebuggerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -352,14 +509,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runScript
 */
 func (protocol *RuntimeProtocol) RunScript(
 	params *runtime.RunScriptParams,
+) <-chan *runtime.RunScriptResult {
+	return protocol.RunScriptContext(context.Background(), params)
+}
+
+/*
+RunScriptContext is the context.Context-aware version of RunScript.
+*/
+func (protocol *RuntimeProtocol) RunScriptContext(
+	ctx context.Context,
+	params *runtime.RunScriptParams,
 ) <-chan *runtime.RunScriptResult {
 	resultChan := make(chan *runtime.RunScriptResult)
 	command := NewCommand(protocol.Socket, "Runtime.runScript", params)
 	result := &runtime.RunScriptResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -379,14 +548,27 @@ EXPERIMENTAL.
 */
 func (protocol *RuntimeProtocol) SetCustomObjectFormatterEnabled(
 	params *runtime.SetCustomObjectFormatterEnabledParams,
+) <-chan *runtime.SetCustomObjectFormatterEnabledResult {
+	return protocol.SetCustomObjectFormatterEnabledContext(context.Background(), params)
+}
+
+/*
+SetCustomObjectFormatterEnabledContext is the context.Context-aware version of
+SetCustomObjectFormatterEnabled.
+*/
+func (protocol *RuntimeProtocol) SetCustomObjectFormatterEnabledContext(
+	ctx context.Context,
+	params *runtime.SetCustomObjectFormatterEnabledParams,
 ) <-chan *runtime.SetCustomObjectFormatterEnabledResult {
 	resultChan := make(chan *runtime.SetCustomObjectFormatterEnabledResult)
 	command := NewCommand(protocol.Socket, "Runtime.setCustomObjectFormatterEnabled", params)
 	result := &runtime.SetCustomObjectFormatterEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -404,7 +586,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-consoleAPI
 */
 func (protocol *RuntimeProtocol) OnConsoleAPICalled(
 	callback func(event *runtime.ConsoleAPICalledEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Runtime.consoleAPICalled",
+		func(response *Response) {
+			event := &runtime.ConsoleAPICalledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ConsoleAPICalledEvents returns a channel that receives each
+Runtime.consoleAPICalled event. The channel is closed when the context is done
+or the socket stops listening. See OnConsoleAPICalled.
+*/
+func (protocol *RuntimeProtocol) ConsoleAPICalledEvents(
+	ctx context.Context,
+) <-chan *runtime.ConsoleAPICalledEvent {
+	eventChan := make(chan *runtime.ConsoleAPICalledEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Runtime.consoleAPICalled")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &runtime.ConsoleAPICalledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForConsoleAPICalled subscribes to the Runtime.consoleAPICalled event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *RuntimeProtocol) WaitForConsoleAPICalled(
+	ctx context.Context,
+	predicate func(event *runtime.ConsoleAPICalledEvent) bool,
+) <-chan *runtime.ConsoleAPICalledEvent {
+	eventChan := make(chan *runtime.ConsoleAPICalledEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Runtime.consoleAPICalled",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &runtime.ConsoleAPICalledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &runtime.ConsoleAPICalledEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnConsoleAPICalledOnce adds a handler to the Runtime.consoleAPICalled event that
+is removed after the first event is received. See OnConsoleAPICalled.
+*/
+func (protocol *RuntimeProtocol) OnConsoleAPICalledOnce(
+	callback func(event *runtime.ConsoleAPICalledEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.consoleAPICalled",
 		func(response *Response) {
@@ -416,7 +689,7 @@ func (protocol *RuntimeProtocol) OnConsoleAPICalled(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -427,7 +700,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionR
 */
 func (protocol *RuntimeProtocol) OnExceptionRevoked(
 	callback func(event *runtime.ExceptionRevokedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.exceptionRevoked",
 		func(response *Response) {
@@ -439,7 +712,98 @@ func (protocol *RuntimeProtocol) OnExceptionRevoked(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ExceptionRevokedEvents returns a channel that receives each
+Runtime.exceptionRevoked event. The channel is closed when the context is done
+or the socket stops listening. See OnExceptionRevoked.
+*/
+func (protocol *RuntimeProtocol) ExceptionRevokedEvents(
+	ctx context.Context,
+) <-chan *runtime.ExceptionRevokedEvent {
+	eventChan := make(chan *runtime.ExceptionRevokedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Runtime.exceptionRevoked")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &runtime.ExceptionRevokedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForExceptionRevoked subscribes to the Runtime.exceptionRevoked event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *RuntimeProtocol) WaitForExceptionRevoked(
+	ctx context.Context,
+	predicate func(event *runtime.ExceptionRevokedEvent) bool,
+) <-chan *runtime.ExceptionRevokedEvent {
+	eventChan := make(chan *runtime.ExceptionRevokedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Runtime.exceptionRevoked",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &runtime.ExceptionRevokedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &runtime.ExceptionRevokedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnExceptionRevokedOnce adds a handler to the Runtime.exceptionRevoked event that
+is removed after the first event is received. See OnExceptionRevoked.
+*/
+func (protocol *RuntimeProtocol) OnExceptionRevokedOnce(
+	callback func(event *runtime.ExceptionRevokedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Runtime.exceptionRevoked",
+		func(response *Response) {
+			event := &runtime.ExceptionRevokedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -450,7 +814,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionT
 */
 func (protocol *RuntimeProtocol) OnExceptionThrown(
 	callback func(event *runtime.ExceptionThrownEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Runtime.exceptionThrown",
+		func(response *Response) {
+			event := &runtime.ExceptionThrownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ExceptionThrownEvents returns a channel that receives each
+Runtime.exceptionThrown event. The channel is closed when the context is done or
+the socket stops listening. See OnExceptionThrown.
+*/
+func (protocol *RuntimeProtocol) ExceptionThrownEvents(
+	ctx context.Context,
+) <-chan *runtime.ExceptionThrownEvent {
+	eventChan := make(chan *runtime.ExceptionThrownEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Runtime.exceptionThrown")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &runtime.ExceptionThrownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForExceptionThrown subscribes to the Runtime.exceptionThrown event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *RuntimeProtocol) WaitForExceptionThrown(
+	ctx context.Context,
+	predicate func(event *runtime.ExceptionThrownEvent) bool,
+) <-chan *runtime.ExceptionThrownEvent {
+	eventChan := make(chan *runtime.ExceptionThrownEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Runtime.exceptionThrown",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &runtime.ExceptionThrownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &runtime.ExceptionThrownEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnExceptionThrownOnce adds a handler to the Runtime.exceptionThrown event that
+is removed after the first event is received. See OnExceptionThrown.
+*/
+func (protocol *RuntimeProtocol) OnExceptionThrownOnce(
+	callback func(event *runtime.ExceptionThrownEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.exceptionThrown",
 		func(response *Response) {
@@ -462,7 +917,7 @@ func (protocol *RuntimeProtocol) OnExceptionThrown(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -474,7 +929,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionC
 */
 func (protocol *RuntimeProtocol) OnExecutionContextCreated(
 	callback func(event *runtime.ExecutionContextCreatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.executionContextCreated",
 		func(response *Response) {
@@ -486,7 +941,99 @@ func (protocol *RuntimeProtocol) OnExecutionContextCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ExecutionContextCreatedEvents returns a channel that receives each
+Runtime.executionContextCreated event. The channel is closed when the context is
+done or the socket stops listening. See OnExecutionContextCreated.
+*/
+func (protocol *RuntimeProtocol) ExecutionContextCreatedEvents(
+	ctx context.Context,
+) <-chan *runtime.ExecutionContextCreatedEvent {
+	eventChan := make(chan *runtime.ExecutionContextCreatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Runtime.executionContextCreated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &runtime.ExecutionContextCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForExecutionContextCreated subscribes to the Runtime.executionContextCreated
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *RuntimeProtocol) WaitForExecutionContextCreated(
+	ctx context.Context,
+	predicate func(event *runtime.ExecutionContextCreatedEvent) bool,
+) <-chan *runtime.ExecutionContextCreatedEvent {
+	eventChan := make(chan *runtime.ExecutionContextCreatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Runtime.executionContextCreated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &runtime.ExecutionContextCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &runtime.ExecutionContextCreatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnExecutionContextCreatedOnce adds a handler to the
+Runtime.executionContextCreated event that is removed after the first event is
+received. See OnExecutionContextCreated.
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextCreatedOnce(
+	callback func(event *runtime.ExecutionContextCreatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Runtime.executionContextCreated",
+		func(response *Response) {
+			event := &runtime.ExecutionContextCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -498,7 +1045,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionC
 */
 func (protocol *RuntimeProtocol) OnExecutionContextDestroyed(
 	callback func(event *runtime.ExecutionContextDestroyedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Runtime.executionContextDestroyed",
+		func(response *Response) {
+			event := &runtime.ExecutionContextDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ExecutionContextDestroyedEvents returns a channel that receives each
+Runtime.executionContextDestroyed event. The channel is closed when the context
+is done or the socket stops listening. See OnExecutionContextDestroyed.
+*/
+func (protocol *RuntimeProtocol) ExecutionContextDestroyedEvents(
+	ctx context.Context,
+) <-chan *runtime.ExecutionContextDestroyedEvent {
+	eventChan := make(chan *runtime.ExecutionContextDestroyedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Runtime.executionContextDestroyed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &runtime.ExecutionContextDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForExecutionContextDestroyed subscribes to the
+Runtime.executionContextDestroyed event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *RuntimeProtocol) WaitForExecutionContextDestroyed(
+	ctx context.Context,
+	predicate func(event *runtime.ExecutionContextDestroyedEvent) bool,
+) <-chan *runtime.ExecutionContextDestroyedEvent {
+	eventChan := make(chan *runtime.ExecutionContextDestroyedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Runtime.executionContextDestroyed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &runtime.ExecutionContextDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &runtime.ExecutionContextDestroyedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnExecutionContextDestroyedOnce adds a handler to the
+Runtime.executionContextDestroyed event that is removed after the first event is
+received. See OnExecutionContextDestroyed.
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextDestroyedOnce(
+	callback func(event *runtime.ExecutionContextDestroyedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.executionContextDestroyed",
 		func(response *Response) {
@@ -510,7 +1149,7 @@ func (protocol *RuntimeProtocol) OnExecutionContextDestroyed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -522,7 +1161,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionC
 */
 func (protocol *RuntimeProtocol) OnExecutionContextsCleared(
 	callback func(event *runtime.ExecutionContextsClearedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.executionContextsCleared",
 		func(response *Response) {
@@ -534,7 +1173,99 @@ func (protocol *RuntimeProtocol) OnExecutionContextsCleared(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ExecutionContextsClearedEvents returns a channel that receives each
+Runtime.executionContextsCleared event. The channel is closed when the context
+is done or the socket stops listening. See OnExecutionContextsCleared.
+*/
+func (protocol *RuntimeProtocol) ExecutionContextsClearedEvents(
+	ctx context.Context,
+) <-chan *runtime.ExecutionContextsClearedEvent {
+	eventChan := make(chan *runtime.ExecutionContextsClearedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Runtime.executionContextsCleared")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &runtime.ExecutionContextsClearedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForExecutionContextsCleared subscribes to the
+Runtime.executionContextsCleared event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *RuntimeProtocol) WaitForExecutionContextsCleared(
+	ctx context.Context,
+	predicate func(event *runtime.ExecutionContextsClearedEvent) bool,
+) <-chan *runtime.ExecutionContextsClearedEvent {
+	eventChan := make(chan *runtime.ExecutionContextsClearedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Runtime.executionContextsCleared",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &runtime.ExecutionContextsClearedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &runtime.ExecutionContextsClearedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnExecutionContextsClearedOnce adds a handler to the
+Runtime.executionContextsCleared event that is removed after the first event is
+received. See OnExecutionContextsCleared.
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextsClearedOnce(
+	callback func(event *runtime.ExecutionContextsClearedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Runtime.executionContextsCleared",
+		func(response *Response) {
+			event := &runtime.ExecutionContextsClearedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -546,7 +1277,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-inspectReq
 */
 func (protocol *RuntimeProtocol) OnInspectRequested(
 	callback func(event *runtime.InspectRequestedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Runtime.inspectRequested",
+		func(response *Response) {
+			event := &runtime.InspectRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+InspectRequestedEvents returns a channel that receives each
+Runtime.inspectRequested event. The channel is closed when the context is done
+or the socket stops listening. See OnInspectRequested.
+*/
+func (protocol *RuntimeProtocol) InspectRequestedEvents(
+	ctx context.Context,
+) <-chan *runtime.InspectRequestedEvent {
+	eventChan := make(chan *runtime.InspectRequestedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Runtime.inspectRequested")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &runtime.InspectRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForInspectRequested subscribes to the Runtime.inspectRequested event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *RuntimeProtocol) WaitForInspectRequested(
+	ctx context.Context,
+	predicate func(event *runtime.InspectRequestedEvent) bool,
+) <-chan *runtime.InspectRequestedEvent {
+	eventChan := make(chan *runtime.InspectRequestedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Runtime.inspectRequested",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &runtime.InspectRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &runtime.InspectRequestedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnInspectRequestedOnce adds a handler to the Runtime.inspectRequested event that
+is removed after the first event is received. See OnInspectRequested.
+*/
+func (protocol *RuntimeProtocol) OnInspectRequestedOnce(
+	callback func(event *runtime.InspectRequestedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.inspectRequested",
 		func(response *Response) {
@@ -558,5 +1380,5 @@ func (protocol *RuntimeProtocol) OnInspectRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
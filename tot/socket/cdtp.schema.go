@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/schema"
@@ -22,13 +23,24 @@ GetDomains returns supported domains.
 https://chromedevtools.github.io/devtools-protocol/tot/Schema/#method-getDomains
 */
 func (protocol *SchemaProtocol) GetDomains() <-chan *schema.GetDomainsResult {
+	return protocol.GetDomainsContext(context.Background())
+}
+
+/*
+GetDomainsContext is the context.Context-aware version of GetDomains.
+*/
+func (protocol *SchemaProtocol) GetDomainsContext(
+	ctx context.Context,
+) <-chan *schema.GetDomainsResult {
 	resultChan := make(chan *schema.GetDomainsResult)
 	command := NewCommand(protocol.Socket, "Schema.getDomains", nil)
 	result := &schema.GetDomainsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/security"
@@ -21,13 +22,24 @@ Disable disables tracking security state changes.
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-disable
 */
 func (protocol *SecurityProtocol) Disable() <-chan *security.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *SecurityProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *security.DisableResult {
 	resultChan := make(chan *security.DisableResult)
 	command := NewCommand(protocol.Socket, "Security.disable", nil)
 	result := &security.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,13 +55,24 @@ Enable tracking security state changes.
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-enable
 */
 func (protocol *SecurityProtocol) Enable() <-chan *security.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *SecurityProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *security.EnableResult {
 	resultChan := make(chan *security.EnableResult)
 	command := NewCommand(protocol.Socket, "Security.enable", nil)
 	result := &security.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -67,14 +90,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCe
 */
 func (protocol *SecurityProtocol) HandleCertificateError(
 	params *security.HandleCertificateErrorParams,
+) <-chan *security.HandleCertificateErrorResult {
+	return protocol.HandleCertificateErrorContext(context.Background(), params)
+}
+
+/*
+HandleCertificateErrorContext is the context.Context-aware version of
+HandleCertificateError.
+*/
+func (protocol *SecurityProtocol) HandleCertificateErrorContext(
+	ctx context.Context,
+	params *security.HandleCertificateErrorParams,
 ) <-chan *security.HandleCertificateErrorResult {
 	resultChan := make(chan *security.HandleCertificateErrorResult)
 	command := NewCommand(protocol.Socket, "Security.handleCertificateError", params)
 	result := &security.HandleCertificateErrorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -93,14 +129,27 @@ EXPERIMENTAL.
 */
 func (protocol *SecurityProtocol) SetIgnoreCertificateErrors(
 	params *security.SetIgnoreCertificateErrorsParams,
+) <-chan *security.SetIgnoreCertificateErrorsResult {
+	return protocol.SetIgnoreCertificateErrorsContext(context.Background(), params)
+}
+
+/*
+SetIgnoreCertificateErrorsContext is the context.Context-aware version of
+SetIgnoreCertificateErrors.
+*/
+func (protocol *SecurityProtocol) SetIgnoreCertificateErrorsContext(
+	ctx context.Context,
+	params *security.SetIgnoreCertificateErrorsParams,
 ) <-chan *security.SetIgnoreCertificateErrorsResult {
 	resultChan := make(chan *security.SetIgnoreCertificateErrorsResult)
 	command := NewCommand(protocol.Socket, "Security.setIgnoreCertificateErrors", params)
 	result := &security.SetIgnoreCertificateErrorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -119,14 +168,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setOverr
 */
 func (protocol *SecurityProtocol) SetOverrideCertificateErrors(
 	params *security.SetOverrideCertificateErrorsParams,
+) <-chan *security.SetOverrideCertificateErrorsResult {
+	return protocol.SetOverrideCertificateErrorsContext(context.Background(), params)
+}
+
+/*
+SetOverrideCertificateErrorsContext is the context.Context-aware version of
+SetOverrideCertificateErrors.
+*/
+func (protocol *SecurityProtocol) SetOverrideCertificateErrorsContext(
+	ctx context.Context,
+	params *security.SetOverrideCertificateErrorsParams,
 ) <-chan *security.SetOverrideCertificateErrorsResult {
 	resultChan := make(chan *security.SetOverrideCertificateErrorsResult)
 	command := NewCommand(protocol.Socket, "Security.setOverrideCertificateErrors", params)
 	result := &security.SetOverrideCertificateErrorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -147,7 +209,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-certifica
 */
 func (protocol *SecurityProtocol) OnCertificateError(
 	callback func(event *security.CertificateErrorEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Security.certificateError",
+		func(response *Response) {
+			event := &security.CertificateErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+CertificateErrorEvents returns a channel that receives each
+Security.certificateError event. The channel is closed when the context is done
+or the socket stops listening. See OnCertificateError.
+*/
+func (protocol *SecurityProtocol) CertificateErrorEvents(
+	ctx context.Context,
+) <-chan *security.CertificateErrorEvent {
+	eventChan := make(chan *security.CertificateErrorEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Security.certificateError")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &security.CertificateErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForCertificateError subscribes to the Security.certificateError event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *SecurityProtocol) WaitForCertificateError(
+	ctx context.Context,
+	predicate func(event *security.CertificateErrorEvent) bool,
+) <-chan *security.CertificateErrorEvent {
+	eventChan := make(chan *security.CertificateErrorEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Security.certificateError",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &security.CertificateErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &security.CertificateErrorEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnCertificateErrorOnce adds a handler to the Security.certificateError event
+that is removed after the first event is received. See OnCertificateError.
+*/
+func (protocol *SecurityProtocol) OnCertificateErrorOnce(
+	callback func(event *security.CertificateErrorEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Security.certificateError",
 		func(response *Response) {
@@ -159,7 +312,7 @@ func (protocol *SecurityProtocol) OnCertificateError(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -170,7 +323,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-securityS
 */
 func (protocol *SecurityProtocol) OnSecurityStateChanged(
 	callback func(event *security.StateChangedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Security.securityStateChanged",
+		func(response *Response) {
+			event := &security.StateChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+SecurityStateChangedEvents returns a channel that receives each
+Security.securityStateChanged event. The channel is closed when the context is
+done or the socket stops listening. See OnSecurityStateChanged.
+*/
+func (protocol *SecurityProtocol) SecurityStateChangedEvents(
+	ctx context.Context,
+) <-chan *security.StateChangedEvent {
+	eventChan := make(chan *security.StateChangedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Security.securityStateChanged")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &security.StateChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForSecurityStateChanged subscribes to the Security.securityStateChanged
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *SecurityProtocol) WaitForSecurityStateChanged(
+	ctx context.Context,
+	predicate func(event *security.StateChangedEvent) bool,
+) <-chan *security.StateChangedEvent {
+	eventChan := make(chan *security.StateChangedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Security.securityStateChanged",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &security.StateChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &security.StateChangedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnSecurityStateChangedOnce adds a handler to the Security.securityStateChanged
+event that is removed after the first event is received. See
+OnSecurityStateChanged.
+*/
+func (protocol *SecurityProtocol) OnSecurityStateChangedOnce(
+	callback func(event *security.StateChangedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Security.securityStateChanged",
 		func(response *Response) {
@@ -182,5 +427,5 @@ func (protocol *SecurityProtocol) OnSecurityStateChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
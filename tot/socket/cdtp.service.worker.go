@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/service/worker"
@@ -25,14 +26,27 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) DeliverPushMessage(
 	params *worker.DeliverPushMessageParams,
+) <-chan *worker.DeliverPushMessageResult {
+	return protocol.DeliverPushMessageContext(context.Background(), params)
+}
+
+/*
+DeliverPushMessageContext is the context.Context-aware version of
+DeliverPushMessage.
+*/
+func (protocol *ServiceWorkerProtocol) DeliverPushMessageContext(
+	ctx context.Context,
+	params *worker.DeliverPushMessageParams,
 ) <-chan *worker.DeliverPushMessageResult {
 	resultChan := make(chan *worker.DeliverPushMessageResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.deliverPushMessage", params)
 	result := &worker.DeliverPushMessageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -49,13 +63,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dis
 EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) Disable() <-chan *worker.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext is the context.Context-aware version of Disable.
+*/
+func (protocol *ServiceWorkerProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *worker.DisableResult {
 	resultChan := make(chan *worker.DisableResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.disable", nil)
 	result := &worker.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -73,14 +98,27 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) DispatchSyncEvent(
 	params *worker.DispatchSyncEventParams,
+) <-chan *worker.DispatchSyncEventResult {
+	return protocol.DispatchSyncEventContext(context.Background(), params)
+}
+
+/*
+DispatchSyncEventContext is the context.Context-aware version of
+DispatchSyncEvent.
+*/
+func (protocol *ServiceWorkerProtocol) DispatchSyncEventContext(
+	ctx context.Context,
+	params *worker.DispatchSyncEventParams,
 ) <-chan *worker.DispatchSyncEventResult {
 	resultChan := make(chan *worker.DispatchSyncEventResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.dispatchSyncEvent", params)
 	result := &worker.DispatchSyncEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -97,13 +135,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-ena
 EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) Enable() <-chan *worker.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext is the context.Context-aware version of Enable.
+*/
+func (protocol *ServiceWorkerProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *worker.EnableResult {
 	resultChan := make(chan *worker.EnableResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.enable", nil)
 	result := &worker.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -121,14 +170,26 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) InspectWorker(
 	params *worker.InspectWorkerParams,
+) <-chan *worker.InspectWorkerResult {
+	return protocol.InspectWorkerContext(context.Background(), params)
+}
+
+/*
+InspectWorkerContext is the context.Context-aware version of InspectWorker.
+*/
+func (protocol *ServiceWorkerProtocol) InspectWorkerContext(
+	ctx context.Context,
+	params *worker.InspectWorkerParams,
 ) <-chan *worker.InspectWorkerResult {
 	resultChan := make(chan *worker.InspectWorkerResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.inspectWorker", params)
 	result := &worker.InspectWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -146,14 +207,27 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) SetForceUpdateOnPageLoad(
 	params *worker.SetForceUpdateOnPageLoadParams,
+) <-chan *worker.SetForceUpdateOnPageLoadResult {
+	return protocol.SetForceUpdateOnPageLoadContext(context.Background(), params)
+}
+
+/*
+SetForceUpdateOnPageLoadContext is the context.Context-aware version of
+SetForceUpdateOnPageLoad.
+*/
+func (protocol *ServiceWorkerProtocol) SetForceUpdateOnPageLoadContext(
+	ctx context.Context,
+	params *worker.SetForceUpdateOnPageLoadParams,
 ) <-chan *worker.SetForceUpdateOnPageLoadResult {
 	resultChan := make(chan *worker.SetForceUpdateOnPageLoadResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.setForceUpdateOnPageLoad", params)
 	result := &worker.SetForceUpdateOnPageLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -171,14 +245,26 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) SkipWaiting(
 	params *worker.SkipWaitingParams,
+) <-chan *worker.SkipWaitingResult {
+	return protocol.SkipWaitingContext(context.Background(), params)
+}
+
+/*
+SkipWaitingContext is the context.Context-aware version of SkipWaiting.
+*/
+func (protocol *ServiceWorkerProtocol) SkipWaitingContext(
+	ctx context.Context,
+	params *worker.SkipWaitingParams,
 ) <-chan *worker.SkipWaitingResult {
 	resultChan := make(chan *worker.SkipWaitingResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.skipWaiting", params)
 	result := &worker.SkipWaitingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -196,14 +282,26 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) StartWorker(
 	params *worker.StartWorkerParams,
+) <-chan *worker.StartWorkerResult {
+	return protocol.StartWorkerContext(context.Background(), params)
+}
+
+/*
+StartWorkerContext is the context.Context-aware version of StartWorker.
+*/
+func (protocol *ServiceWorkerProtocol) StartWorkerContext(
+	ctx context.Context,
+	params *worker.StartWorkerParams,
 ) <-chan *worker.StartWorkerResult {
 	resultChan := make(chan *worker.StartWorkerResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.startWorker", params)
 	result := &worker.StartWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -220,13 +318,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-sto
 EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) StopAllWorkers() <-chan *worker.StopAllWorkersResult {
+	return protocol.StopAllWorkersContext(context.Background())
+}
+
+/*
+StopAllWorkersContext is the context.Context-aware version of StopAllWorkers.
+*/
+func (protocol *ServiceWorkerProtocol) StopAllWorkersContext(
+	ctx context.Context,
+) <-chan *worker.StopAllWorkersResult {
 	resultChan := make(chan *worker.StopAllWorkersResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.stopAllWorkers", nil)
 	result := &worker.StopAllWorkersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -244,14 +353,26 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) StopWorker(
 	params *worker.StopWorkerParams,
+) <-chan *worker.StopWorkerResult {
+	return protocol.StopWorkerContext(context.Background(), params)
+}
+
+/*
+StopWorkerContext is the context.Context-aware version of StopWorker.
+*/
+func (protocol *ServiceWorkerProtocol) StopWorkerContext(
+	ctx context.Context,
+	params *worker.StopWorkerParams,
 ) <-chan *worker.StopWorkerResult {
 	resultChan := make(chan *worker.StopWorkerResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.stopWorker", params)
 	result := &worker.StopWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -269,14 +390,26 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) Unregister(
 	params *worker.UnregisterParams,
+) <-chan *worker.UnregisterResult {
+	return protocol.UnregisterContext(context.Background(), params)
+}
+
+/*
+UnregisterContext is the context.Context-aware version of Unregister.
+*/
+func (protocol *ServiceWorkerProtocol) UnregisterContext(
+	ctx context.Context,
+	params *worker.UnregisterParams,
 ) <-chan *worker.UnregisterResult {
 	resultChan := make(chan *worker.UnregisterResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.unregister", params)
 	result := &worker.UnregisterResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -294,14 +427,27 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) UpdateRegistration(
 	params *worker.UpdateRegistrationParams,
+) <-chan *worker.UpdateRegistrationResult {
+	return protocol.UpdateRegistrationContext(context.Background(), params)
+}
+
+/*
+UpdateRegistrationContext is the context.Context-aware version of
+UpdateRegistration.
+*/
+func (protocol *ServiceWorkerProtocol) UpdateRegistrationContext(
+	ctx context.Context,
+	params *worker.UpdateRegistrationParams,
 ) <-chan *worker.UpdateRegistrationResult {
 	resultChan := make(chan *worker.UpdateRegistrationResult)
 	command := NewCommand(protocol.Socket, "ServiceWorker.updateRegistration", params)
 	result := &worker.UpdateRegistrationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -319,7 +465,99 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerErrorReported(
 	callback func(event *worker.ErrorReportedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"ServiceWorker.workerErrorReported",
+		func(response *Response) {
+			event := &worker.ErrorReportedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WorkerErrorReportedEvents returns a channel that receives each
+ServiceWorker.workerErrorReported event. The channel is closed when the context
+is done or the socket stops listening. See OnWorkerErrorReported.
+*/
+func (protocol *ServiceWorkerProtocol) WorkerErrorReportedEvents(
+	ctx context.Context,
+) <-chan *worker.ErrorReportedEvent {
+	eventChan := make(chan *worker.ErrorReportedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "ServiceWorker.workerErrorReported")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &worker.ErrorReportedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWorkerErrorReported subscribes to the ServiceWorker.workerErrorReported
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *ServiceWorkerProtocol) WaitForWorkerErrorReported(
+	ctx context.Context,
+	predicate func(event *worker.ErrorReportedEvent) bool,
+) <-chan *worker.ErrorReportedEvent {
+	eventChan := make(chan *worker.ErrorReportedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"ServiceWorker.workerErrorReported",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &worker.ErrorReportedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &worker.ErrorReportedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWorkerErrorReportedOnce adds a handler to the
+ServiceWorker.workerErrorReported event that is removed after the first event is
+received. See OnWorkerErrorReported.
+*/
+func (protocol *ServiceWorkerProtocol) OnWorkerErrorReportedOnce(
+	callback func(event *worker.ErrorReportedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"ServiceWorker.workerErrorReported",
 		func(response *Response) {
@@ -331,7 +569,7 @@ func (protocol *ServiceWorkerProtocol) OnWorkerErrorReported(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -342,7 +580,99 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdated(
 	callback func(event *worker.RegistrationUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"ServiceWorker.workerRegistrationUpdated",
+		func(response *Response) {
+			event := &worker.RegistrationUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WorkerRegistrationUpdatedEvents returns a channel that receives each
+ServiceWorker.workerRegistrationUpdated event. The channel is closed when the
+context is done or the socket stops listening. See OnWorkerRegistrationUpdated.
+*/
+func (protocol *ServiceWorkerProtocol) WorkerRegistrationUpdatedEvents(
+	ctx context.Context,
+) <-chan *worker.RegistrationUpdatedEvent {
+	eventChan := make(chan *worker.RegistrationUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "ServiceWorker.workerRegistrationUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &worker.RegistrationUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWorkerRegistrationUpdated subscribes to the
+ServiceWorker.workerRegistrationUpdated event and returns a channel that
+receives the first event for which the predicate returns true. A nil predicate
+matches any event. If the context is done or the socket stops listening first,
+an event containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *ServiceWorkerProtocol) WaitForWorkerRegistrationUpdated(
+	ctx context.Context,
+	predicate func(event *worker.RegistrationUpdatedEvent) bool,
+) <-chan *worker.RegistrationUpdatedEvent {
+	eventChan := make(chan *worker.RegistrationUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"ServiceWorker.workerRegistrationUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &worker.RegistrationUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &worker.RegistrationUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWorkerRegistrationUpdatedOnce adds a handler to the
+ServiceWorker.workerRegistrationUpdated event that is removed after the first
+event is received. See OnWorkerRegistrationUpdated.
+*/
+func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdatedOnce(
+	callback func(event *worker.RegistrationUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"ServiceWorker.workerRegistrationUpdated",
 		func(response *Response) {
@@ -354,7 +684,7 @@ func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -365,7 +695,99 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdated(
 	callback func(event *worker.VersionUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"ServiceWorker.workerVersionUpdated",
+		func(response *Response) {
+			event := &worker.VersionUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+WorkerVersionUpdatedEvents returns a channel that receives each
+ServiceWorker.workerVersionUpdated event. The channel is closed when the context
+is done or the socket stops listening. See OnWorkerVersionUpdated.
+*/
+func (protocol *ServiceWorkerProtocol) WorkerVersionUpdatedEvents(
+	ctx context.Context,
+) <-chan *worker.VersionUpdatedEvent {
+	eventChan := make(chan *worker.VersionUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "ServiceWorker.workerVersionUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &worker.VersionUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForWorkerVersionUpdated subscribes to the ServiceWorker.workerVersionUpdated
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *ServiceWorkerProtocol) WaitForWorkerVersionUpdated(
+	ctx context.Context,
+	predicate func(event *worker.VersionUpdatedEvent) bool,
+) <-chan *worker.VersionUpdatedEvent {
+	eventChan := make(chan *worker.VersionUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"ServiceWorker.workerVersionUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &worker.VersionUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &worker.VersionUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnWorkerVersionUpdatedOnce adds a handler to the
+ServiceWorker.workerVersionUpdated event that is removed after the first event
+is received. See OnWorkerVersionUpdated.
+*/
+func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdatedOnce(
+	callback func(event *worker.VersionUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"ServiceWorker.workerVersionUpdated",
 		func(response *Response) {
@@ -377,5 +799,5 @@ func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
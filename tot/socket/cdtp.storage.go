@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/storage"
@@ -22,14 +23,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearData
 */
 func (protocol *StorageProtocol) ClearDataForOrigin(
 	params *storage.ClearDataForOriginParams,
+) <-chan *storage.ClearDataForOriginResult {
+	return protocol.ClearDataForOriginContext(context.Background(), params)
+}
+
+/*
+ClearDataForOriginContext is the context.Context-aware version of
+ClearDataForOrigin.
+*/
+func (protocol *StorageProtocol) ClearDataForOriginContext(
+	ctx context.Context,
+	params *storage.ClearDataForOriginParams,
 ) <-chan *storage.ClearDataForOriginResult {
 	resultChan := make(chan *storage.ClearDataForOriginResult)
 	command := NewCommand(protocol.Socket, "Storage.clearDataForOrigin", params)
 	result := &storage.ClearDataForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -46,14 +60,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getUsageA
 */
 func (protocol *StorageProtocol) GetUsageAndQuota(
 	params *storage.GetUsageAndQuotaParams,
+) <-chan *storage.GetUsageAndQuotaResult {
+	return protocol.GetUsageAndQuotaContext(context.Background(), params)
+}
+
+/*
+GetUsageAndQuotaContext is the context.Context-aware version of
+GetUsageAndQuota.
+*/
+func (protocol *StorageProtocol) GetUsageAndQuotaContext(
+	ctx context.Context,
+	params *storage.GetUsageAndQuotaParams,
 ) <-chan *storage.GetUsageAndQuotaResult {
 	resultChan := make(chan *storage.GetUsageAndQuotaResult)
 	command := NewCommand(protocol.Socket, "storage.getUsageAndQuota", params)
 	result := &storage.GetUsageAndQuotaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -73,14 +100,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCach
 */
 func (protocol *StorageProtocol) TrackCacheStorageForOrigin(
 	params *storage.TrackCacheStorageForOriginParams,
+) <-chan *storage.TrackCacheStorageForOriginResult {
+	return protocol.TrackCacheStorageForOriginContext(context.Background(), params)
+}
+
+/*
+TrackCacheStorageForOriginContext is the context.Context-aware version of
+TrackCacheStorageForOrigin.
+*/
+func (protocol *StorageProtocol) TrackCacheStorageForOriginContext(
+	ctx context.Context,
+	params *storage.TrackCacheStorageForOriginParams,
 ) <-chan *storage.TrackCacheStorageForOriginResult {
 	resultChan := make(chan *storage.TrackCacheStorageForOriginResult)
 	command := NewCommand(protocol.Socket, "Storage.trackCacheStorageForOrigin", params)
 	result := &storage.TrackCacheStorageForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -98,14 +138,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackInde
 */
 func (protocol *StorageProtocol) TrackIndexedDBForOrigin(
 	params *storage.TrackIndexedDBForOriginParams,
+) <-chan *storage.TrackIndexedDBForOriginResult {
+	return protocol.TrackIndexedDBForOriginContext(context.Background(), params)
+}
+
+/*
+TrackIndexedDBForOriginContext is the context.Context-aware version of
+TrackIndexedDBForOrigin.
+*/
+func (protocol *StorageProtocol) TrackIndexedDBForOriginContext(
+	ctx context.Context,
+	params *storage.TrackIndexedDBForOriginParams,
 ) <-chan *storage.TrackIndexedDBForOriginResult {
 	resultChan := make(chan *storage.TrackIndexedDBForOriginResult)
 	command := NewCommand(protocol.Socket, "Storage.trackIndexedDBForOrigin", params)
 	result := &storage.TrackIndexedDBForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -123,14 +176,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCa
 */
 func (protocol *StorageProtocol) UntrackCacheStorageForOrigin(
 	params *storage.UntrackCacheStorageForOriginParams,
+) <-chan *storage.UntrackCacheStorageForOriginResult {
+	return protocol.UntrackCacheStorageForOriginContext(context.Background(), params)
+}
+
+/*
+UntrackCacheStorageForOriginContext is the context.Context-aware version of
+UntrackCacheStorageForOrigin.
+*/
+func (protocol *StorageProtocol) UntrackCacheStorageForOriginContext(
+	ctx context.Context,
+	params *storage.UntrackCacheStorageForOriginParams,
 ) <-chan *storage.UntrackCacheStorageForOriginResult {
 	resultChan := make(chan *storage.UntrackCacheStorageForOriginResult)
 	command := NewCommand(protocol.Socket, "Storage.untrackCacheStorageForOrigin", params)
 	result := &storage.UntrackCacheStorageForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -148,14 +214,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIn
 */
 func (protocol *StorageProtocol) UntrackIndexedDBForOrigin(
 	params *storage.UntrackIndexedDBForOriginParams,
+) <-chan *storage.UntrackIndexedDBForOriginResult {
+	return protocol.UntrackIndexedDBForOriginContext(context.Background(), params)
+}
+
+/*
+UntrackIndexedDBForOriginContext is the context.Context-aware version of
+UntrackIndexedDBForOrigin.
+*/
+func (protocol *StorageProtocol) UntrackIndexedDBForOriginContext(
+	ctx context.Context,
+	params *storage.UntrackIndexedDBForOriginParams,
 ) <-chan *storage.UntrackIndexedDBForOriginResult {
 	resultChan := make(chan *storage.UntrackIndexedDBForOriginResult)
 	command := NewCommand(protocol.Socket, "Storage.untrackIndexedDBForOrigin", params)
 	result := &storage.UntrackIndexedDBForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -174,7 +253,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStora
 */
 func (protocol *StorageProtocol) OnCacheStorageContentUpdated(
 	callback func(event *storage.CacheStorageContentUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Storage.cacheStorageContentUpdated",
+		func(response *Response) {
+			event := &storage.CacheStorageContentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+CacheStorageContentUpdatedEvents returns a channel that receives each
+Storage.cacheStorageContentUpdated event. The channel is closed when the context
+is done or the socket stops listening. See OnCacheStorageContentUpdated.
+*/
+func (protocol *StorageProtocol) CacheStorageContentUpdatedEvents(
+	ctx context.Context,
+) <-chan *storage.CacheStorageContentUpdatedEvent {
+	eventChan := make(chan *storage.CacheStorageContentUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Storage.cacheStorageContentUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.CacheStorageContentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForCacheStorageContentUpdated subscribes to the
+Storage.cacheStorageContentUpdated event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *StorageProtocol) WaitForCacheStorageContentUpdated(
+	ctx context.Context,
+	predicate func(event *storage.CacheStorageContentUpdatedEvent) bool,
+) <-chan *storage.CacheStorageContentUpdatedEvent {
+	eventChan := make(chan *storage.CacheStorageContentUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Storage.cacheStorageContentUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.CacheStorageContentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.CacheStorageContentUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnCacheStorageContentUpdatedOnce adds a handler to the
+Storage.cacheStorageContentUpdated event that is removed after the first event
+is received. See OnCacheStorageContentUpdated.
+*/
+func (protocol *StorageProtocol) OnCacheStorageContentUpdatedOnce(
+	callback func(event *storage.CacheStorageContentUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.cacheStorageContentUpdated",
 		func(response *Response) {
@@ -186,7 +357,7 @@ func (protocol *StorageProtocol) OnCacheStorageContentUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -197,7 +368,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStora
 */
 func (protocol *StorageProtocol) OnCacheStorageListUpdated(
 	callback func(event *storage.CacheStorageListUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Storage.cacheStorageListUpdated",
+		func(response *Response) {
+			event := &storage.CacheStorageListUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+CacheStorageListUpdatedEvents returns a channel that receives each
+Storage.cacheStorageListUpdated event. The channel is closed when the context is
+done or the socket stops listening. See OnCacheStorageListUpdated.
+*/
+func (protocol *StorageProtocol) CacheStorageListUpdatedEvents(
+	ctx context.Context,
+) <-chan *storage.CacheStorageListUpdatedEvent {
+	eventChan := make(chan *storage.CacheStorageListUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Storage.cacheStorageListUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.CacheStorageListUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForCacheStorageListUpdated subscribes to the Storage.cacheStorageListUpdated
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *StorageProtocol) WaitForCacheStorageListUpdated(
+	ctx context.Context,
+	predicate func(event *storage.CacheStorageListUpdatedEvent) bool,
+) <-chan *storage.CacheStorageListUpdatedEvent {
+	eventChan := make(chan *storage.CacheStorageListUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Storage.cacheStorageListUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.CacheStorageListUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.CacheStorageListUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnCacheStorageListUpdatedOnce adds a handler to the
+Storage.cacheStorageListUpdated event that is removed after the first event is
+received. See OnCacheStorageListUpdated.
+*/
+func (protocol *StorageProtocol) OnCacheStorageListUpdatedOnce(
+	callback func(event *storage.CacheStorageListUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.cacheStorageListUpdated",
 		func(response *Response) {
@@ -209,7 +472,7 @@ func (protocol *StorageProtocol) OnCacheStorageListUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -221,7 +484,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBC
 */
 func (protocol *StorageProtocol) OnIndexedDBContentUpdated(
 	callback func(event *storage.IndexedDBContentUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Storage.indexedDBContentUpdated",
+		func(response *Response) {
+			event := &storage.IndexedDBContentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+IndexedDBContentUpdatedEvents returns a channel that receives each
+Storage.indexedDBContentUpdated event. The channel is closed when the context is
+done or the socket stops listening. See OnIndexedDBContentUpdated.
+*/
+func (protocol *StorageProtocol) IndexedDBContentUpdatedEvents(
+	ctx context.Context,
+) <-chan *storage.IndexedDBContentUpdatedEvent {
+	eventChan := make(chan *storage.IndexedDBContentUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Storage.indexedDBContentUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.IndexedDBContentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForIndexedDBContentUpdated subscribes to the Storage.indexedDBContentUpdated
+event and returns a channel that receives the first event for which the
+predicate returns true. A nil predicate matches any event. If the context is
+done or the socket stops listening first, an event containing an error is
+delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *StorageProtocol) WaitForIndexedDBContentUpdated(
+	ctx context.Context,
+	predicate func(event *storage.IndexedDBContentUpdatedEvent) bool,
+) <-chan *storage.IndexedDBContentUpdatedEvent {
+	eventChan := make(chan *storage.IndexedDBContentUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Storage.indexedDBContentUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.IndexedDBContentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.IndexedDBContentUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnIndexedDBContentUpdatedOnce adds a handler to the
+Storage.indexedDBContentUpdated event that is removed after the first event is
+received. See OnIndexedDBContentUpdated.
+*/
+func (protocol *StorageProtocol) OnIndexedDBContentUpdatedOnce(
+	callback func(event *storage.IndexedDBContentUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.indexedDBContentUpdated",
 		func(response *Response) {
@@ -233,7 +588,7 @@ func (protocol *StorageProtocol) OnIndexedDBContentUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -245,7 +600,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBL
 */
 func (protocol *StorageProtocol) OnIndexedDBListUpdated(
 	callback func(event *storage.IndexedDBListUpdatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Storage.indexedDBListUpdated",
+		func(response *Response) {
+			event := &storage.IndexedDBListUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+IndexedDBListUpdatedEvents returns a channel that receives each
+Storage.indexedDBListUpdated event. The channel is closed when the context is
+done or the socket stops listening. See OnIndexedDBListUpdated.
+*/
+func (protocol *StorageProtocol) IndexedDBListUpdatedEvents(
+	ctx context.Context,
+) <-chan *storage.IndexedDBListUpdatedEvent {
+	eventChan := make(chan *storage.IndexedDBListUpdatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Storage.indexedDBListUpdated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &storage.IndexedDBListUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForIndexedDBListUpdated subscribes to the Storage.indexedDBListUpdated event
+and returns a channel that receives the first event for which the predicate
+returns true. A nil predicate matches any event. If the context is done or the
+socket stops listening first, an event containing an error is delivered instead.
+See Socketer.WaitForEvent.
+*/
+func (protocol *StorageProtocol) WaitForIndexedDBListUpdated(
+	ctx context.Context,
+	predicate func(event *storage.IndexedDBListUpdatedEvent) bool,
+) <-chan *storage.IndexedDBListUpdatedEvent {
+	eventChan := make(chan *storage.IndexedDBListUpdatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Storage.indexedDBListUpdated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &storage.IndexedDBListUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &storage.IndexedDBListUpdatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnIndexedDBListUpdatedOnce adds a handler to the Storage.indexedDBListUpdated
+event that is removed after the first event is received. See
+OnIndexedDBListUpdated.
+*/
+func (protocol *StorageProtocol) OnIndexedDBListUpdatedOnce(
+	callback func(event *storage.IndexedDBListUpdatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.indexedDBListUpdated",
 		func(response *Response) {
@@ -257,5 +704,5 @@ func (protocol *StorageProtocol) OnIndexedDBListUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/system/info"
@@ -23,13 +24,24 @@ GetInfo returns information about the system.
 https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getInfo
 */
 func (protocol *SystemInfoProtocol) GetInfo() <-chan *info.GetInfoResult {
+	return protocol.GetInfoContext(context.Background())
+}
+
+/*
+GetInfoContext is the context.Context-aware version of GetInfo.
+*/
+func (protocol *SystemInfoProtocol) GetInfoContext(
+	ctx context.Context,
+) <-chan *info.GetInfoResult {
 	resultChan := make(chan *info.GetInfoResult)
 	command := NewCommand(protocol.Socket, "SystemInfo.getInfo", nil)
 	result := &info.GetInfoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
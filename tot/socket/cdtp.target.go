@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/target"
@@ -23,14 +24,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-activateTa
 */
 func (protocol *TargetProtocol) ActivateTarget(
 	params *target.ActivateTargetParams,
+) <-chan *target.ActivateTargetResult {
+	return protocol.ActivateTargetContext(context.Background(), params)
+}
+
+/*
+ActivateTargetContext is the context.Context-aware version of ActivateTarget.
+*/
+func (protocol *TargetProtocol) ActivateTargetContext(
+	ctx context.Context,
+	params *target.ActivateTargetParams,
 ) <-chan *target.ActivateTargetResult {
 	resultChan := make(chan *target.ActivateTargetResult)
 	command := NewCommand(protocol.Socket, "Target.activateTarget", params)
 	result := &target.ActivateTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,14 +60,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToTa
 */
 func (protocol *TargetProtocol) AttachToTarget(
 	params *target.AttachToTargetParams,
+) <-chan *target.AttachToTargetResult {
+	return protocol.AttachToTargetContext(context.Background(), params)
+}
+
+/*
+AttachToTargetContext is the context.Context-aware version of AttachToTarget.
+*/
+func (protocol *TargetProtocol) AttachToTargetContext(
+	ctx context.Context,
+	params *target.AttachToTargetParams,
 ) <-chan *target.AttachToTargetResult {
 	resultChan := make(chan *target.AttachToTargetResult)
 	command := NewCommand(protocol.Socket, "Target.attachToTarget", params)
 	result := &target.AttachToTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -73,14 +98,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-closeTarge
 */
 func (protocol *TargetProtocol) CloseTarget(
 	params *target.CloseTargetParams,
+) <-chan *target.CloseTargetResult {
+	return protocol.CloseTargetContext(context.Background(), params)
+}
+
+/*
+CloseTargetContext is the context.Context-aware version of CloseTarget.
+*/
+func (protocol *TargetProtocol) CloseTargetContext(
+	ctx context.Context,
+	params *target.CloseTargetParams,
 ) <-chan *target.CloseTargetResult {
 	resultChan := make(chan *target.CloseTargetResult)
 	command := NewCommand(protocol.Socket, "Target.closeTarget", params)
 	result := &target.CloseTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -100,13 +137,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createBrow
 EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) CreateBrowserContext() <-chan *target.CreateBrowserContextResult {
+	return protocol.CreateBrowserContextContext(context.Background())
+}
+
+/*
+CreateBrowserContextContext is the context.Context-aware version of
+CreateBrowserContext.
+*/
+func (protocol *TargetProtocol) CreateBrowserContextContext(
+	ctx context.Context,
+) <-chan *target.CreateBrowserContextResult {
 	resultChan := make(chan *target.CreateBrowserContextResult)
 	command := NewCommand(protocol.Socket, "Target.createBrowserContext", nil)
 	result := &target.CreateBrowserContextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -125,14 +174,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createTarg
 */
 func (protocol *TargetProtocol) CreateTarget(
 	params *target.CreateTargetParams,
+) <-chan *target.CreateTargetResult {
+	return protocol.CreateTargetContext(context.Background(), params)
+}
+
+/*
+CreateTargetContext is the context.Context-aware version of CreateTarget.
+*/
+func (protocol *TargetProtocol) CreateTargetContext(
+	ctx context.Context,
+	params *target.CreateTargetParams,
 ) <-chan *target.CreateTargetResult {
 	resultChan := make(chan *target.CreateTargetResult)
 	command := NewCommand(protocol.Socket, "Target.createTarget", params)
 	result := &target.CreateTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -151,14 +212,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-detachFrom
 */
 func (protocol *TargetProtocol) DetachFromTarget(
 	params *target.DetachFromTargetParams,
+) <-chan *target.DetachFromTargetResult {
+	return protocol.DetachFromTargetContext(context.Background(), params)
+}
+
+/*
+DetachFromTargetContext is the context.Context-aware version of
+DetachFromTarget.
+*/
+func (protocol *TargetProtocol) DetachFromTargetContext(
+	ctx context.Context,
+	params *target.DetachFromTargetParams,
 ) <-chan *target.DetachFromTargetResult {
 	resultChan := make(chan *target.DetachFromTargetResult)
 	command := NewCommand(protocol.Socket, "Target.detachFromTarget", params)
 	result := &target.DetachFromTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -177,14 +251,27 @@ EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) DisposeBrowserContext(
 	params *target.DisposeBrowserContextParams,
+) <-chan *target.DisposeBrowserContextResult {
+	return protocol.DisposeBrowserContextContext(context.Background(), params)
+}
+
+/*
+DisposeBrowserContextContext is the context.Context-aware version of
+DisposeBrowserContext.
+*/
+func (protocol *TargetProtocol) DisposeBrowserContextContext(
+	ctx context.Context,
+	params *target.DisposeBrowserContextParams,
 ) <-chan *target.DisposeBrowserContextResult {
 	resultChan := make(chan *target.DisposeBrowserContextResult)
 	command := NewCommand(protocol.Socket, "Target.disposeBrowserContext", params)
 	result := &target.DisposeBrowserContextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -204,14 +291,26 @@ EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) GetTargetInfo(
 	params *target.GetTargetInfoParams,
+) <-chan *target.GetTargetInfoResult {
+	return protocol.GetTargetInfoContext(context.Background(), params)
+}
+
+/*
+GetTargetInfoContext is the context.Context-aware version of GetTargetInfo.
+*/
+func (protocol *TargetProtocol) GetTargetInfoContext(
+	ctx context.Context,
+	params *target.GetTargetInfoParams,
 ) <-chan *target.GetTargetInfoResult {
 	resultChan := make(chan *target.GetTargetInfoResult)
 	command := NewCommand(protocol.Socket, "Target.getTargetInfo", params)
 	result := &target.GetTargetInfoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -230,15 +329,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargets
 */
 func (protocol *TargetProtocol) GetTargets(
 	params *target.GetTargetsParams,
+) <-chan *target.GetTargetsResult {
+	return protocol.GetTargetsContext(context.Background(), params)
+}
+
+/*
+GetTargetsContext is the context.Context-aware version of GetTargets.
+*/
+func (protocol *TargetProtocol) GetTargetsContext(
+	ctx context.Context,
+	params *target.GetTargetsParams,
 ) <-chan *target.GetTargetsResult {
 	resultChan := make(chan *target.GetTargetsResult)
 	command := NewCommand(protocol.Socket, "Target.getTargets", params)
 	result := &target.GetTargetsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -254,14 +367,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-sendMessag
 */
 func (protocol *TargetProtocol) SendMessageToTarget(
 	params *target.SendMessageToTargetParams,
+) <-chan *target.SendMessageToTargetResult {
+	return protocol.SendMessageToTargetContext(context.Background(), params)
+}
+
+/*
+SendMessageToTargetContext is the context.Context-aware version of
+SendMessageToTarget.
+*/
+func (protocol *TargetProtocol) SendMessageToTargetContext(
+	ctx context.Context,
+	params *target.SendMessageToTargetParams,
 ) <-chan *target.SendMessageToTargetResult {
 	resultChan := make(chan *target.SendMessageToTargetResult)
 	command := NewCommand(protocol.Socket, "Target.sendMessageToTarget", params)
 	result := &target.SendMessageToTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -279,14 +405,27 @@ EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) SetAttachToFrames(
 	params *target.SetAttachToFramesParams,
+) <-chan *target.SetAttachToFramesResult {
+	return protocol.SetAttachToFramesContext(context.Background(), params)
+}
+
+/*
+SetAttachToFramesContext is the context.Context-aware version of
+SetAttachToFrames.
+*/
+func (protocol *TargetProtocol) SetAttachToFramesContext(
+	ctx context.Context,
+	params *target.SetAttachToFramesParams,
 ) <-chan *target.SetAttachToFramesResult {
 	resultChan := make(chan *target.SetAttachToFramesResult)
 	command := NewCommand(protocol.Socket, "Target.setAttachToFrames", params)
 	result := &target.SetAttachToFramesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -306,14 +445,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAtt
 */
 func (protocol *TargetProtocol) SetAutoAttach(
 	params *target.SetAutoAttachParams,
+) <-chan *target.SetAutoAttachResult {
+	return protocol.SetAutoAttachContext(context.Background(), params)
+}
+
+/*
+SetAutoAttachContext is the context.Context-aware version of SetAutoAttach.
+*/
+func (protocol *TargetProtocol) SetAutoAttachContext(
+	ctx context.Context,
+	params *target.SetAutoAttachParams,
 ) <-chan *target.SetAutoAttachResult {
 	resultChan := make(chan *target.SetAutoAttachResult)
 	command := NewCommand(protocol.Socket, "Target.setAutoAttach", params)
 	result := &target.SetAutoAttachResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -331,14 +482,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setDiscove
 */
 func (protocol *TargetProtocol) SetDiscoverTargets(
 	params *target.SetDiscoverTargetsParams,
+) <-chan *target.SetDiscoverTargetsResult {
+	return protocol.SetDiscoverTargetsContext(context.Background(), params)
+}
+
+/*
+SetDiscoverTargetsContext is the context.Context-aware version of
+SetDiscoverTargets.
+*/
+func (protocol *TargetProtocol) SetDiscoverTargetsContext(
+	ctx context.Context,
+	params *target.SetDiscoverTargetsParams,
 ) <-chan *target.SetDiscoverTargetsResult {
 	resultChan := make(chan *target.SetDiscoverTargetsResult)
 	command := NewCommand(protocol.Socket, "Target.setDiscoverTargets", params)
 	result := &target.SetDiscoverTargetsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -357,14 +521,27 @@ EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) SetRemoteLocations(
 	params *target.SetRemoteLocationsParams,
+) <-chan *target.SetRemoteLocationsResult {
+	return protocol.SetRemoteLocationsContext(context.Background(), params)
+}
+
+/*
+SetRemoteLocationsContext is the context.Context-aware version of
+SetRemoteLocations.
+*/
+func (protocol *TargetProtocol) SetRemoteLocationsContext(
+	ctx context.Context,
+	params *target.SetRemoteLocationsParams,
 ) <-chan *target.SetRemoteLocationsResult {
 	resultChan := make(chan *target.SetRemoteLocationsResult)
 	command := NewCommand(protocol.Socket, "Target.setRemoteLocations", params)
 	result := &target.SetRemoteLocationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -383,7 +560,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-attachedToT
 */
 func (protocol *TargetProtocol) OnAttachedToTarget(
 	callback func(event *target.AttachedToTargetEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.attachedToTarget",
+		func(response *Response) {
+			event := &target.AttachedToTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AttachedToTargetEvents returns a channel that receives each
+Target.attachedToTarget event. The channel is closed when the context is done or
+the socket stops listening. See OnAttachedToTarget.
+*/
+func (protocol *TargetProtocol) AttachedToTargetEvents(
+	ctx context.Context,
+) <-chan *target.AttachedToTargetEvent {
+	eventChan := make(chan *target.AttachedToTargetEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Target.attachedToTarget")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &target.AttachedToTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAttachedToTarget subscribes to the Target.attachedToTarget event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TargetProtocol) WaitForAttachedToTarget(
+	ctx context.Context,
+	predicate func(event *target.AttachedToTargetEvent) bool,
+) <-chan *target.AttachedToTargetEvent {
+	eventChan := make(chan *target.AttachedToTargetEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Target.attachedToTarget",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &target.AttachedToTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &target.AttachedToTargetEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAttachedToTargetOnce adds a handler to the Target.attachedToTarget event that
+is removed after the first event is received. See OnAttachedToTarget.
+*/
+func (protocol *TargetProtocol) OnAttachedToTargetOnce(
+	callback func(event *target.AttachedToTargetEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.attachedToTarget",
 		func(response *Response) {
@@ -395,7 +663,7 @@ func (protocol *TargetProtocol) OnAttachedToTarget(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -409,7 +677,98 @@ EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) OnDetachedFromTarget(
 	callback func(event *target.DetachedFromTargetEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.detachedFromTarget",
+		func(response *Response) {
+			event := &target.DetachedFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+DetachedFromTargetEvents returns a channel that receives each
+Target.detachedFromTarget event. The channel is closed when the context is done
+or the socket stops listening. See OnDetachedFromTarget.
+*/
+func (protocol *TargetProtocol) DetachedFromTargetEvents(
+	ctx context.Context,
+) <-chan *target.DetachedFromTargetEvent {
+	eventChan := make(chan *target.DetachedFromTargetEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Target.detachedFromTarget")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &target.DetachedFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForDetachedFromTarget subscribes to the Target.detachedFromTarget event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TargetProtocol) WaitForDetachedFromTarget(
+	ctx context.Context,
+	predicate func(event *target.DetachedFromTargetEvent) bool,
+) <-chan *target.DetachedFromTargetEvent {
+	eventChan := make(chan *target.DetachedFromTargetEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Target.detachedFromTarget",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &target.DetachedFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &target.DetachedFromTargetEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnDetachedFromTargetOnce adds a handler to the Target.detachedFromTarget event
+that is removed after the first event is received. See OnDetachedFromTarget.
+*/
+func (protocol *TargetProtocol) OnDetachedFromTargetOnce(
+	callback func(event *target.DetachedFromTargetEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.detachedFromTarget",
 		func(response *Response) {
@@ -421,7 +780,7 @@ func (protocol *TargetProtocol) OnDetachedFromTarget(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -433,7 +792,99 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-receivedMes
 */
 func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
 	callback func(event *target.ReceivedMessageFromTargetEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.receivedMessageFromTarget",
+		func(response *Response) {
+			event := &target.ReceivedMessageFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+ReceivedMessageFromTargetEvents returns a channel that receives each
+Target.receivedMessageFromTarget event. The channel is closed when the context
+is done or the socket stops listening. See OnReceivedMessageFromTarget.
+*/
+func (protocol *TargetProtocol) ReceivedMessageFromTargetEvents(
+	ctx context.Context,
+) <-chan *target.ReceivedMessageFromTargetEvent {
+	eventChan := make(chan *target.ReceivedMessageFromTargetEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Target.receivedMessageFromTarget")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &target.ReceivedMessageFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForReceivedMessageFromTarget subscribes to the
+Target.receivedMessageFromTarget event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. If the context is done or the socket stops listening first, an event
+containing an error is delivered instead. See Socketer.WaitForEvent.
+*/
+func (protocol *TargetProtocol) WaitForReceivedMessageFromTarget(
+	ctx context.Context,
+	predicate func(event *target.ReceivedMessageFromTargetEvent) bool,
+) <-chan *target.ReceivedMessageFromTargetEvent {
+	eventChan := make(chan *target.ReceivedMessageFromTargetEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Target.receivedMessageFromTarget",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &target.ReceivedMessageFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &target.ReceivedMessageFromTargetEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnReceivedMessageFromTargetOnce adds a handler to the
+Target.receivedMessageFromTarget event that is removed after the first event is
+received. See OnReceivedMessageFromTarget.
+*/
+func (protocol *TargetProtocol) OnReceivedMessageFromTargetOnce(
+	callback func(event *target.ReceivedMessageFromTargetEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.receivedMessageFromTarget",
 		func(response *Response) {
@@ -445,7 +896,7 @@ func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -456,7 +907,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetCreat
 */
 func (protocol *TargetProtocol) OnTargetCreated(
 	callback func(event *target.CreatedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.targetCreated",
+		func(response *Response) {
+			event := &target.CreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+TargetCreatedEvents returns a channel that receives each Target.targetCreated
+event. The channel is closed when the context is done or the socket stops
+listening. See OnTargetCreated.
+*/
+func (protocol *TargetProtocol) TargetCreatedEvents(
+	ctx context.Context,
+) <-chan *target.CreatedEvent {
+	eventChan := make(chan *target.CreatedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Target.targetCreated")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &target.CreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForTargetCreated subscribes to the Target.targetCreated event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TargetProtocol) WaitForTargetCreated(
+	ctx context.Context,
+	predicate func(event *target.CreatedEvent) bool,
+) <-chan *target.CreatedEvent {
+	eventChan := make(chan *target.CreatedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Target.targetCreated",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &target.CreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &target.CreatedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnTargetCreatedOnce adds a handler to the Target.targetCreated event that is
+removed after the first event is received. See OnTargetCreated.
+*/
+func (protocol *TargetProtocol) OnTargetCreatedOnce(
+	callback func(event *target.CreatedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.targetCreated",
 		func(response *Response) {
@@ -468,7 +1010,7 @@ func (protocol *TargetProtocol) OnTargetCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -479,7 +1021,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetDestr
 */
 func (protocol *TargetProtocol) OnTargetDestroyed(
 	callback func(event *target.DestroyedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.targetDestroyed",
+		func(response *Response) {
+			event := &target.DestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+TargetDestroyedEvents returns a channel that receives each
+Target.targetDestroyed event. The channel is closed when the context is done or
+the socket stops listening. See OnTargetDestroyed.
+*/
+func (protocol *TargetProtocol) TargetDestroyedEvents(
+	ctx context.Context,
+) <-chan *target.DestroyedEvent {
+	eventChan := make(chan *target.DestroyedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Target.targetDestroyed")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &target.DestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForTargetDestroyed subscribes to the Target.targetDestroyed event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TargetProtocol) WaitForTargetDestroyed(
+	ctx context.Context,
+	predicate func(event *target.DestroyedEvent) bool,
+) <-chan *target.DestroyedEvent {
+	eventChan := make(chan *target.DestroyedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Target.targetDestroyed",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &target.DestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &target.DestroyedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnTargetDestroyedOnce adds a handler to the Target.targetDestroyed event that is
+removed after the first event is received. See OnTargetDestroyed.
+*/
+func (protocol *TargetProtocol) OnTargetDestroyedOnce(
+	callback func(event *target.DestroyedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.targetDestroyed",
 		func(response *Response) {
@@ -491,7 +1124,7 @@ func (protocol *TargetProtocol) OnTargetDestroyed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -503,7 +1136,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetInfoC
 */
 func (protocol *TargetProtocol) OnTargetInfoChanged(
 	callback func(event *target.InfoChangedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.targetInfoChanged",
+		func(response *Response) {
+			event := &target.InfoChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+TargetInfoChangedEvents returns a channel that receives each
+Target.targetInfoChanged event. The channel is closed when the context is done
+or the socket stops listening. See OnTargetInfoChanged.
+*/
+func (protocol *TargetProtocol) TargetInfoChangedEvents(
+	ctx context.Context,
+) <-chan *target.InfoChangedEvent {
+	eventChan := make(chan *target.InfoChangedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Target.targetInfoChanged")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &target.InfoChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForTargetInfoChanged subscribes to the Target.targetInfoChanged event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TargetProtocol) WaitForTargetInfoChanged(
+	ctx context.Context,
+	predicate func(event *target.InfoChangedEvent) bool,
+) <-chan *target.InfoChangedEvent {
+	eventChan := make(chan *target.InfoChangedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Target.targetInfoChanged",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &target.InfoChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &target.InfoChangedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnTargetInfoChangedOnce adds a handler to the Target.targetInfoChanged event
+that is removed after the first event is received. See OnTargetInfoChanged.
+*/
+func (protocol *TargetProtocol) OnTargetInfoChangedOnce(
+	callback func(event *target.InfoChangedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.targetInfoChanged",
 		func(response *Response) {
@@ -515,5 +1239,5 @@ func (protocol *TargetProtocol) OnTargetInfoChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -360,7 +360,7 @@ func TestTargetGetTargets(t *testing.T) {
 		}},
 	}
 	resultChan := mockSocket.Target().GetTargets(params)
-	mockResult := &target.GetTargetsResult{}
+	mockResult := &target.GetTargetsResult{Infos: params.Infos}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID:     mockSocket.CurCommandID(),
@@ -371,6 +371,9 @@ func TestTargetGetTargets(t *testing.T) {
 	if nil != result.Err {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
+	if 1 != len(result.Infos) || "ID" != result.Infos[0].ID {
+		t.Errorf("Expected 1 target, got %v", result.Infos)
+	}
 
 	resultChan = mockSocket.Target().GetTargets(params)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/tethering"
@@ -24,14 +25,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-bind
 */
 func (protocol *TetheringProtocol) Bind(
 	params *tethering.BindParams,
+) <-chan *tethering.BindResult {
+	return protocol.BindContext(context.Background(), params)
+}
+
+/*
+BindContext is the context.Context-aware version of Bind.
+*/
+func (protocol *TetheringProtocol) BindContext(
+	ctx context.Context,
+	params *tethering.BindParams,
 ) <-chan *tethering.BindResult {
 	resultChan := make(chan *tethering.BindResult)
 	command := NewCommand(protocol.Socket, "Tethering.bind", params)
 	result := &tethering.BindResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -48,14 +61,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-unbind
 */
 func (protocol *TetheringProtocol) Unbind(
 	params *tethering.UnbindParams,
+) <-chan *tethering.UnbindResult {
+	return protocol.UnbindContext(context.Background(), params)
+}
+
+/*
+UnbindContext is the context.Context-aware version of Unbind.
+*/
+func (protocol *TetheringProtocol) UnbindContext(
+	ctx context.Context,
+	params *tethering.UnbindParams,
 ) <-chan *tethering.UnbindResult {
 	resultChan := make(chan *tethering.UnbindResult)
 	command := NewCommand(protocol.Socket, "Tethering.unbind", params)
 	result := &tethering.UnbindResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -73,7 +98,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#event-accepted
 */
 func (protocol *TetheringProtocol) OnAccepted(
 	callback func(event *tethering.AcceptedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Tethering.accepted",
+		func(response *Response) {
+			event := &tethering.AcceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+AcceptedEvents returns a channel that receives each Tethering.accepted event.
+The channel is closed when the context is done or the socket stops listening.
+See OnAccepted.
+*/
+func (protocol *TetheringProtocol) AcceptedEvents(
+	ctx context.Context,
+) <-chan *tethering.AcceptedEvent {
+	eventChan := make(chan *tethering.AcceptedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Tethering.accepted")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &tethering.AcceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForAccepted subscribes to the Tethering.accepted event and returns a channel
+that receives the first event for which the predicate returns true. A nil
+predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TetheringProtocol) WaitForAccepted(
+	ctx context.Context,
+	predicate func(event *tethering.AcceptedEvent) bool,
+) <-chan *tethering.AcceptedEvent {
+	eventChan := make(chan *tethering.AcceptedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Tethering.accepted",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &tethering.AcceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &tethering.AcceptedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnAcceptedOnce adds a handler to the Tethering.accepted event that is removed
+after the first event is received. See OnAccepted.
+*/
+func (protocol *TetheringProtocol) OnAcceptedOnce(
+	callback func(event *tethering.AcceptedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Tethering.accepted",
 		func(response *Response) {
@@ -85,5 +201,5 @@ func (protocol *TetheringProtocol) OnAccepted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/tracing"
@@ -21,13 +22,24 @@ End stops trace events collection.
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-end
 */
 func (protocol *TracingProtocol) End() <-chan *tracing.EndResult {
+	return protocol.EndContext(context.Background())
+}
+
+/*
+EndContext is the context.Context-aware version of End.
+*/
+func (protocol *TracingProtocol) EndContext(
+	ctx context.Context,
+) <-chan *tracing.EndResult {
 	resultChan := make(chan *tracing.EndResult)
 	command := NewCommand(protocol.Socket, "Tracing.end", nil)
 	result := &tracing.EndResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,13 +55,24 @@ GetCategories gets supported tracing categories.
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-getCategories
 */
 func (protocol *TracingProtocol) GetCategories() <-chan *tracing.GetCategoriesResult {
+	return protocol.GetCategoriesContext(context.Background())
+}
+
+/*
+GetCategoriesContext is the context.Context-aware version of GetCategories.
+*/
+func (protocol *TracingProtocol) GetCategoriesContext(
+	ctx context.Context,
+) <-chan *tracing.GetCategoriesResult {
 	resultChan := make(chan *tracing.GetCategoriesResult)
 	command := NewCommand(protocol.Socket, "Tracing.getCategories", nil)
 	result := &tracing.GetCategoriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -67,14 +90,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-recordClo
 */
 func (protocol *TracingProtocol) RecordClockSyncMarker(
 	params *tracing.RecordClockSyncMarkerParams,
+) <-chan *tracing.RecordClockSyncMarkerResult {
+	return protocol.RecordClockSyncMarkerContext(context.Background(), params)
+}
+
+/*
+RecordClockSyncMarkerContext is the context.Context-aware version of
+RecordClockSyncMarker.
+*/
+func (protocol *TracingProtocol) RecordClockSyncMarkerContext(
+	ctx context.Context,
+	params *tracing.RecordClockSyncMarkerParams,
 ) <-chan *tracing.RecordClockSyncMarkerResult {
 	resultChan := make(chan *tracing.RecordClockSyncMarkerResult)
 	command := NewCommand(protocol.Socket, "Tracing.recordClockSyncMarker", params)
 	result := &tracing.RecordClockSyncMarkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -90,13 +126,25 @@ RequestMemoryDump requests a global memory dump.
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-requestMemoryDump
 */
 func (protocol *TracingProtocol) RequestMemoryDump() <-chan *tracing.RequestMemoryDumpResult {
+	return protocol.RequestMemoryDumpContext(context.Background())
+}
+
+/*
+RequestMemoryDumpContext is the context.Context-aware version of
+RequestMemoryDump.
+*/
+func (protocol *TracingProtocol) RequestMemoryDumpContext(
+	ctx context.Context,
+) <-chan *tracing.RequestMemoryDumpResult {
 	resultChan := make(chan *tracing.RequestMemoryDumpResult)
 	command := NewCommand(protocol.Socket, "Tracing.requestMemoryDump", nil)
 	result := &tracing.RequestMemoryDumpResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -114,14 +162,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-start
 */
 func (protocol *TracingProtocol) Start(
 	params *tracing.StartParams,
+) <-chan *tracing.StartResult {
+	return protocol.StartContext(context.Background(), params)
+}
+
+/*
+StartContext is the context.Context-aware version of Start.
+*/
+func (protocol *TracingProtocol) StartContext(
+	ctx context.Context,
+	params *tracing.StartParams,
 ) <-chan *tracing.StartResult {
 	resultChan := make(chan *tracing.StartResult)
 	command := NewCommand(protocol.Socket, "Tracing.start", params)
 	result := &tracing.StartResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response := <-protocol.Socket.SendCommandContext(ctx, command)
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -139,7 +199,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-bufferUsag
 */
 func (protocol *TracingProtocol) OnBufferUsage(
 	callback func(event *tracing.BufferUsageEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Tracing.bufferUsage",
 		func(response *Response) {
@@ -151,7 +211,98 @@ func (protocol *TracingProtocol) OnBufferUsage(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+BufferUsageEvents returns a channel that receives each Tracing.bufferUsage
+event. The channel is closed when the context is done or the socket stops
+listening. See OnBufferUsage.
+*/
+func (protocol *TracingProtocol) BufferUsageEvents(
+	ctx context.Context,
+) <-chan *tracing.BufferUsageEvent {
+	eventChan := make(chan *tracing.BufferUsageEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Tracing.bufferUsage")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &tracing.BufferUsageEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForBufferUsage subscribes to the Tracing.bufferUsage event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TracingProtocol) WaitForBufferUsage(
+	ctx context.Context,
+	predicate func(event *tracing.BufferUsageEvent) bool,
+) <-chan *tracing.BufferUsageEvent {
+	eventChan := make(chan *tracing.BufferUsageEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Tracing.bufferUsage",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &tracing.BufferUsageEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &tracing.BufferUsageEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnBufferUsageOnce adds a handler to the Tracing.bufferUsage event that is
+removed after the first event is received. See OnBufferUsage.
+*/
+func (protocol *TracingProtocol) OnBufferUsageOnce(
+	callback func(event *tracing.BufferUsageEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Tracing.bufferUsage",
+		func(response *Response) {
+			event := &tracing.BufferUsageEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -164,7 +315,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-dataCollec
 */
 func (protocol *TracingProtocol) OnDataCollected(
 	callback func(event *tracing.DataCollectedEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Tracing.dataCollected",
+		func(response *Response) {
+			event := &tracing.DataCollectedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+DataCollectedEvents returns a channel that receives each Tracing.dataCollected
+event. The channel is closed when the context is done or the socket stops
+listening. See OnDataCollected.
+*/
+func (protocol *TracingProtocol) DataCollectedEvents(
+	ctx context.Context,
+) <-chan *tracing.DataCollectedEvent {
+	eventChan := make(chan *tracing.DataCollectedEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Tracing.dataCollected")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &tracing.DataCollectedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForDataCollected subscribes to the Tracing.dataCollected event and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TracingProtocol) WaitForDataCollected(
+	ctx context.Context,
+	predicate func(event *tracing.DataCollectedEvent) bool,
+) <-chan *tracing.DataCollectedEvent {
+	eventChan := make(chan *tracing.DataCollectedEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Tracing.dataCollected",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &tracing.DataCollectedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &tracing.DataCollectedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnDataCollectedOnce adds a handler to the Tracing.dataCollected event that is
+removed after the first event is received. See OnDataCollected.
+*/
+func (protocol *TracingProtocol) OnDataCollectedOnce(
+	callback func(event *tracing.DataCollectedEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Tracing.dataCollected",
 		func(response *Response) {
@@ -176,7 +418,7 @@ func (protocol *TracingProtocol) OnDataCollected(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
 
 /*
@@ -188,7 +430,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-tracingCom
 */
 func (protocol *TracingProtocol) OnTracingComplete(
 	callback func(event *tracing.CompleteEvent),
-) {
+) *Subscription {
+	handler := NewEventHandler(
+		"Tracing.tracingComplete",
+		func(response *Response) {
+			event := &tracing.CompleteEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+/*
+TracingCompleteEvents returns a channel that receives each
+Tracing.tracingComplete event. The channel is closed when the context is done or
+the socket stops listening. See OnTracingComplete.
+*/
+func (protocol *TracingProtocol) TracingCompleteEvents(
+	ctx context.Context,
+) <-chan *tracing.CompleteEvent {
+	eventChan := make(chan *tracing.CompleteEvent)
+	stream := NewEventStream(ctx, protocol.Socket, "Tracing.tracingComplete")
+
+	go func() {
+		for response := range stream.Responses() {
+			event := &tracing.CompleteEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			select {
+			case eventChan <- event:
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForTracingComplete subscribes to the Tracing.tracingComplete event and
+returns a channel that receives the first event for which the predicate returns
+true. A nil predicate matches any event. If the context is done or the socket
+stops listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func (protocol *TracingProtocol) WaitForTracingComplete(
+	ctx context.Context,
+	predicate func(event *tracing.CompleteEvent) bool,
+) <-chan *tracing.CompleteEvent {
+	eventChan := make(chan *tracing.CompleteEvent, 1)
+	responseChan := protocol.Socket.WaitForEvent(
+		ctx,
+		"Tracing.tracingComplete",
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			event := &tracing.CompleteEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			return predicate(event)
+		},
+	)
+
+	go func() {
+		response := <-responseChan
+		event := &tracing.CompleteEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		if nil != response.Err {
+			event.Err = response.Err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			event.Err = response.Error
+		}
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+OnTracingCompleteOnce adds a handler to the Tracing.tracingComplete event that
+is removed after the first event is received. See OnTracingComplete.
+*/
+func (protocol *TracingProtocol) OnTracingCompleteOnce(
+	callback func(event *tracing.CompleteEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"Tracing.tracingComplete",
 		func(response *Response) {
@@ -200,5 +533,5 @@ func (protocol *TracingProtocol) OnTracingComplete(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return SubscribeOnce(protocol.Socket, handler)
 }
@@ -0,0 +1,28 @@
+package socket
+
+/*
+Fields holds structured logging fields. The socket adds the "socket",
+"command", "method" and "target" fields where they apply.
+*/
+type Fields map[string]interface{}
+
+/*
+Logger defines the structured logging interface used by the Socket and Chrome
+types. See NewLogrusLogger and NewNopLogger.
+*/
+type Logger interface {
+	// Debugf logs a message at the debug level.
+	Debugf(format string, args ...interface{})
+
+	// Errorf logs a message at the error level.
+	Errorf(format string, args ...interface{})
+
+	// Infof logs a message at the info level.
+	Infof(format string, args ...interface{})
+
+	// Warnf logs a message at the warning level.
+	Warnf(format string, args ...interface{})
+
+	// WithFields returns a Logger that adds the fields to each message.
+	WithFields(fields Fields) Logger
+}
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"net/url"
 )
 
@@ -12,9 +13,24 @@ type Socketer interface {
 	// event.
 	AddEventHandler(handler EventHandler)
 
+	// Batch returns an empty command batch for sending many independent
+	// commands at once.
+	Batch() *Batch
+
+	// Call sends a command for any protocol method, waits for the response
+	// and decodes the result into the provided value.
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+
 	// CurCommandID returns the latest command ID.
 	CurCommandID() int
 
+	// Done returns a channel that is closed when the socket stops listening.
+	Done() <-chan struct{}
+
+	// Err returns the error that ended the debugging session, or nil while
+	// the target is usable.
+	Err() error
+
 	// Listen starts the socket read loop and delivers messages to
 	// HandleCommand() and HandleEvent() as appropriate.
 	Listen()
@@ -29,10 +45,19 @@ type Socketer interface {
 	// SendCommand delivers a command payload to the websocket connection.
 	SendCommand(command Commander) chan *Response
 
+	// SendCommandContext delivers a command payload to the websocket
+	// connection and abandons the command if the context is done before a
+	// response is received.
+	SendCommandContext(ctx context.Context, command Commander) chan *Response
+
 	// Stop signals the socket read loop to stop listening for data and close
 	// the websocket connection.
 	Stop() error
 
 	// URL returns the URL of the websocket connection.
 	URL() *url.URL
+
+	// WaitForEvent subscribes to an event and returns a channel that receives
+	// the first matching event.
+	WaitForEvent(ctx context.Context, method string, predicate func(response *Response) bool) chan *Response
 }
@@ -3,6 +3,7 @@ package socket
 import (
 	"net/url"
 	"sync"
+	"time"
 
 	logfmt "github.com/mkenney/go-log-fmt"
 	log "github.com/sirupsen/logrus"
@@ -17,16 +18,22 @@ func init() {
 /*
 NewMock returns a Chromium Socketer mock for unit testing
 */
-func NewMock(socketURL *url.URL) *Socket {
+func NewMock(socketURL *url.URL, options ...Option) *Socket {
 	socket := &Socket{
+		abandoned:    make(map[int]time.Time),
+		abandonedMux: &sync.Mutex{},
 		commandIDMux: &sync.Mutex{},
 		commands:     NewCommandMap(),
+		failMux:      &sync.Mutex{},
 		handlers:     NewEventHandlerMap(),
 		mux:          &sync.Mutex{},
 		newSocket:    NewMockWebsocket,
+		sessionMux:   &sync.Mutex{},
+		sessions:     make(map[string]*sessionConn),
 		socketID:     NextSocketID(),
 		url:          socketURL,
 	}
+	socket.logger = NewLogrusLogger(log.StandardLogger())
 	log.Debugf("Created socket #%d", socket.socketID)
 
 	socket.accessibility = &AccessibilityProtocol{Socket: socket}
@@ -49,6 +56,7 @@ func NewMock(socketURL *url.URL) *Socket {
 	socket.heapProfiler = &HeapProfilerProtocol{Socket: socket}
 	socket.indexedDB = &IndexedDBProtocol{Socket: socket}
 	socket.input = &InputProtocol{Socket: socket}
+	socket.inspector = &InspectorProtocol{Socket: socket}
 	socket.io = &IOProtocol{Socket: socket}
 	socket.layerTree = &LayerTreeProtocol{Socket: socket}
 	socket.log = &LogProtocol{Socket: socket}
@@ -68,5 +76,10 @@ func NewMock(socketURL *url.URL) *Socket {
 	socket.tethering = &TetheringProtocol{Socket: socket}
 	socket.tracing = &TracingProtocol{Socket: socket}
 
+	for _, option := range options {
+		option(socket)
+	}
+	socket.logger = socket.logger.WithFields(Fields{"socket": socket.socketID})
+
 	return socket
 }
@@ -23,6 +23,7 @@ func NewMockWebsocket(socketURL *url.URL) (WebSocketer, error) {
 
 type MockChromeWebSocket struct {
 	mockResponses []*Response
+	readErr       error
 	sleep         time.Duration
 }
 
@@ -56,6 +57,12 @@ func (socket *MockChromeWebSocket) ReadJSON(v interface{}) error {
 		socket.sleep = 0
 	}
 
+	if nil != socket.readErr {
+		err := socket.readErr
+		socket.readErr = nil
+		return err
+	}
+
 	if len(socket.mockResponses) > 0 {
 		data = socket.mockResponses[0]
 		socket.mockResponses = socket.mockResponses[1:]
@@ -76,6 +83,14 @@ func (socket *MockChromeWebSocket) ReadJSON(v interface{}) error {
 	return nil
 }
 
+/*
+ReadError sets an error to be returned by the next ReadJSON call to replicate
+a broken connection.
+*/
+func (socket *MockChromeWebSocket) ReadError(err error) {
+	socket.readErr = err
+}
+
 /*
 Sleep sets the sleep duration for the next ReadJSON call to replicate
 timeouts and delays.
@@ -0,0 +1,208 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+NewBatch returns an empty command batch for the socket, see Batch.
+*/
+func NewBatch(socket Socketer) *Batch {
+	return &Batch{socket: socket}
+}
+
+/*
+Batch sends many independent commands at once. The payloads are written back
+to back and all results are gathered with a single wait, instead of a
+goroutine and a channel per command:
+
+	batch := socket.Batch()
+	attributes := make([]*dom.GetAttributesResult, len(nodeIDs))
+	for k, nodeID := range nodeIDs {
+		attributes[k] = &dom.GetAttributesResult{}
+		batch.Add("DOM.getAttributes", &dom.GetAttributesParams{NodeID: nodeID}, attributes[k])
+	}
+	err := batch.Send(ctx)
+
+Commands are sent in the order they were added but they are independent, a
+failed command doesn't stop the others. The error of each command is set on
+its BatchItem.
+*/
+type Batch struct {
+	items  []*BatchItem
+	socket Socketer
+}
+
+/*
+BatchItem is a command in a Batch.
+*/
+type BatchItem struct {
+	// Err is the error of the command after the batch has been sent, nil if
+	// it succeeded. Errors are reported the same way as by Socketer.Call.
+	Err error
+
+	// Method is the command method.
+	Method string
+
+	// Params are the command parameters.
+	Params interface{}
+
+	// Result is the raw result of the command after the batch has been sent.
+	Result json.RawMessage
+
+	command *Command
+	result  interface{}
+}
+
+/*
+Add adds a command to the batch. The result of the command is decoded into
+result unless it is nil.
+*/
+func (batch *Batch) Add(method string, params interface{}, result interface{}) *BatchItem {
+	item := &BatchItem{
+		Method: method,
+		Params: params,
+		result: result,
+	}
+	batch.items = append(batch.items, item)
+	return item
+}
+
+/*
+Items returns the commands in the batch.
+*/
+func (batch *Batch) Items() []*BatchItem {
+	return batch.items
+}
+
+/*
+Len returns the number of commands in the batch.
+*/
+func (batch *Batch) Len() int {
+	return len(batch.items)
+}
+
+/*
+Send sends the commands in the batch and waits for all results, or until the
+context is done. It returns a BatchError if any command failed, the error of
+each command is set on its item.
+*/
+func (batch *Batch) Send(ctx context.Context) error {
+	for _, item := range batch.items {
+		item.command = NewCommand(batch.socket, item.Method, item.Params)
+		item.Err = nil
+		item.Result = nil
+	}
+
+	if socket, ok := batch.socket.(*Socket); ok {
+		socket.sendBatch(ctx, batch)
+	} else {
+		responses := make([]chan *Response, len(batch.items))
+		for k, item := range batch.items {
+			responses[k] = batch.socket.SendCommandContext(ctx, item.command)
+		}
+		for k, item := range batch.items {
+			item.respond(<-responses[k])
+		}
+	}
+
+	var failed []*BatchItem
+	for _, item := range batch.items {
+		if nil != item.Err {
+			failed = append(failed, item)
+		}
+	}
+	if len(failed) > 0 {
+		return &BatchError{Failed: failed, Len: len(batch.items)}
+	}
+	return nil
+}
+
+/*
+respond sets the result or error of the item from the command response.
+*/
+func (item *BatchItem) respond(response *Response) {
+	switch {
+	case nil != response.Err:
+		item.Err = response.Err
+	case nil != response.Error && 0 != response.Error.Code:
+		item.Err = response.Error
+	default:
+		item.Result = response.Result
+		if nil != item.result && len(response.Result) > 0 {
+			if err := json.Unmarshal(response.Result, item.result); nil != err {
+				item.Err = errs.Wrap(err, 0, fmt.Sprintf("could not decode %s result", item.Method))
+			}
+		}
+	}
+}
+
+/*
+Batch returns an empty command batch for the socket, see Batch.
+
+Batch is a Socketer implementation.
+*/
+func (socket *Socket) Batch() *Batch {
+	return NewBatch(socket)
+}
+
+/*
+sendBatch stores all commands of the batch, writes their payloads back to back
+and waits for the responses. If the context has no deadline the longest
+default timeout of the batch commands applies, see WithCommandTimeout.
+*/
+func (socket *Socket) sendBatch(ctx context.Context, batch *Batch) {
+	if _, ok := ctx.Deadline(); !ok {
+		var timeout time.Duration
+		for _, item := range batch.items {
+			itemTimeout := socket.commandTimeout(item.Method)
+			if itemTimeout <= 0 {
+				timeout = 0
+				break
+			}
+			if itemTimeout > timeout {
+				timeout = itemTimeout
+			}
+		}
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
+	for _, item := range batch.items {
+		socket.commands.Set(item.command)
+	}
+	socket.logger.Debugf("sending batch of %d commands", len(batch.items))
+	for _, item := range batch.items {
+		if err := ctx.Err(); nil != err {
+			break
+		}
+		payload := &Payload{
+			ID:     item.command.ID(),
+			Method: item.command.Method(),
+			Params: item.command.Params(),
+		}
+		if err := socket.send(payload); nil != err {
+			socket.failCommands([]Commander{item.command}, err)
+		}
+	}
+
+	for _, item := range batch.items {
+		select {
+		case response := <-item.command.Response():
+			item.respond(response)
+		case <-ctx.Done():
+			socket.abandon(item.command)
+			err := contextError(item.Method, ctx.Err())
+			item.command.SetError(err)
+			item.respond(&Response{Err: err, ID: item.command.ID()})
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/dom"
+)
+
+/*
+echoWebSocket is a WebSocketer that answers each command immediately with its
+ID as the result. Commands for Fail.method are answered with an error.
+*/
+type echoWebSocket struct {
+	closed    chan struct{}
+	responses chan *Response
+}
+
+func newEchoWebSocket() *echoWebSocket {
+	return &echoWebSocket{
+		closed:    make(chan struct{}),
+		responses: make(chan *Response, 1024),
+	}
+}
+
+func (socket *echoWebSocket) Close() error {
+	select {
+	case <-socket.closed:
+	default:
+		close(socket.closed)
+	}
+	return nil
+}
+
+func (socket *echoWebSocket) ReadJSON(v interface{}) error {
+	select {
+	case response := <-socket.responses:
+		data, _ := json.Marshal(response)
+		return json.Unmarshal(data, &v)
+	case <-socket.closed:
+		return ErrSocketClosed
+	}
+}
+
+func (socket *echoWebSocket) WriteJSON(v interface{}) error {
+	payload := v.(*Payload)
+	response := &Response{
+		ID:     payload.ID,
+		Result: []byte(fmt.Sprintf(`{"id":%d}`, payload.ID)),
+	}
+	if "Fail.method" == payload.Method {
+		response.Error = &Error{Code: -32601, Message: "'Fail.method' wasn't found"}
+	}
+	socket.responses <- response
+	return nil
+}
+
+func newEchoSocket(name string) *Socket {
+	conn := newEchoWebSocket()
+	socketURL, _ := url.Parse("https://test:9222/" + name)
+	echoSocket := NewMock(socketURL, WithLogger(NewNopLogger()))
+	echoSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		return conn, nil
+	}
+	echoSocket.Listen()
+	return echoSocket
+}
+
+func TestBatch(t *testing.T) {
+	echoSocket := newEchoSocket("TestBatch")
+	defer echoSocket.Stop()
+
+	batch := echoSocket.Batch()
+	results := make([]struct {
+		ID int `json:"id"`
+	}, 3)
+	batch.Add("Some.method", nil, &results[0])
+	failed := batch.Add("Fail.method", nil, &results[1])
+	batch.Add("Some.method", map[string]string{"param": "value"}, &results[2])
+	if 3 != batch.Len() {
+		t.Errorf("Expected 3 commands, received %d", batch.Len())
+	}
+
+	err := batch.Send(context.Background())
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("Expected BatchError, received '%v'", err)
+	}
+	if 1 != len(batchErr.Failed) || failed != batchErr.Failed[0] {
+		t.Errorf("Expected the failed command to be reported, received %v", batchErr.Failed)
+	}
+	if !errors.Is(err, ErrMethodNotFound) {
+		t.Errorf("Expected ErrMethodNotFound, received '%v'", err)
+	}
+	for k, item := range batch.Items() {
+		if failed == item {
+			continue
+		}
+		if nil != item.Err {
+			t.Errorf("Expected nil, received error: %v", item.Err)
+		}
+		if item.command.ID() != results[k].ID {
+			t.Errorf("Expected result %d, received %d", item.command.ID(), results[k].ID)
+		}
+	}
+	if 0 != len(echoSocket.commands.List()) {
+		t.Errorf("Expected 0 pending commands, received %d", len(echoSocket.commands.List()))
+	}
+}
+
+func TestBatchContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestBatchContext")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	batch := NewBatch(mockSocket)
+	batch.Add("Some.method", nil, nil)
+	batch.Add("Some.method", nil, nil)
+	err := batch.Send(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, received '%v'", err)
+	}
+	for _, item := range batch.Items() {
+		if !errors.Is(item.Err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, received '%v'", item.Err)
+		}
+	}
+	if 0 != len(mockSocket.commands.List()) {
+		t.Errorf("Expected 0 pending commands, received %d", len(mockSocket.commands.List()))
+	}
+}
+
+func BenchmarkBatch(b *testing.B) {
+	echoSocket := newEchoSocket("BenchmarkBatch")
+	defer echoSocket.Stop()
+
+	b.ResetTimer()
+	for a := 0; a < b.N; a++ {
+		batch := echoSocket.Batch()
+		for nodeID := 0; nodeID < 100; nodeID++ {
+			batch.Add(
+				"DOM.getAttributes",
+				&dom.GetAttributesParams{NodeID: dom.NodeID(nodeID)},
+				&dom.GetAttributesResult{},
+			)
+		}
+		if err := batch.Send(context.Background()); nil != err {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+}
+
+func BenchmarkBatchGeneratedMethods(b *testing.B) {
+	echoSocket := newEchoSocket("BenchmarkBatchGeneratedMethods")
+	defer echoSocket.Stop()
+
+	b.ResetTimer()
+	for a := 0; a < b.N; a++ {
+		results := make([]<-chan *dom.GetAttributesResult, 100)
+		for nodeID := range results {
+			results[nodeID] = echoSocket.DOM().GetAttributes(&dom.GetAttributesParams{NodeID: dom.NodeID(nodeID)})
+		}
+		for _, result := range results {
+			if err := (<-result).Err; nil != err {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.StopTimer()
+}
+
+func BenchmarkBatchCall(b *testing.B) {
+	echoSocket := newEchoSocket("BenchmarkBatchCall")
+	defer echoSocket.Stop()
+
+	b.ResetTimer()
+	for a := 0; a < b.N; a++ {
+		for nodeID := 0; nodeID < 100; nodeID++ {
+			result := json.RawMessage{}
+			err := echoSocket.Call(
+				context.Background(),
+				"DOM.getAttributes",
+				&dom.GetAttributesParams{NodeID: dom.NodeID(nodeID)},
+				&result,
+			)
+			if nil != err {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.StopTimer()
+}
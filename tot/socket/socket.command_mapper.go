@@ -53,6 +53,21 @@ func (stack *CommandMap) Get(id int) (Commander, error) {
 	return command, nil
 }
 
+/*
+List returns all commands currently in the stack.
+
+List is a CommandMapper implementation.
+*/
+func (stack *CommandMap) List() []Commander {
+	stack.mux.Lock()
+	commands := make([]Commander, 0, len(stack.stack))
+	for _, command := range stack.stack {
+		commands = append(commands, command)
+	}
+	stack.mux.Unlock()
+	return commands
+}
+
 /*
 Set sets a command in the stack.
 
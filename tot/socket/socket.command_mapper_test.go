@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"net/url"
 	"testing"
 )
 
@@ -11,3 +12,17 @@ func TestSocketCommandMapperError(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", err.Error())
 	}
 }
+
+func TestSocketCommandMapperList(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketCommandMapperList")
+	mockSocket := NewMock(socketURL)
+	commandMap := NewCommandMap()
+	if 0 != len(commandMap.List()) {
+		t.Errorf("Expected an empty list, got %d commands", len(commandMap.List()))
+	}
+	commandMap.Set(NewCommand(mockSocket, "Some.method", nil))
+	commandMap.Set(NewCommand(mockSocket, "Some.method", nil))
+	if 2 != len(commandMap.List()) {
+		t.Errorf("Expected 2 commands, got %d", len(commandMap.List()))
+	}
+}
@@ -9,7 +9,7 @@ func NewCommand(socket Socketer, method string, params interface{}) *Command {
 		id:       socket.NextCommandID(),
 		method:   method,
 		params:   params,
-		response: make(chan *Response),
+		response: make(chan *Response, 1),
 		socket:   socket,
 	}
 }
@@ -32,7 +32,8 @@ type Command struct {
 	params interface{}
 
 	// Optional. result holds the JSON results returned by the socket when the
-	// command is complete.
+	// command is complete. It is buffered so responding never blocks the
+	// socket read loop, even if the command has been abandoned.
 	response chan *Response
 
 	// socket contains the Socketer instance
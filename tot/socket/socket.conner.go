@@ -1,10 +1,10 @@
 package socket
 
 import (
+	"encoding/json"
 	"fmt"
 
 	errs "github.com/bdlm/errors"
-	log "github.com/sirupsen/logrus"
 )
 
 /*
@@ -14,11 +14,15 @@ Conn is a Conner implementation.
 */
 func (socket *Socket) Conn() WebSocketer {
 	socket.Connect()
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	return socket.conn
 }
 
 /*
-Connect establishes a websocket connection.
+Connect establishes a websocket connection. While the socket is reconnecting,
+see ReconnectPolicy, the connection is only established by the reconnect and
+Connect fails.
 
 Connect is a Conner implementation.
 */
@@ -26,14 +30,25 @@ func (socket *Socket) Connect() error {
 	socket.mux.Lock()
 	defer socket.mux.Unlock()
 
+	if nil != socket.reconnecting {
+		return errs.New(0, "socket is reconnecting")
+	}
+	return socket.dial()
+}
+
+/*
+dial establishes a websocket connection unless one exists. The socket mutex
+must be held.
+*/
+func (socket *Socket) dial() error {
 	if socket.connected {
 		return nil
 	}
 
-	log.Debugf("socket #%d - socket.Connect(): connecting to %s", socket.socketID, socket.url.String())
+	socket.logger.Debugf("connecting to %s", socket.url.String())
 	websocket, err := socket.newSocket(socket.url)
 	if nil != err {
-		log.Debugf("socket #%d - socket.Connect(): received error %s", socket.socketID, err.Error())
+		socket.logger.Debugf("connection failed: %s", err.Error())
 		socket.connected = false
 		return errs.Wrap(err, 0, "Connect() failed while creating socket")
 	}
@@ -41,7 +56,7 @@ func (socket *Socket) Connect() error {
 	socket.conn = websocket
 	socket.connected = true
 
-	log.Debugf("socket #%d - socket.Connect(): connection to %s established", socket.socketID, socket.url.String())
+	socket.logger.Debugf("connection to %s established", socket.url.String())
 	return nil
 }
 
@@ -51,6 +66,8 @@ Connected returns whether a connection exists.
 Connected is a Conner implementation.
 */
 func (socket *Socket) Connected() bool {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	return socket.connected
 }
 
@@ -60,20 +77,22 @@ Disconnect closes a websocket connection.
 Disconnect is a Conner implementation.
 */
 func (socket *Socket) Disconnect() error {
-	if !socket.connected {
+	if !socket.Connected() {
 		return fmt.Errorf("not connected")
 	}
 	socket.Stop()
-	err := socket.conn.Close()
-	if nil != err {
-		socket.listenErr.With(err, "could not close socket connection")
+
+	// The read loop disconnects as well when it stops.
+	socket.mux.Lock()
+	if nil != socket.conn {
+		if err := socket.conn.Close(); nil != err {
+			socket.listenErr.With(err, "could not close socket connection")
+		}
 	}
 	socket.conn = nil
 	socket.connected = false
-	if 0 == len(socket.listenErr) {
-		return nil
-	}
-	return socket.listenErr
+	socket.mux.Unlock()
+	return socket.listenError()
 }
 
 /*
@@ -87,29 +106,82 @@ func (socket *Socket) ReadJSON(v interface{}) error {
 		return errs.Wrap(err, 0, "not connected")
 	}
 
-	err = socket.conn.ReadJSON(&v)
-	if nil != err {
-		return errs.Wrap(err, 0, "socket read failed")
+	// The connection may be closed by Stop or Disconnect meanwhile.
+	socket.mux.Lock()
+	conn := socket.conn
+	socket.mux.Unlock()
+	if nil == conn {
+		return errs.New(0, "not connected")
+	}
+
+	if !socket.recording() {
+		if err = conn.ReadJSON(&v); nil != err {
+			return errs.Wrap(err, 0, "socket read failed")
+		}
+		return nil
 	}
 
+	// The message is recorded as read, including fields Response doesn't
+	// have.
+	message := json.RawMessage{}
+	if err = conn.ReadJSON(&message); nil != err {
+		return errs.Wrap(err, 0, "socket read failed")
+	}
+	socket.record(RecordReceive, message)
+	if err = json.Unmarshal(message, &v); nil != err {
+		return errs.Wrap(err, 0, "socket read failed")
+	}
 	return nil
 }
 
 /*
-WriteJSON writes data to a websocket connection.
+WriteJSON writes data to a websocket connection. While the socket is
+reconnecting the write waits for the reconnect to finish, ErrSocketClosed is
+returned if it fails.
 
 WriteJSON is a Conner implementation.
 */
 func (socket *Socket) WriteJSON(v interface{}) error {
-	err := socket.Connect()
+	conn, err := socket.writeConn()
 	if nil != err {
-		return errs.Wrap(err, 0, "not connected")
+		return err
 	}
 
-	err = socket.conn.WriteJSON(v)
+	err = conn.WriteJSON(v)
 	if nil != err {
 		return errs.Wrap(err, 0, "socket write failed")
 	}
+	socket.record(RecordSend, v)
 
 	return nil
 }
+
+/*
+writeConn returns the websocket connection to write to, connecting if
+necessary. While the socket is reconnecting it waits for the reconnect to
+finish instead of connecting.
+*/
+func (socket *Socket) writeConn() (WebSocketer, error) {
+	for {
+		socket.mux.Lock()
+		reconnecting := socket.reconnecting
+		if nil == reconnecting {
+			err := socket.dial()
+			conn := socket.conn
+			socket.mux.Unlock()
+			if nil != err {
+				return nil, errs.Wrap(err, 0, "not connected")
+			}
+			return conn, nil
+		}
+		socket.mux.Unlock()
+
+		<-reconnecting
+		socket.mux.Lock()
+		failed := reconnecting == socket.reconnecting
+		socket.mux.Unlock()
+		if failed {
+			return nil, ErrSocketClosed
+		}
+	}
+}
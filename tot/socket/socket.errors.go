@@ -0,0 +1,166 @@
+package socket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+/*
+Protocol errors returned by the DevTools protocol. Use errors.Is to test a
+command or event error against them:
+
+	if errors.Is(result.Err, socket.ErrInvalidParams) {
+		...
+	}
+*/
+var (
+	// ErrParse is returned when the browser could not parse a command.
+	ErrParse = &Error{Code: -32700, Message: "Parse error"}
+
+	// ErrInvalidRequest is returned when a command is not a valid request.
+	ErrInvalidRequest = &Error{Code: -32600, Message: "Invalid request"}
+
+	// ErrMethodNotFound is returned when the method does not exist in the
+	// browser, usually because of a protocol version mismatch.
+	ErrMethodNotFound = &Error{Code: -32601, Message: "Method not found"}
+
+	// ErrInvalidParams is returned when the command parameters are invalid.
+	ErrInvalidParams = &Error{Code: -32602, Message: "Invalid params"}
+
+	// ErrInternal is returned when the browser failed to execute a command.
+	ErrInternal = &Error{Code: -32603, Message: "Internal error"}
+
+	// ErrServer is the generic error the browser returns when a command can
+	// not be completed, for example when a node or context does not exist.
+	ErrServer = &Error{Code: -32000, Message: "Server error"}
+)
+
+/*
+Local errors reported when a command or event can't be completed.
+*/
+var (
+	// ErrPayloadTooLarge is reported when a command payload exceeds
+	// MaxPayloadSize and no large payload strategy applies to the method.
+	// See PayloadStrategy.
+	ErrPayloadTooLarge = errors.New("payload too large")
+
+	// ErrSocketClosed is reported when the websocket connection was closed
+	// or lost before a response was received.
+	ErrSocketClosed = errors.New("socket connection closed")
+
+	// ErrTargetCrashed is reported when the target crashed before a response
+	// was received.
+	ErrTargetCrashed = errors.New("target crashed")
+
+	// ErrTargetDetached is reported when the debugging session was detached
+	// from the target before a response was received.
+	ErrTargetDetached = errors.New("target detached")
+)
+
+/*
+BatchError is returned by Batch.Send when one or more commands of the batch
+failed. The error of each failed command is set on its BatchItem.
+*/
+type BatchError struct {
+	Failed []*BatchItem
+	Len    int
+}
+
+/*
+Error implements the error interface.
+*/
+func (err *BatchError) Error() string {
+	return fmt.Sprintf("%d of %d batch commands failed, first error: %v", len(err.Failed), err.Len, err.Failed[0].Err)
+}
+
+/*
+Unwrap returns the error of the first failed command.
+*/
+func (err *BatchError) Unwrap() error {
+	return err.Failed[0].Err
+}
+
+/*
+TargetError is reported when the target crashed or the debugging session was
+detached before a response was received. Err is ErrTargetCrashed or
+ErrTargetDetached.
+*/
+type TargetError struct {
+	Err    error
+	Method string
+}
+
+/*
+Error implements the error interface.
+*/
+func (err *TargetError) Error() string {
+	return fmt.Sprintf("%s: %v", err.Method, err.Err)
+}
+
+/*
+Unwrap returns the underlying cause.
+*/
+func (err *TargetError) Unwrap() error {
+	return err.Err
+}
+
+/*
+TransportError is reported when a command payload could not be written to the
+websocket or the connection failed before a response was received. Err is the
+underlying cause, for example ErrSocketClosed.
+*/
+type TransportError struct {
+	Err    error
+	Method string
+}
+
+/*
+Error implements the error interface.
+*/
+func (err *TransportError) Error() string {
+	return fmt.Sprintf("%s: transport error: %v", err.Method, err.Err)
+}
+
+/*
+Unwrap returns the underlying cause.
+*/
+func (err *TransportError) Unwrap() error {
+	return err.Err
+}
+
+/*
+TimeoutError is reported when the context deadline for a command or event was
+exceeded before a response was received. It unwraps to
+context.DeadlineExceeded.
+*/
+type TimeoutError struct {
+	Err    error
+	Method string
+}
+
+/*
+Error implements the error interface.
+*/
+func (err *TimeoutError) Error() string {
+	return fmt.Sprintf("%s: timed out: %v", err.Method, err.Err)
+}
+
+/*
+Unwrap returns the underlying context error.
+*/
+func (err *TimeoutError) Unwrap() error {
+	return err.Err
+}
+
+/*
+contextError returns the error reported when the context for a method is done.
+Exceeded deadlines are reported as a TimeoutError, cancellation is reported as
+the context error.
+*/
+func contextError(method string, err error) error {
+	if context.DeadlineExceeded == err {
+		return &TimeoutError{Err: err, Method: method}
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+package socket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestProtocolError(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestProtocolError")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: command.ID(),
+		Error: &Error{
+			Code:    -32601,
+			Message: "'Some.method' wasn't found",
+		},
+	})
+	result := <-resultChan
+
+	var err error = result.Error
+	if !errors.Is(err, ErrMethodNotFound) {
+		t.Errorf("Expected ErrMethodNotFound, received '%v'", err)
+	}
+	if errors.Is(err, ErrInvalidParams) {
+		t.Errorf("Expected error not to match ErrInvalidParams")
+	}
+	var protocolErr *Error
+	if !errors.As(err, &protocolErr) {
+		t.Fatalf("Expected *Error, received '%v'", err)
+	}
+	if "Some.method" != protocolErr.Method {
+		t.Errorf("Expected method 'Some.method', received '%s'", protocolErr.Method)
+	}
+}
+
+func TestTransportError(t *testing.T) {
+	cause := fmt.Errorf("mock write error")
+	var err error = &TransportError{Err: cause, Method: "Some.method"}
+
+	if !errors.Is(err, cause) {
+		t.Errorf("Expected error to unwrap to the cause")
+	}
+	if "Some.method: transport error: mock write error" != err.Error() {
+		t.Errorf("Unexpected error message '%s'", err.Error())
+	}
+}
+
+func TestContextError(t *testing.T) {
+	err := contextError("Some.method", context.DeadlineExceeded)
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Errorf("Expected TimeoutError, received '%v'", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, received '%v'", err)
+	}
+
+	if err := contextError("Some.method", context.Canceled); context.Canceled != err {
+		t.Errorf("Expected context.Canceled, received '%v'", err)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"sync"
 
 	errs "github.com/bdlm/errors"
-	log "github.com/sirupsen/logrus"
 )
 
 /*
@@ -48,7 +47,6 @@ func (stack *EventHandlerMap) Add(
 		}
 	}
 
-	log.Debugf("Adding handler for event '%s'", handler.Name())
 	handlers = append(handlers, handler)
 	stack.Set(handler.Name(), handlers)
 	return nil
@@ -99,9 +97,14 @@ func (stack *EventHandlerMap) Remove(
 	stack.Lock()
 	defer stack.Unlock()
 
-	for k, hndl := range stack.stack[handler.Name()] {
+	handlers := stack.stack[handler.Name()]
+	for k, hndl := range handlers {
 		if hndl == handler {
-			stack.stack[handler.Name()] = append(stack.stack[handler.Name()][:k], stack.stack[handler.Name()][k+1:]...)
+			// Copy the stack, a caller may be iterating over the current one.
+			remaining := make([]EventHandler, 0, len(handlers)-1)
+			remaining = append(remaining, handlers[:k]...)
+			remaining = append(remaining, handlers[k+1:]...)
+			stack.stack[handler.Name()] = remaining
 			return nil
 		}
 	}
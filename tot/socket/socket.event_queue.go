@@ -0,0 +1,191 @@
+package socket
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+/*
+OverflowPolicy defines how ordered event delivery behaves when a handler's
+event queue is full. The zero value is DropOldestOnOverflow.
+*/
+type OverflowPolicy int
+
+const (
+	// DropOldestOnOverflow discards the oldest queued event to make room for
+	// the new one. This is the default policy.
+	DropOldestOnOverflow OverflowPolicy = iota
+
+	// DropNewestOnOverflow discards the new event.
+	DropNewestOnOverflow
+
+	// BlockOnOverflow blocks the socket read loop until the handler has room
+	// in its queue. No events are dropped but no other message is read while
+	// the read loop is blocked, including command responses. A handler that
+	// sends a command and waits for the response while its queue is full
+	// deadlocks the socket: the response can't be read until the handler
+	// returns. Only use it with handlers that don't send commands, or send
+	// them without waiting for the response.
+	BlockOnOverflow
+)
+
+/*
+eventQueue delivers events to a single handler in order.
+*/
+type eventQueue struct {
+	done    chan struct{}
+	handler EventHandler
+	once    *sync.Once
+	queue   chan *Response
+}
+
+/*
+stop ends event delivery for the queue. Queued events are discarded.
+*/
+func (queue *eventQueue) stop() {
+	queue.once.Do(func() {
+		close(queue.done)
+	})
+}
+
+/*
+work executes the handler for each queued event until the queue is stopped.
+*/
+func (queue *eventQueue) work() {
+	for {
+		select {
+		case <-queue.done:
+			return
+		case response := <-queue.queue:
+			queue.handler.Handle(response)
+		}
+	}
+}
+
+/*
+DroppedEvents returns the number of events that have been discarded by the
+ordered event delivery overflow policy.
+*/
+func (socket *Socket) DroppedEvents() uint64 {
+	return atomic.LoadUint64(&socket.droppedEvents)
+}
+
+/*
+dropEvent records a discarded event.
+*/
+func (socket *Socket) dropEvent(handler EventHandler) {
+	dropped := atomic.AddUint64(&socket.droppedEvents, 1)
+	socket.logger.WithFields(Fields{"method": handler.Name()}).Debugf(
+		"event queue is full, event dropped (%d total)",
+		dropped,
+	)
+}
+
+/*
+enqueueEvent adds an event to the handler's queue, creating the queue if it
+doesn't exist, and applies the overflow policy if the queue is full. The
+event is discarded if the handler has been removed.
+*/
+func (socket *Socket) enqueueEvent(handler EventHandler, response *Response) {
+	socket.eventQueueMux.Lock()
+	queue, ok := socket.eventQueues[handler]
+	socket.eventQueueMux.Unlock()
+	if !ok {
+		if queue = socket.newEventQueue(handler); nil == queue {
+			return
+		}
+	}
+
+	switch socket.eventOverflow {
+	case BlockOnOverflow:
+		select {
+		case queue.queue <- response:
+		case <-queue.done:
+		}
+
+	case DropNewestOnOverflow:
+		select {
+		case queue.queue <- response:
+		case <-queue.done:
+		default:
+			socket.dropEvent(handler)
+		}
+
+	default:
+		for {
+			select {
+			case queue.queue <- response:
+				return
+			case <-queue.done:
+				return
+			default:
+			}
+			select {
+			case <-queue.queue:
+				socket.dropEvent(handler)
+			default:
+			}
+		}
+	}
+}
+
+/*
+newEventQueue returns the queue of a handler, creating it if it doesn't exist,
+or nil if the handler has been removed. The handler stack is locked like in
+RemoveEventHandler so the queue of a removed handler isn't created again.
+*/
+func (socket *Socket) newEventQueue(handler EventHandler) *eventQueue {
+	socket.handlers.Lock()
+	defer socket.handlers.Unlock()
+	socket.eventQueueMux.Lock()
+	defer socket.eventQueueMux.Unlock()
+
+	if queue, ok := socket.eventQueues[handler]; ok {
+		return queue
+	}
+	handlers, _ := socket.handlers.Get(handler.Name())
+	for _, hndlr := range handlers {
+		if hndlr == handler {
+			queue := &eventQueue{
+				done:    make(chan struct{}),
+				handler: handler,
+				once:    &sync.Once{},
+				queue:   make(chan *Response, socket.eventQueueSize),
+			}
+			socket.eventQueues[handler] = queue
+			go queue.work()
+			return queue
+		}
+	}
+	return nil
+}
+
+/*
+stopEventQueue ends ordered event delivery for a handler.
+*/
+func (socket *Socket) stopEventQueue(handler EventHandler) {
+	if nil == socket.eventQueues {
+		return
+	}
+	socket.eventQueueMux.Lock()
+	if queue, ok := socket.eventQueues[handler]; ok {
+		queue.stop()
+		delete(socket.eventQueues, handler)
+	}
+	socket.eventQueueMux.Unlock()
+}
+
+/*
+stopEventQueues ends ordered event delivery for all handlers.
+*/
+func (socket *Socket) stopEventQueues() {
+	if nil == socket.eventQueues {
+		return
+	}
+	socket.eventQueueMux.Lock()
+	for handler, queue := range socket.eventQueues {
+		queue.stop()
+		delete(socket.eventQueues, handler)
+	}
+	socket.eventQueueMux.Unlock()
+}
@@ -0,0 +1,181 @@
+package socket
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func addMockEvents(mockSocket *Socket, method string, count int) {
+	for a := 1; a <= count; a++ {
+		params, _ := json.Marshal(a)
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: method,
+			Params: params,
+		})
+	}
+}
+
+func TestOrderedEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOrderedEvents")
+	mockSocket := NewMock(socketURL, WithOrderedEvents(100, BlockOnOverflow))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan int, 100)
+	mockSocket.AddEventHandler(NewEventHandler(
+		"Test.event",
+		func(response *Response) {
+			var seq int
+			json.Unmarshal(response.Params, &seq)
+			resultChan <- seq
+		},
+	))
+	addMockEvents(mockSocket, "Test.event", 20)
+
+	for a := 1; a <= 20; a++ {
+		select {
+		case seq := <-resultChan:
+			if a != seq {
+				t.Errorf("Expected event #%d, received #%d", a, seq)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Event #%d was not received", a)
+		}
+	}
+	if 0 != mockSocket.DroppedEvents() {
+		t.Errorf("Expected 0 dropped events, found %d", mockSocket.DroppedEvents())
+	}
+}
+
+func TestOrderedEventsDropNewest(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOrderedEventsDropNewest")
+	mockSocket := NewMock(socketURL, WithOrderedEvents(1, DropNewestOnOverflow))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	block := make(chan bool)
+	resultChan := make(chan int, 10)
+	mockSocket.AddEventHandler(NewEventHandler(
+		"Test.event",
+		func(response *Response) {
+			var seq int
+			json.Unmarshal(response.Params, &seq)
+			if 1 == seq {
+				<-block
+			}
+			resultChan <- seq
+		},
+	))
+	addMockEvents(mockSocket, "Test.event", 3)
+	time.Sleep(200 * time.Millisecond)
+	close(block)
+
+	if seq := <-resultChan; 1 != seq {
+		t.Errorf("Expected event #1, received #%d", seq)
+	}
+	if seq := <-resultChan; 2 != seq {
+		t.Errorf("Expected event #2, received #%d", seq)
+	}
+	if 1 != mockSocket.DroppedEvents() {
+		t.Errorf("Expected 1 dropped event, found %d", mockSocket.DroppedEvents())
+	}
+}
+
+func TestOrderedEventsDropOldest(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOrderedEventsDropOldest")
+	mockSocket := NewMock(socketURL, WithOrderedEvents(1, DropOldestOnOverflow))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	block := make(chan bool)
+	resultChan := make(chan int, 10)
+	mockSocket.AddEventHandler(NewEventHandler(
+		"Test.event",
+		func(response *Response) {
+			var seq int
+			json.Unmarshal(response.Params, &seq)
+			if 1 == seq {
+				<-block
+			}
+			resultChan <- seq
+		},
+	))
+	addMockEvents(mockSocket, "Test.event", 3)
+	time.Sleep(200 * time.Millisecond)
+	close(block)
+
+	if seq := <-resultChan; 1 != seq {
+		t.Errorf("Expected event #1, received #%d", seq)
+	}
+	if seq := <-resultChan; 3 != seq {
+		t.Errorf("Expected event #3, received #%d", seq)
+	}
+	if 1 != mockSocket.DroppedEvents() {
+		t.Errorf("Expected 1 dropped event, found %d", mockSocket.DroppedEvents())
+	}
+
+	var policy OverflowPolicy
+	if DropOldestOnOverflow != policy {
+		t.Errorf("Expected DropOldestOnOverflow to be the default policy")
+	}
+}
+
+func TestOrderedEventsRemoveHandler(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOrderedEventsRemoveHandler")
+	mockSocket := NewMock(socketURL, WithOrderedEvents(10, BlockOnOverflow))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan int, 10)
+	SubscribeOnce(mockSocket, NewEventHandler(
+		"Test.event",
+		func(response *Response) {
+			var seq int
+			json.Unmarshal(response.Params, &seq)
+			resultChan <- seq
+		},
+	))
+	addMockEvents(mockSocket, "Test.event", 3)
+	time.Sleep(200 * time.Millisecond)
+
+	if 1 != len(resultChan) {
+		t.Errorf("Expected 1 event, received %d", len(resultChan))
+	}
+	mockSocket.eventQueueMux.Lock()
+	if 0 != len(mockSocket.eventQueues) {
+		t.Errorf("Expected no event queues, found %d", len(mockSocket.eventQueues))
+	}
+	mockSocket.eventQueueMux.Unlock()
+}
+
+func TestOrderedEventsRemovedHandler(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOrderedEventsRemovedHandler")
+	mockSocket := NewMock(socketURL, WithOrderedEvents(10, BlockOnOverflow))
+
+	resultChan := make(chan int, 10)
+	handler := NewEventHandler(
+		"Test.event",
+		func(response *Response) {
+			resultChan <- 1
+		},
+	)
+	mockSocket.AddEventHandler(handler)
+	mockSocket.RemoveEventHandler(handler)
+
+	// An event dispatched to the handler list read before the handler was
+	// removed doesn't create a new queue.
+	mockSocket.enqueueEvent(handler, &Response{Method: "Test.event"})
+	time.Sleep(50 * time.Millisecond)
+
+	if 0 != len(resultChan) {
+		t.Errorf("Expected no events, received %d", len(resultChan))
+	}
+	mockSocket.eventQueueMux.Lock()
+	if 0 != len(mockSocket.eventQueues) {
+		t.Errorf("Expected no event queues, found %d", len(mockSocket.eventQueues))
+	}
+	mockSocket.eventQueueMux.Unlock()
+}
@@ -0,0 +1,108 @@
+package socket
+
+import (
+	"context"
+	"sync"
+)
+
+/*
+NewEventStream adds an event handler to the socket that delivers each event to
+a channel and returns the EventStream. The handler is removed and the channel
+is closed when the context is done or the socket stops listening.
+
+Events are delivered in the order the handler receives them. Unless the socket
+was created with WithOrderedEvents that may differ from the order they were
+received from the websocket.
+*/
+func NewEventStream(ctx context.Context, socket Socketer, name string) *EventStream {
+	stream := &EventStream{
+		done:      make(chan struct{}),
+		mux:       &sync.Mutex{},
+		name:      name,
+		once:      &sync.Once{},
+		responses: make(chan *Response),
+		socket:    socket,
+	}
+	socket.AddEventHandler(stream)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-socket.Done():
+		case <-stream.done:
+		}
+		stream.Close()
+	}()
+
+	return stream
+}
+
+/*
+EventStream provides an EventHandler interface that delivers events to a
+channel.
+*/
+type EventStream struct {
+	closed    bool
+	done      chan struct{}
+	mux       *sync.Mutex
+	name      string
+	once      *sync.Once
+	responses chan *Response
+	socket    Socketer
+}
+
+/*
+Close removes the event handler from the socket and closes the stream. It is
+safe to call more than once.
+*/
+func (stream *EventStream) Close() {
+	stream.once.Do(func() {
+		stream.socket.RemoveEventHandler(stream)
+		close(stream.done)
+		stream.mux.Lock()
+		stream.closed = true
+		close(stream.responses)
+		stream.mux.Unlock()
+	})
+}
+
+/*
+Done returns a channel that is closed when the stream is closed.
+*/
+func (stream *EventStream) Done() <-chan struct{} {
+	return stream.done
+}
+
+/*
+Handle delivers the event to the stream channel, blocking until it is read or
+the stream is closed.
+
+Handle is an EventHandler implementation.
+*/
+func (stream *EventStream) Handle(response *Response) {
+	stream.mux.Lock()
+	defer stream.mux.Unlock()
+	if stream.closed {
+		return
+	}
+	select {
+	case stream.responses <- response:
+	case <-stream.done:
+	}
+}
+
+/*
+Name returns the name of the event the stream is assigned to.
+
+Name is an EventHandler implementation.
+*/
+func (stream *EventStream) Name() string {
+	return stream.name
+}
+
+/*
+Responses returns the stream channel. It is closed when the stream is closed.
+*/
+func (stream *EventStream) Responses() <-chan *Response {
+	return stream.responses
+}
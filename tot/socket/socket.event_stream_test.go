@@ -0,0 +1,62 @@
+package socket
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestEventStream(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEventStream")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := NewEventStream(ctx, mockSocket, "Test.event")
+	if "Test.event" != stream.Name() {
+		t.Errorf("Expected 'Test.event', received '%s'", stream.Name())
+	}
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Test.event",
+		Params: []byte(`"Mock Event Params"`),
+	})
+	select {
+	case response := <-stream.Responses():
+		if `"Mock Event Params"` != string(response.Params) {
+			t.Errorf("Expected 'Mock Event Params', received '%s'", response.Params)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-stream.Responses():
+		if ok {
+			t.Errorf("Expected closed stream, received event")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Stream was not closed")
+	}
+	handlers, _ := mockSocket.handlers.Get("Test.event")
+	if 0 != len(handlers) {
+		t.Errorf("Expected no handlers, found %d", len(handlers))
+	}
+}
+
+func TestEventStreamSocketStop(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEventStreamSocketStop")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+
+	stream := NewEventStream(context.Background(), mockSocket, "Test.event")
+	mockSocket.Stop()
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Stream was not closed")
+	}
+}
@@ -13,30 +13,59 @@ import (
 )
 
 /*
-Error represents a socket response error.
+Error represents a protocol error returned by the browser in response to a
+command. Method is the command or event the error was returned for.
+
+Errors with the same code match with errors.Is, see ErrMethodNotFound and
+related values. Use errors.As to access the error details.
 */
 type Error struct {
 	Code    int             `json:"code"`
 	Data    json.RawMessage `json:"data"`
 	Message string          `json:"message"`
+	Method  string          `json:"-"`
 }
 
 /*
 Error implements the error interface for socket response Error structs
 */
 func (err Error) Error() string {
+	if "" != err.Method {
+		return fmt.Sprintf("%s: code=%d, data=%s, msg=%s", err.Method, err.Code, err.Data, err.Message)
+	}
 	return fmt.Sprintf("code=%d, data=%s, msg=%s", err.Code, err.Data, err.Message)
 }
 
+/*
+Is reports whether the target is a protocol error with the same code.
+*/
+func (err Error) Is(target error) bool {
+	switch tgt := target.(type) {
+	case *Error:
+		return nil != tgt && err.Code == tgt.Code
+	case Error:
+		return err.Code == tgt.Code
+	}
+	return false
+}
+
 /*
 Response represents a socket message.
+
+Error contains any protocol error returned by the browser. Err contains any
+local error that prevented a response from being received, such as a
+TransportError or TimeoutError.
 */
 type Response struct {
+	Err    error           `json:"-"`
 	Error  *Error          `json:"error"`
 	ID     int             `json:"id"`
 	Method string          `json:"method"`
 	Params json.RawMessage `json:"params"`
 	Result json.RawMessage `json:"result"`
+
+	// SessionID identifies the flattened session the message belongs to.
+	SessionID string `json:"sessionId,omitempty"`
 }
 
 /*
@@ -47,4 +76,7 @@ type Payload struct {
 	ID     int         `json:"id"`
 	Method string      `json:"method"`
 	Params interface{} `json:"params"`
+
+	// SessionID identifies the flattened session the command is sent to.
+	SessionID string `json:"sessionId,omitempty"`
 }
@@ -0,0 +1,229 @@
+package socket
+
+import (
+	"context"
+	"time"
+)
+
+/*
+HeartbeatPolicy defines how a Socket monitors the health of the connection. A
+heartbeat command is sent on an interval and the socket is declared unhealthy
+after a number of consecutive heartbeats were missed, see Socket.Health.
+*/
+type HeartbeatPolicy struct {
+	// Optional. Interval is the time between heartbeats. Defaults to 10s.
+	Interval time.Duration
+
+	// Optional. Timeout is the time to wait for the heartbeat response
+	// before it is counted as missed. Defaults to Interval.
+	Timeout time.Duration
+
+	// Optional. MaxMissed is the number of consecutive missed heartbeats
+	// after which the socket is unhealthy. Defaults to 3.
+	MaxMissed int
+
+	// Optional. Method is the command sent as a heartbeat. It should be
+	// cheap for the target to answer. Defaults to "Browser.getVersion", page
+	// targets can use "Runtime.evaluate" with the expression "1".
+	Method string
+
+	// Optional. Params are the parameters of the heartbeat command.
+	Params interface{}
+
+	// Optional. OnHealthChange is called when the socket becomes unhealthy
+	// or recovers.
+	OnHealthChange func(socket *Socket, health Health)
+}
+
+/*
+interval returns the time between heartbeats.
+*/
+func (policy *HeartbeatPolicy) interval() time.Duration {
+	if policy.Interval <= 0 {
+		return 10 * time.Second
+	}
+	return policy.Interval
+}
+
+/*
+maxMissed returns the number of missed heartbeats after which the socket is
+unhealthy.
+*/
+func (policy *HeartbeatPolicy) maxMissed() int {
+	if policy.MaxMissed <= 0 {
+		return 3
+	}
+	return policy.MaxMissed
+}
+
+/*
+method returns the heartbeat command method.
+*/
+func (policy *HeartbeatPolicy) method() string {
+	if "" == policy.Method {
+		return "Browser.getVersion"
+	}
+	return policy.Method
+}
+
+/*
+timeout returns the time to wait for a heartbeat response.
+*/
+func (policy *HeartbeatPolicy) timeout() time.Duration {
+	if policy.Timeout <= 0 {
+		return policy.interval()
+	}
+	return policy.Timeout
+}
+
+/*
+Health describes the health of a socket connection.
+*/
+type Health struct {
+	// Healthy is false after HeartbeatPolicy.MaxMissed consecutive
+	// heartbeats were missed, until a heartbeat succeeds again, and once the
+	// socket stopped listening.
+	Healthy bool
+
+	// Err is the error of the last missed heartbeat, nil after a heartbeat
+	// succeeded. ErrSocketClosed once the socket stopped listening.
+	Err error
+
+	// LastBeat is the time of the last successful heartbeat.
+	LastBeat time.Time
+
+	// Latency is the round-trip time of the last successful heartbeat.
+	Latency time.Duration
+
+	// AverageLatency is a moving average of the heartbeat round-trip time.
+	AverageLatency time.Duration
+
+	// Missed is the number of consecutive missed heartbeats.
+	Missed int
+
+	// Pending is the number of commands waiting for a response.
+	Pending int
+}
+
+/*
+Health returns the health of the socket connection. A socket that isn't
+listening is unhealthy. Without a heartbeat policy, see WithHeartbeat, the
+socket is reported healthy while it is listening and only Pending is tracked.
+*/
+func (socket *Socket) Health() Health {
+	socket.mux.Lock()
+	health := socket.health
+	if nil == socket.heartbeatPolicy {
+		health.Healthy = socket.listening
+	} else if !socket.listening {
+		health.Healthy = false
+	}
+	socket.mux.Unlock()
+	health.Pending = len(socket.commands.List())
+	return health
+}
+
+/*
+heartbeat sends the heartbeat command on the policy interval until the socket
+stops listening, and then declares the socket unhealthy.
+*/
+func (socket *Socket) heartbeat(done chan struct{}) {
+	policy := socket.heartbeatPolicy
+	ticker := time.NewTicker(policy.interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			socket.closeHealth(done)
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), policy.timeout())
+		start := time.Now()
+		err := socket.Call(ctx, policy.method(), policy.Params, nil)
+		cancel()
+
+		// A protocol error is a response, the connection is alive.
+		if _, ok := err.(*Error); ok {
+			err = nil
+		}
+		socket.beat(time.Since(start), err)
+	}
+}
+
+/*
+beat records the result of a heartbeat and notifies the health change
+handler if the socket became unhealthy or recovered.
+*/
+func (socket *Socket) beat(latency time.Duration, err error) {
+	policy := socket.heartbeatPolicy
+
+	socket.mux.Lock()
+	healthy := socket.health.Healthy
+	if nil == err {
+		socket.health.Err = nil
+		socket.health.LastBeat = time.Now()
+		socket.health.Latency = latency
+		if 0 == socket.health.AverageLatency {
+			socket.health.AverageLatency = latency
+		} else {
+			socket.health.AverageLatency = (socket.health.AverageLatency*7 + latency) / 8
+		}
+		socket.health.Missed = 0
+		socket.health.Healthy = true
+	} else {
+		socket.health.Err = err
+		socket.health.Missed++
+		if socket.health.Missed >= policy.maxMissed() {
+			socket.health.Healthy = false
+		}
+	}
+	changed := healthy != socket.health.Healthy
+	socket.mux.Unlock()
+
+	if nil != err {
+		socket.logger.Warnf("heartbeat missed: %v", err)
+	}
+	if changed {
+		health := socket.Health()
+		if health.Healthy {
+			socket.logger.Infof("socket is healthy")
+		} else {
+			socket.logger.Errorf("socket is unhealthy, %d heartbeats missed", health.Missed)
+		}
+		socket.notifyHealth(health)
+	}
+}
+
+/*
+closeHealth declares the socket unhealthy once it stopped listening and
+notifies the health change handler if it was healthy. Nothing is recorded if
+the socket listens again.
+*/
+func (socket *Socket) closeHealth(done chan struct{}) {
+	socket.mux.Lock()
+	if done != socket.done {
+		socket.mux.Unlock()
+		return
+	}
+	changed := socket.health.Healthy
+	socket.health.Healthy = false
+	socket.health.Err = ErrSocketClosed
+	socket.mux.Unlock()
+
+	if changed {
+		socket.logger.Errorf("socket is unhealthy, socket closed")
+		socket.notifyHealth(socket.Health())
+	}
+}
+
+/*
+notifyHealth calls the health change handler, if any.
+*/
+func (socket *Socket) notifyHealth(health Health) {
+	if nil != socket.heartbeatPolicy.OnHealthChange {
+		go socket.heartbeatPolicy.OnHealthChange(socket, health)
+	}
+}
@@ -0,0 +1,103 @@
+package socket
+
+import (
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHeartbeat(t *testing.T) {
+	var mockSocket *Socket
+	var responding int32 = 1
+	respond := func(next SendFunc) SendFunc {
+		return func(payload *Payload) error {
+			err := next(payload)
+			if 1 == atomic.LoadInt32(&responding) {
+				mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+					ID:     payload.ID,
+					Error:  &Error{},
+					Result: []byte(`{}`),
+				})
+			}
+			return err
+		}
+	}
+	changes := make(chan Health, 10)
+	socketURL, _ := url.Parse("https://test:9222/TestHeartbeat")
+	mockSocket = NewMock(
+		socketURL,
+		WithCommandMiddleware(respond),
+		WithHeartbeat(&HeartbeatPolicy{
+			Interval:  20 * time.Millisecond,
+			MaxMissed: 2,
+			OnHealthChange: func(socket *Socket, health Health) {
+				changes <- health
+			},
+		}),
+	)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for mockSocket.Health().LastBeat.IsZero() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	health := mockSocket.Health()
+	if !health.Healthy || health.LastBeat.IsZero() || 0 == health.AverageLatency {
+		t.Fatalf("Expected a healthy socket with a heartbeat, received %+v", health)
+	}
+
+	atomic.StoreInt32(&responding, 0)
+	select {
+	case health := <-changes:
+		if health.Healthy || health.Missed < 2 || nil == health.Err {
+			t.Errorf("Expected an unhealthy socket, received %+v", health)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Socket was not declared unhealthy")
+	}
+
+	atomic.StoreInt32(&responding, 1)
+	select {
+	case health := <-changes:
+		if !health.Healthy || 0 != health.Missed {
+			t.Errorf("Expected a healthy socket, received %+v", health)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Socket did not recover")
+	}
+
+	mockSocket.Stop()
+	if mockSocket.Health().Healthy {
+		t.Errorf("Expected a stopped socket to be unhealthy")
+	}
+	select {
+	case health := <-changes:
+		if health.Healthy || ErrSocketClosed != health.Err {
+			t.Errorf("Expected an unhealthy closed socket, received %+v", health)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Health change was not reported for the stopped socket")
+	}
+}
+
+func TestHealthWithoutHeartbeat(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestHealthWithoutHeartbeat")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+
+	mockSocket.SendCommand(NewCommand(mockSocket, "Some.method", nil))
+	health := mockSocket.Health()
+	if !health.Healthy {
+		t.Errorf("Expected a healthy socket")
+	}
+	if 1 != health.Pending {
+		t.Errorf("Expected 1 pending command, received %d", health.Pending)
+	}
+
+	mockSocket.Stop()
+	if mockSocket.Health().Healthy {
+		t.Errorf("Expected a stopped socket to be unhealthy")
+	}
+}
@@ -0,0 +1,139 @@
+package socket
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/inspector"
+)
+
+/*
+LifecycleEventType identifies a change in the state of the debugging target or
+the socket connection.
+*/
+type LifecycleEventType int
+
+const (
+	// TargetCrashed is reported when the target has crashed. The target may
+	// be recovered by reloading it.
+	TargetCrashed LifecycleEventType = iota
+
+	// TargetDetached is reported when the debugging session was detached from
+	// the target.
+	TargetDetached
+
+	// TargetReloaded is reported when the target has been reloaded after a
+	// crash.
+	TargetReloaded
+
+	// SocketClosed is reported when the socket stops listening.
+	SocketClosed
+)
+
+/*
+LifecycleEvent describes a change in the state of the debugging target or the
+socket connection.
+*/
+type LifecycleEvent struct {
+	// Err is ErrTargetCrashed, ErrTargetDetached or ErrSocketClosed. It is
+	// nil for TargetReloaded events.
+	Err error
+
+	// Reason is the reason given by the browser for detaching the session.
+	Reason string
+
+	// Type is the type of the event.
+	Type LifecycleEventType
+}
+
+/*
+Err returns the error that ended the debugging session: ErrTargetCrashed,
+ErrTargetDetached or ErrSocketClosed. It returns nil while the target is
+usable.
+
+While the target is crashed or detached commands fail immediately with a
+TargetError, except Page.reload and Page.navigate which recover a crashed
+target.
+
+Crash notifications are only sent by the browser after the Inspector domain
+has been enabled, see InspectorProtocol.Enable.
+
+Err is a Socketer implementation.
+*/
+func (socket *Socket) Err() error {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	return socket.targetErr
+}
+
+/*
+endSession records the error that ended the debugging session, fails all
+pending commands and notifies the lifecycle handler.
+*/
+func (socket *Socket) endSession(event *LifecycleEvent) {
+	socket.mux.Lock()
+	if nil == socket.targetErr {
+		socket.targetErr = event.Err
+	}
+	socket.mux.Unlock()
+
+	socket.failCommands(socket.commands.List(), event.Err)
+	socket.notifyLifecycle(event)
+}
+
+/*
+commandErr returns the reason a command can't be delivered to the target, if
+any: ErrTargetDetached, or ErrTargetCrashed unless the command reloads the
+crashed target.
+*/
+func (socket *Socket) commandErr(method string) error {
+	switch socket.Err() {
+	case ErrTargetDetached:
+		return ErrTargetDetached
+	case ErrTargetCrashed:
+		if "Page.reload" != method && "Page.navigate" != method {
+			return ErrTargetCrashed
+		}
+	}
+	return nil
+}
+
+/*
+handleLifecycle updates the session state for Inspector domain events.
+*/
+func (socket *Socket) handleLifecycle(response *Response) {
+	switch response.Method {
+	case "Inspector.targetCrashed":
+		socket.logger.Errorf("target crashed")
+		socket.endSession(&LifecycleEvent{
+			Err:  ErrTargetCrashed,
+			Type: TargetCrashed,
+		})
+
+	case "Inspector.detached":
+		event := &inspector.DetachedEvent{}
+		json.Unmarshal([]byte(response.Params), event)
+		socket.logger.Errorf("debugging session detached: %s", event.Reason)
+		socket.endSession(&LifecycleEvent{
+			Err:    ErrTargetDetached,
+			Reason: event.Reason,
+			Type:   TargetDetached,
+		})
+
+	case "Inspector.targetReloadedAfterCrash":
+		socket.mux.Lock()
+		if ErrTargetCrashed == socket.targetErr {
+			socket.targetErr = nil
+		}
+		socket.mux.Unlock()
+		socket.notifyLifecycle(&LifecycleEvent{Type: TargetReloaded})
+	}
+}
+
+/*
+notifyLifecycle passes the event to the lifecycle handler, if any.
+*/
+func (socket *Socket) notifyLifecycle(event *LifecycleEvent) {
+	if nil != socket.lifecycleHandler {
+		go socket.lifecycleHandler(socket, event)
+	}
+}
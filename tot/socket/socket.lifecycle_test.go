@@ -0,0 +1,120 @@
+package socket
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func waitForLifecycle(t *testing.T, events chan *LifecycleEvent, eventType LifecycleEventType) *LifecycleEvent {
+	select {
+	case event := <-events:
+		if eventType != event.Type {
+			t.Errorf("Expected lifecycle event %d, received %d", eventType, event.Type)
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Lifecycle event %d was not received", eventType)
+	}
+	return nil
+}
+
+func TestLifecycleTargetCrashed(t *testing.T) {
+	events := make(chan *LifecycleEvent, 10)
+	socketURL, _ := url.Parse("https://test:9222/TestLifecycleTargetCrashed")
+	mockSocket := NewMock(socketURL, WithLifecycleHandler(func(socket *Socket, event *LifecycleEvent) {
+		events <- event
+	}))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Inspector.targetCrashed",
+		Params: []byte(`{}`),
+	})
+	result := <-resultChan
+	var targetErr *TargetError
+	if !errors.As(result.Err, &targetErr) {
+		t.Errorf("Expected TargetError, received '%v'", result.Err)
+	}
+	if !errors.Is(command.Error(), ErrTargetCrashed) {
+		t.Errorf("Expected ErrTargetCrashed, received '%v'", command.Error())
+	}
+	waitForLifecycle(t, events, TargetCrashed)
+	if ErrTargetCrashed != mockSocket.Err() {
+		t.Errorf("Expected ErrTargetCrashed, received '%v'", mockSocket.Err())
+	}
+
+	// New commands fail without being sent, except a reload.
+	result = <-mockSocket.SendCommand(NewCommand(mockSocket, "Some.method", nil))
+	if !errors.As(result.Err, &targetErr) || !errors.Is(result.Err, ErrTargetCrashed) {
+		t.Errorf("Expected ErrTargetCrashed, received '%v'", result.Err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	result = <-mockSocket.SendCommandContext(ctx, NewCommand(mockSocket, "Page.reload", nil))
+	cancel()
+	if !errors.Is(result.Err, context.DeadlineExceeded) {
+		t.Errorf("Expected the reload to be sent, received '%v'", result.Err)
+	}
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Inspector.targetReloadedAfterCrash",
+		Params: []byte(`{}`),
+	})
+	waitForLifecycle(t, events, TargetReloaded)
+	if nil != mockSocket.Err() {
+		t.Errorf("Expected nil, received '%v'", mockSocket.Err())
+	}
+}
+
+func TestLifecycleTargetDetached(t *testing.T) {
+	events := make(chan *LifecycleEvent, 10)
+	socketURL, _ := url.Parse("https://test:9222/TestLifecycleTargetDetached")
+	mockSocket := NewMock(socketURL, WithLifecycleHandler(func(socket *Socket, event *LifecycleEvent) {
+		events <- event
+	}))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Inspector.detached",
+		Params: []byte(`{"reason":"target_closed"}`),
+	})
+	event := waitForLifecycle(t, events, TargetDetached)
+	if "target_closed" != event.Reason {
+		t.Errorf("Expected reason 'target_closed', received '%s'", event.Reason)
+	}
+	if ErrTargetDetached != mockSocket.Err() {
+		t.Errorf("Expected ErrTargetDetached, received '%v'", mockSocket.Err())
+	}
+
+	result := <-mockSocket.SendCommand(NewCommand(mockSocket, "Page.reload", nil))
+	if !errors.Is(result.Err, ErrTargetDetached) {
+		t.Errorf("Expected ErrTargetDetached, received '%v'", result.Err)
+	}
+}
+
+func TestLifecycleSocketClosed(t *testing.T) {
+	events := make(chan *LifecycleEvent, 10)
+	socketURL, _ := url.Parse("https://test:9222/TestLifecycleSocketClosed")
+	mockSocket := NewMock(socketURL, WithLifecycleHandler(func(socket *Socket, event *LifecycleEvent) {
+		events <- event
+	}))
+	mockSocket.Listen()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Stop()
+	result := <-resultChan
+	if !errors.Is(result.Err, ErrSocketClosed) {
+		t.Errorf("Expected ErrSocketClosed, received '%v'", result.Err)
+	}
+	waitForLifecycle(t, events, SocketClosed)
+	if ErrSocketClosed != mockSocket.Err() {
+		t.Errorf("Expected ErrSocketClosed, received '%v'", mockSocket.Err())
+	}
+}
@@ -0,0 +1,56 @@
+package socket
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+/*
+NewLogrusLogger returns a Logger that writes to the provided logrus logger.
+Sockets use the logrus standard logger unless a logger is provided with
+WithLogger.
+*/
+func NewLogrusLogger(logger log.FieldLogger) Logger {
+	return &logrusLogger{FieldLogger: logger}
+}
+
+/*
+logrusLogger adapts a logrus.FieldLogger to the Logger interface.
+*/
+type logrusLogger struct {
+	log.FieldLogger
+}
+
+/*
+WithFields is a Logger implementation.
+*/
+func (logger *logrusLogger) WithFields(fields Fields) Logger {
+	return &logrusLogger{FieldLogger: logger.FieldLogger.WithFields(log.Fields(fields))}
+}
+
+/*
+NewNopLogger returns a Logger that discards all messages.
+*/
+func NewNopLogger() Logger {
+	return nopLogger{}
+}
+
+/*
+nopLogger is a Logger implementation that discards all messages.
+*/
+type nopLogger struct{}
+
+func (nopLogger) Debugf(format string, args ...interface{}) {}
+func (nopLogger) Errorf(format string, args ...interface{}) {}
+func (nopLogger) Infof(format string, args ...interface{})  {}
+func (nopLogger) Warnf(format string, args ...interface{})  {}
+func (nopLogger) WithFields(fields Fields) Logger           { return nopLogger{} }
+
+/*
+commandLogger returns the socket logger with the command ID and method fields.
+*/
+func (socket *Socket) commandLogger(command Commander) Logger {
+	return socket.logger.WithFields(Fields{
+		"command": command.ID(),
+		"method":  command.Method(),
+	})
+}
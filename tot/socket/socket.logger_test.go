@@ -0,0 +1,84 @@
+package socket
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+type mockLogger struct {
+	fields   Fields
+	messages *[]string
+	mux      *sync.Mutex
+}
+
+func (logger *mockLogger) log(level, format string, args ...interface{}) {
+	logger.mux.Lock()
+	defer logger.mux.Unlock()
+	*logger.messages = append(*logger.messages, fmt.Sprintf(
+		"%s %v %s",
+		level,
+		logger.fields,
+		fmt.Sprintf(format, args...),
+	))
+}
+
+func (logger *mockLogger) Debugf(format string, args ...interface{}) {
+	logger.log("debug", format, args...)
+}
+func (logger *mockLogger) Errorf(format string, args ...interface{}) {
+	logger.log("error", format, args...)
+}
+func (logger *mockLogger) Infof(format string, args ...interface{}) {
+	logger.log("info", format, args...)
+}
+func (logger *mockLogger) Warnf(format string, args ...interface{}) {
+	logger.log("warn", format, args...)
+}
+func (logger *mockLogger) WithFields(fields Fields) Logger {
+	merged := Fields{}
+	for k, v := range logger.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return &mockLogger{fields: merged, messages: logger.messages, mux: logger.mux}
+}
+
+func TestWithLogger(t *testing.T) {
+	messages := []string{}
+	logger := &mockLogger{fields: Fields{}, messages: &messages, mux: &sync.Mutex{}}
+	socketURL, _ := url.Parse("https://test:9222/TestWithLogger")
+	mockSocket := NewMock(socketURL, WithLogger(logger))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Result: []byte(`"Mock Command Result"`),
+	})
+	<-resultChan
+	time.Sleep(50 * time.Millisecond)
+
+	expected := fmt.Sprintf(
+		"debug %v command complete",
+		Fields{"command": command.ID(), "method": "Some.method", "socket": mockSocket.socketID},
+	)
+	logger.mux.Lock()
+	defer logger.mux.Unlock()
+	for _, message := range messages {
+		if expected == message {
+			return
+		}
+		if "info" == message[:4] {
+			t.Errorf("Unexpected info message '%s'", message)
+		}
+	}
+	t.Errorf("Expected message '%s', received %v", expected, messages)
+}
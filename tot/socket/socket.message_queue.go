@@ -0,0 +1,105 @@
+package socket
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+/*
+newMessageQueue returns an empty, open message queue.
+*/
+func newMessageQueue() *messageQueue {
+	return &messageQueue{
+		closed: make(chan struct{}),
+		mux:    &sync.Mutex{},
+		ready:  make(chan struct{}, 1),
+	}
+}
+
+/*
+messageQueue holds the messages read by a WebSocketer that isn't backed by a
+network connection, see ReplayWebSocket and sessionConn.
+*/
+type messageQueue struct {
+	closed   chan struct{}
+	messages []*Response
+	mux      *sync.Mutex
+	ready    chan struct{}
+}
+
+/*
+close closes the queue and discards the queued messages, a pending read
+returns ErrSocketClosed. It returns false if the queue was already closed.
+*/
+func (queue *messageQueue) close() bool {
+	queue.mux.Lock()
+	defer queue.mux.Unlock()
+	select {
+	case <-queue.closed:
+		return false
+	default:
+	}
+	close(queue.closed)
+	queue.messages = nil
+	return true
+}
+
+/*
+done returns a channel that is closed when the queue is closed.
+*/
+func (queue *messageQueue) done() <-chan struct{} {
+	return queue.closed
+}
+
+/*
+push queues messages to be read, unless the queue is closed.
+*/
+func (queue *messageQueue) push(messages ...*Response) {
+	queue.mux.Lock()
+	select {
+	case <-queue.closed:
+		queue.mux.Unlock()
+		return
+	default:
+	}
+	queue.messages = append(queue.messages, messages...)
+	queue.mux.Unlock()
+
+	select {
+	case queue.ready <- struct{}{}:
+	default:
+	}
+}
+
+/*
+read waits for the next message and unmarshalls it into the provided
+variable.
+*/
+func (queue *messageQueue) read(v interface{}) error {
+	for {
+		select {
+		case <-queue.closed:
+			return ErrSocketClosed
+		default:
+		}
+
+		queue.mux.Lock()
+		if len(queue.messages) > 0 {
+			message := queue.messages[0]
+			queue.messages[0] = nil
+			queue.messages = queue.messages[1:]
+			queue.mux.Unlock()
+			data, err := json.Marshal(message)
+			if nil != err {
+				return err
+			}
+			return json.Unmarshal(data, &v)
+		}
+		queue.mux.Unlock()
+
+		select {
+		case <-queue.ready:
+		case <-queue.closed:
+		}
+	}
+}
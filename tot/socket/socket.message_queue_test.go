@@ -0,0 +1,45 @@
+package socket
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMessageQueue(t *testing.T) {
+	queue := newMessageQueue()
+
+	// A read waits for a message.
+	read := make(chan *Response, 1)
+	go func() {
+		response := &Response{}
+		if err := queue.read(&response); nil != err {
+			t.Errorf("Expected nil, received error: %v", err)
+		}
+		read <- response
+	}()
+	queue.push(&Response{ID: 1}, &Response{ID: 2})
+	select {
+	case response := <-read:
+		if 1 != response.ID {
+			t.Errorf("Expected #1, received #%d", response.ID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Message was not read")
+	}
+
+	response := &Response{}
+	if err := queue.read(&response); nil != err || 2 != response.ID {
+		t.Errorf("Expected #2, received #%d: %v", response.ID, err)
+	}
+
+	if !queue.close() {
+		t.Errorf("Expected the queue to be closed")
+	}
+	if queue.close() {
+		t.Errorf("Expected the queue to be closed once")
+	}
+	queue.push(&Response{ID: 3})
+	if err := queue.read(&response); ErrSocketClosed != err {
+		t.Errorf("Expected ErrSocketClosed, received '%v'", err)
+	}
+}
@@ -0,0 +1,63 @@
+package socket
+
+/*
+SendFunc writes a command payload to the websocket connection.
+*/
+type SendFunc func(payload *Payload) error
+
+/*
+CommandMiddleware wraps the function that writes command payloads to the
+websocket connection. A middleware can inspect or modify the payload before
+calling next, or return an error without calling next to prevent the payload
+from being written, in which case the command fails with a TransportError
+wrapping that error. Resent commands pass through the middleware again after
+a reconnection. Middleware sees the original payload of commands that exceed
+MaxPayloadSize, the commands sent by the PayloadStrategy pass through the
+middleware separately.
+
+	func logCommands(next socket.SendFunc) socket.SendFunc {
+		return func(payload *socket.Payload) error {
+			log.Printf("sending #%d %s", payload.ID, payload.Method)
+			return next(payload)
+		}
+	}
+*/
+type CommandMiddleware func(next SendFunc) SendFunc
+
+/*
+DispatchFunc delivers a message read from the websocket connection to the
+command, event or unknown message handlers.
+*/
+type DispatchFunc func(response *Response)
+
+/*
+ResponseMiddleware wraps the function that delivers messages read from the
+websocket connection. A middleware can inspect or modify the response before
+calling next, or drop it by not calling next. Middleware is called from the
+socket read loop so it should return quickly.
+*/
+type ResponseMiddleware func(next DispatchFunc) DispatchFunc
+
+/*
+dispatch delivers a message read from the websocket connection through the
+response middleware chain.
+*/
+func (socket *Socket) dispatch(response *Response) {
+	dispatch := DispatchFunc(socket.handleMessage)
+	for a := len(socket.responseMiddleware) - 1; a >= 0; a-- {
+		dispatch = socket.responseMiddleware[a](dispatch)
+	}
+	dispatch(response)
+}
+
+/*
+send writes a command payload to the websocket connection through the command
+middleware chain.
+*/
+func (socket *Socket) send(payload *Payload) error {
+	send := SendFunc(socket.writePayload)
+	for a := len(socket.commandMiddleware) - 1; a >= 0; a-- {
+		send = socket.commandMiddleware[a](send)
+	}
+	return send(payload)
+}
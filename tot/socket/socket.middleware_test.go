@@ -0,0 +1,107 @@
+package socket
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCommandMiddleware(t *testing.T) {
+	var calls []string
+	payloads := make(chan *Payload, 1)
+	first := func(next SendFunc) SendFunc {
+		return func(payload *Payload) error {
+			calls = append(calls, "first")
+			payload.Method = "Rewritten.method"
+			return next(payload)
+		}
+	}
+	second := func(next SendFunc) SendFunc {
+		return func(payload *Payload) error {
+			calls = append(calls, "second")
+			payloads <- payload
+			return next(payload)
+		}
+	}
+
+	socketURL, _ := url.Parse("https://test:9222/TestCommandMiddleware")
+	mockSocket := NewMock(socketURL, WithCommandMiddleware(first, second))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockSocket.SendCommand(NewCommand(mockSocket, "Some.method", nil))
+	select {
+	case payload := <-payloads:
+		if "Rewritten.method" != payload.Method {
+			t.Errorf("Expected 'Rewritten.method', received '%s'", payload.Method)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Payload was not sent")
+	}
+	if 2 != len(calls) || "first" != calls[0] || "second" != calls[1] {
+		t.Errorf("Expected middleware to be called in order, received %v", calls)
+	}
+}
+
+func TestCommandMiddlewareError(t *testing.T) {
+	mockErr := fmt.Errorf("mock rate limit")
+	reject := func(next SendFunc) SendFunc {
+		return func(payload *Payload) error {
+			return mockErr
+		}
+	}
+
+	socketURL, _ := url.Parse("https://test:9222/TestCommandMiddlewareError")
+	mockSocket := NewMock(socketURL, WithCommandMiddleware(reject))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	result := <-mockSocket.SendCommand(NewCommand(mockSocket, "Some.method", nil))
+	var transportErr *TransportError
+	if !errors.As(result.Err, &transportErr) {
+		t.Errorf("Expected TransportError, received '%v'", result.Err)
+	}
+	if !errors.Is(result.Err, mockErr) {
+		t.Errorf("Expected '%v', received '%v'", mockErr, result.Err)
+	}
+}
+
+func TestResponseMiddleware(t *testing.T) {
+	dropEvents := func(next DispatchFunc) DispatchFunc {
+		return func(response *Response) {
+			if "Dropped.event" == response.Method {
+				return
+			}
+			next(response)
+		}
+	}
+
+	socketURL, _ := url.Parse("https://test:9222/TestResponseMiddleware")
+	mockSocket := NewMock(socketURL, WithResponseMiddleware(dropEvents))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan string, 10)
+	for _, method := range []string{"Dropped.event", "Test.event"} {
+		mockSocket.AddEventHandler(NewEventHandler(method, func(response *Response) {
+			resultChan <- response.Method
+		}))
+	}
+	for _, method := range []string{"Dropped.event", "Test.event"} {
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: method,
+		})
+	}
+
+	select {
+	case method := <-resultChan:
+		if "Test.event" != method {
+			t.Errorf("Expected 'Test.event', received '%s'", method)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+}
@@ -0,0 +1,201 @@
+package socket
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+Option defines a functional option used to configure a Socket. Options are
+passed to New and applied before the socket starts listening.
+*/
+type Option func(socket *Socket)
+
+/*
+WithCommandMiddleware appends middleware to the chain that wraps writing
+command payloads to the websocket connection. Middleware is called in the
+order it was added, the first middleware added is the outermost.
+*/
+func WithCommandMiddleware(middleware ...CommandMiddleware) Option {
+	return func(socket *Socket) {
+		socket.commandMiddleware = append(socket.commandMiddleware, middleware...)
+	}
+}
+
+/*
+WithCommandTimeout sets the default timeout of commands sent without a
+deadline: the generated protocol methods, Call, SendCommand and
+SendCommandContext with a context that has no deadline. WithDomainTimeout and WithMethodTimeout set the timeout of a domain or a
+single method, a method timeout takes precedence over a domain timeout. When
+a timeout expires the command fails with a TimeoutError and a late response
+is discarded.
+
+	socket.New(
+		websocketURL,
+		socket.WithCommandTimeout(30*time.Second),
+		socket.WithDomainTimeout("DOM", 5*time.Second),
+		socket.WithMethodTimeout("Page.printToPDF", 60*time.Second),
+	)
+*/
+func WithCommandTimeout(timeout time.Duration) Option {
+	return func(socket *Socket) {
+		socket.defaultTimeout = timeout
+	}
+}
+
+/*
+WithDomainTimeout sets the default timeout of the commands of a protocol
+domain, for example "DOM". See WithCommandTimeout.
+*/
+func WithDomainTimeout(domain string, timeout time.Duration) Option {
+	return func(socket *Socket) {
+		socket.setCommandTimeout(domain, timeout)
+	}
+}
+
+/*
+WithHeartbeat enables connection health monitoring using the provided policy.
+See HeartbeatPolicy and Socket.Health for details.
+
+	socket.New(websocketURL, socket.WithHeartbeat(&socket.HeartbeatPolicy{
+		Interval: 5 * time.Second,
+		OnHealthChange: func(s *socket.Socket, health socket.Health) {
+			pool.SetHealthy(s, health.Healthy)
+		},
+	}))
+*/
+func WithHeartbeat(policy *HeartbeatPolicy) Option {
+	return func(socket *Socket) {
+		socket.heartbeatPolicy = policy
+	}
+}
+
+/*
+WithLifecycleHandler registers a handler that is called in a new goroutine
+when the target crashes, is reloaded after a crash, the debugging session is
+detached or the socket stops listening. Commands waiting for a response when
+the target crashes or the session ends are failed before the handler is
+called.
+*/
+func WithLifecycleHandler(handler func(socket *Socket, event *LifecycleEvent)) Option {
+	return func(socket *Socket) {
+		socket.lifecycleHandler = handler
+	}
+}
+
+/*
+WithLogger sets the logger used by the socket. The socket adds its own fields
+to the logger, use NewNopLogger to silence it. Defaults to the logrus standard
+logger.
+*/
+func WithLogger(logger Logger) Option {
+	return func(socket *Socket) {
+		socket.logger = logger
+	}
+}
+
+/*
+WithMethodTimeout sets the default timeout of a protocol method, for example
+"Page.printToPDF". See WithCommandTimeout.
+*/
+func WithMethodTimeout(method string, timeout time.Duration) Option {
+	return func(socket *Socket) {
+		socket.setCommandTimeout(method, timeout)
+	}
+}
+
+/*
+WithOrderedEvents enables ordered event delivery. Instead of executing each
+handler in a new goroutine per event, every handler is given a queue of the
+specified size that is processed by a single goroutine, so events are handled
+in the order they were received from the websocket. When a queue is full the
+overflow policy is applied and any discarded events are counted, see
+DroppedEvents. BlockOnOverflow deadlocks the socket if a handler waits for a
+command response while its queue is full, DropOldestOnOverflow is the safe
+choice for handlers that send commands.
+*/
+func WithOrderedEvents(queueSize int, overflow OverflowPolicy) Option {
+	return func(socket *Socket) {
+		if queueSize < 1 {
+			queueSize = 1
+		}
+		socket.eventQueueSize = queueSize
+		socket.eventOverflow = overflow
+		socket.eventQueues = make(map[EventHandler]*eventQueue)
+		socket.eventQueueMux = &sync.Mutex{}
+	}
+}
+
+/*
+WithPayloadStrategy sets the strategy used to deliver commands for the
+specified method whose payload exceeds MaxPayloadSize, replacing any default
+strategy. See PayloadStrategy.
+*/
+func WithPayloadStrategy(method string, strategy PayloadStrategy) Option {
+	return func(socket *Socket) {
+		if nil == socket.payloadStrategies {
+			socket.payloadStrategies = make(map[string]PayloadStrategy)
+		}
+		socket.payloadStrategies[method] = strategy
+	}
+}
+
+/*
+WithReconnect enables automatic reconnection using the provided policy. See
+ReconnectPolicy for details.
+*/
+func WithReconnect(policy *ReconnectPolicy) Option {
+	return func(socket *Socket) {
+		socket.reconnectPolicy = policy
+	}
+}
+
+/*
+WithRecorder records every command payload written to and every message read
+from the connection of the socket, see Recorder. A recorder can be shared by
+several sockets, entries include the socket ID. The messages of flattened
+sessions are recorded by the socket that owns the connection.
+
+	file, _ := os.Create("session.ndjson")
+	defer file.Close()
+	socket.New(websocketURL, socket.WithRecorder(socket.NewRecorder(file)))
+*/
+func WithRecorder(recorder *Recorder) Option {
+	return func(socket *Socket) {
+		socket.recorder = recorder
+	}
+}
+
+/*
+WithResponseMiddleware appends middleware to the chain that wraps delivering
+messages read from the websocket connection to the command and event
+handlers. Middleware is called in the order it was added, the first
+middleware added is the outermost.
+*/
+func WithResponseMiddleware(middleware ...ResponseMiddleware) Option {
+	return func(socket *Socket) {
+		socket.responseMiddleware = append(socket.responseMiddleware, middleware...)
+	}
+}
+
+/*
+WithWebSocketer connects the socket using the provided connection instead of
+dialing the socket URL, for example a ChromePipe. The connection can only be
+established once, reconnecting after it is lost fails.
+*/
+func WithWebSocketer(conn WebSocketer) Option {
+	return func(socket *Socket) {
+		used := false
+		socket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+			if used {
+				return nil, errs.New(0, fmt.Sprintf("%s connection cannot be re-established", socketURL))
+			}
+			used = true
+			return conn, nil
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
+
+/*
+MaxPayloadSize is the largest command payload, in bytes, Chrome accepts over
+the websocket connection.
+
+See https://chromium.googlesource.com/chromium/src/+/master/net/server/http_connection.h#33
+*/
+const MaxPayloadSize = 1 * 1024 * 1024
+
+/*
+payloadChunkSize is the size of the string chunks uploaded by the large
+payload strategies. JSON encoding can expand a string up to six times, so
+this leaves room for the worst case.
+*/
+const payloadChunkSize = 128 * 1024
+
+/*
+PayloadStrategy delivers a command whose payload exceeds MaxPayloadSize. It
+may send any number of smaller commands to prepare the target and returns the
+payload to write in place of the original. The replacement payload must keep
+the original command ID so the response is delivered to the original command.
+The provided strategies that call a function deliver the response themselves
+and return nil.
+
+Strategies are provided for DOM.setFileInputFiles, DOM.setOuterHTML,
+Page.setDocumentContent and Runtime.evaluate, other methods can be supported
+with WithPayloadStrategy. If no strategy applies the command fails with
+ErrPayloadTooLarge. Strategies aren't applied over the remote debugging pipe,
+which has no message size limit.
+
+The strategies upload the oversized string to the browser in chunks and
+replace the command with a Runtime.evaluate call that reads it, or call a
+function that reads it with Runtime.callFunctionOn. File paths are set in
+chunks on temporary file inputs instead, and the function moves the files to
+the original input. A JavaScript exception thrown by the function is reported
+to the original command as an *Error.
+*/
+type PayloadStrategy func(socket *Socket, payload *Payload) (*Payload, error)
+
+/*
+defaultPayloadStrategy returns the default large payload strategy for a
+method, if any.
+*/
+func defaultPayloadStrategy(method string) (PayloadStrategy, bool) {
+	switch method {
+	case "DOM.setFileInputFiles":
+		return setFileInputFilesStrategy, true
+	case "DOM.setOuterHTML":
+		return setOuterHTMLStrategy, true
+	case "Page.setDocumentContent":
+		return setDocumentContentStrategy, true
+	case "Runtime.evaluate":
+		return evaluateStrategy, true
+	}
+	return nil, false
+}
+
+/*
+callReplacement calls the function that replaces a command with a large
+payload and delivers the outcome to the original command, which has no
+result. A JavaScript exception thrown by the function or a protocol error is
+delivered as an *Error. It returns a nil payload, nothing is left to write.
+*/
+func (socket *Socket) callReplacement(payload *Payload, params *runtime.CallFunctionOnParams) (*Payload, error) {
+	response := &Response{ID: payload.ID, Result: json.RawMessage(`{}`)}
+
+	result := &runtime.CallFunctionOnResult{}
+	err := socket.Call(context.Background(), "Runtime.callFunctionOn", params, result)
+	if protocolErr, ok := err.(*Error); ok {
+		response.Error = &Error{Code: protocolErr.Code, Data: protocolErr.Data, Message: protocolErr.Message}
+	} else if nil != err {
+		return nil, err
+	} else if nil != result.ExceptionDetails {
+		data, _ := json.Marshal(result.ExceptionDetails)
+		response.Error = &Error{
+			Code:    ErrServer.Code,
+			Data:    data,
+			Message: exceptionMessage(result.ExceptionDetails),
+		}
+	}
+
+	socket.dispatch(response)
+	return nil, nil
+}
+
+/*
+decodeParams unmarshals the payload parameters into the provided struct.
+*/
+func decodeParams(payload *Payload, params interface{}) error {
+	data, err := json.Marshal(payload.Params)
+	if nil != err {
+		return errs.Wrap(err, 0, fmt.Sprintf("could not encode %s parameters", payload.Method))
+	}
+	return json.Unmarshal(data, params)
+}
+
+/*
+evaluateStrategy uploads the expression to the global object of the
+execution context and replaces the command with an evaluation of the uploaded
+expression. Declarations in the expression are scoped to the evaluation.
+*/
+func evaluateStrategy(socket *Socket, payload *Payload) (*Payload, error) {
+	params := &runtime.EvaluateParams{}
+	if err := decodeParams(payload, params); nil != err {
+		return nil, err
+	}
+
+	global := &runtime.EvaluateResult{}
+	err := socket.Call(context.Background(), "Runtime.evaluate", &runtime.EvaluateParams{
+		ContextID:  params.ContextID,
+		Expression: "this",
+	}, global)
+	if nil != err {
+		return nil, err
+	}
+
+	property := payloadProperty(payload)
+	if err := socket.upload(remoteObjectID(global.Result), property, params.Expression); nil != err {
+		return nil, err
+	}
+
+	params.Expression = fmt.Sprintf(
+		`(0, eval)((function(property) { var expression = this[property].join(""); delete this[property]; return expression; }).call(this, %q))`,
+		property,
+	)
+	return &Payload{ID: payload.ID, Method: payload.Method, Params: params}, nil
+}
+
+/*
+exceptionMessage returns the message of a JavaScript exception.
+*/
+func exceptionMessage(details *runtime.ExceptionDetails) string {
+	if nil != details.Exception && "" != details.Exception.Description {
+		return details.Exception.Description
+	}
+	return details.Text
+}
+
+/*
+payloadLimited returns whether command payloads are limited to
+MaxPayloadSize. The remote debugging pipe has no message size limit.
+*/
+func (socket *Socket) payloadLimited() bool {
+	root := socket.sessionRoot()
+	root.mux.Lock()
+	defer root.mux.Unlock()
+	_, pipe := root.conn.(*ChromePipe)
+	return !pipe
+}
+
+/*
+payloadProperty returns the name of the property used to upload a large
+payload.
+*/
+func payloadProperty(payload *Payload) string {
+	return fmt.Sprintf("__goChromePayload%d", payload.ID)
+}
+
+/*
+remoteObjectID returns the ID of a remote object, if any.
+*/
+func remoteObjectID(object *runtime.RemoteObject) runtime.RemoteObjectID {
+	if nil == object {
+		return ""
+	}
+	return object.ObjectID
+}
+
+/*
+setDocumentContentStrategy uploads the HTML to the frame document in an
+isolated world and replaces the command with a call that writes it to the
+document.
+*/
+func setDocumentContentStrategy(socket *Socket, payload *Payload) (*Payload, error) {
+	params := &page.SetDocumentContentParams{}
+	if err := decodeParams(payload, params); nil != err {
+		return nil, err
+	}
+
+	world := &page.CreateIsolatedWorldResult{}
+	err := socket.Call(context.Background(), "Page.createIsolatedWorld", &page.CreateIsolatedWorldParams{
+		FrameID: params.FrameID,
+	}, world)
+	if nil != err {
+		return nil, err
+	}
+
+	document := &runtime.EvaluateResult{}
+	err = socket.Call(context.Background(), "Runtime.evaluate", &runtime.EvaluateParams{
+		ContextID:  world.ExecutionContextID,
+		Expression: "document",
+	}, document)
+	if nil != err {
+		return nil, err
+	}
+
+	property := payloadProperty(payload)
+	if err := socket.upload(remoteObjectID(document.Result), property, params.HTML); nil != err {
+		return nil, err
+	}
+
+	return socket.callReplacement(payload, &runtime.CallFunctionOnParams{
+		Arguments:           []*runtime.CallArgument{{Value: property}},
+		FunctionDeclaration: `function(property) { var html = this[property].join(""); delete this[property]; this.open(); this.write(html); this.close(); }`,
+		ObjectID:            document.Result.ObjectID,
+	})
+}
+
+/*
+setFileInputFilesStrategy sets the files in chunks on temporary file inputs
+stored on the input node and replaces the command with a call that moves the
+files of the temporary inputs to the input node.
+*/
+func setFileInputFilesStrategy(socket *Socket, payload *Payload) (*Payload, error) {
+	params := &dom.SetFileInputFilesParams{}
+	if err := decodeParams(payload, params); nil != err {
+		return nil, err
+	}
+
+	objectID := params.ObjectID
+	if "" == objectID {
+		node := &dom.ResolveNodeResult{}
+		err := socket.Call(context.Background(), "DOM.resolveNode", &dom.ResolveNodeParams{
+			BackendNodeID: params.BackendNodeID,
+			NodeID:        params.NodeID,
+		}, node)
+		if nil != err {
+			return nil, err
+		}
+		objectID = remoteObjectID(node.Object)
+	}
+	if "" == objectID {
+		return nil, errs.New(0, "could not set file input files: remote object not found")
+	}
+
+	property := payloadProperty(payload)
+	for _, files := range splitPaths(params.Files) {
+		input := &runtime.CallFunctionOnResult{}
+		err := socket.Call(context.Background(), "Runtime.callFunctionOn", &runtime.CallFunctionOnParams{
+			Arguments:           []*runtime.CallArgument{{Value: property}},
+			FunctionDeclaration: `function(property) { var input = this.ownerDocument.createElement("input"); input.type = "file"; input.multiple = true; (this[property] = this[property] || []).push(input); return input; }`,
+			ObjectID:            objectID,
+		}, input)
+		if nil != err {
+			return nil, errs.Wrap(err, 0, "could not create file input")
+		}
+		if nil != input.ExceptionDetails {
+			return nil, errs.New(0, fmt.Sprintf("could not create file input: %s", input.ExceptionDetails.Text))
+		}
+		if "" == remoteObjectID(input.Result) {
+			return nil, errs.New(0, "could not create file input: remote object not found")
+		}
+
+		err = socket.Call(context.Background(), "DOM.setFileInputFiles", &dom.SetFileInputFilesParams{
+			Files:    files,
+			ObjectID: input.Result.ObjectID,
+		}, nil)
+		if nil != err {
+			return nil, errs.Wrap(err, 0, "could not set file input files chunk")
+		}
+	}
+
+	return socket.callReplacement(payload, &runtime.CallFunctionOnParams{
+		Arguments:           []*runtime.CallArgument{{Value: property}},
+		FunctionDeclaration: `function(property) { var transfer = new DataTransfer(); (this[property] || []).forEach(function(input) { Array.prototype.forEach.call(input.files, function(file) { transfer.items.add(file); }); }); delete this[property]; this.files = transfer.files; this.dispatchEvent(new Event("input", {bubbles: true})); this.dispatchEvent(new Event("change", {bubbles: true})); }`,
+		ObjectID:            objectID,
+	})
+}
+
+/*
+setOuterHTMLStrategy uploads the HTML to the node and replaces the command
+with a call that sets the node's outerHTML property.
+*/
+func setOuterHTMLStrategy(socket *Socket, payload *Payload) (*Payload, error) {
+	params := &dom.SetOuterHTMLParams{}
+	if err := decodeParams(payload, params); nil != err {
+		return nil, err
+	}
+
+	node := &dom.ResolveNodeResult{}
+	err := socket.Call(context.Background(), "DOM.resolveNode", &dom.ResolveNodeParams{
+		NodeID: params.NodeID,
+	}, node)
+	if nil != err {
+		return nil, err
+	}
+
+	property := payloadProperty(payload)
+	if err := socket.upload(remoteObjectID(node.Object), property, params.OuterHTML); nil != err {
+		return nil, err
+	}
+
+	return socket.callReplacement(payload, &runtime.CallFunctionOnParams{
+		Arguments:           []*runtime.CallArgument{{Value: property}},
+		FunctionDeclaration: `function(property) { var html = this[property].join(""); delete this[property]; this.outerHTML = html; }`,
+		ObjectID:            node.Object.ObjectID,
+	})
+}
+
+/*
+splitPaths splits a list of file paths into chunks that fit in a websocket
+payload.
+*/
+func splitPaths(paths []string) [][]string {
+	chunks := make([][]string, 0)
+	start, size := 0, 0
+	for a, path := range paths {
+		// Quotes and separator.
+		pathSize := len(path) + 3
+		if a > start && size+pathSize > payloadChunkSize {
+			chunks = append(chunks, paths[start:a])
+			start, size = a, 0
+		}
+		size += pathSize
+	}
+	if start < len(paths) {
+		chunks = append(chunks, paths[start:])
+	}
+	return chunks
+}
+
+/*
+upload appends the value to an array stored in the property of the remote
+object in chunks that fit in a websocket payload.
+*/
+func (socket *Socket) upload(objectID runtime.RemoteObjectID, property string, value string) error {
+	if "" == objectID {
+		return errs.New(0, "could not upload payload: remote object not found")
+	}
+	for len(value) > 0 {
+		size := payloadChunkSize
+		if size >= len(value) {
+			size = len(value)
+		}
+		for size < len(value) && !utf8.RuneStart(value[size]) {
+			size--
+		}
+
+		result := &runtime.CallFunctionOnResult{}
+		err := socket.Call(context.Background(), "Runtime.callFunctionOn", &runtime.CallFunctionOnParams{
+			Arguments: []*runtime.CallArgument{
+				{Value: property},
+				{Value: value[:size]},
+			},
+			FunctionDeclaration: `function(property, chunk) { (this[property] = this[property] || []).push(chunk); }`,
+			ObjectID:            objectID,
+		}, result)
+		if nil != err {
+			return errs.Wrap(err, 0, "could not upload payload chunk")
+		}
+		if nil != result.ExceptionDetails {
+			return errs.New(0, fmt.Sprintf("could not upload payload chunk: %s", result.ExceptionDetails.Text))
+		}
+		value = value[size:]
+	}
+	return nil
+}
+
+/*
+writePayload writes a command payload to the websocket connection, applying a
+large payload strategy if the payload exceeds MaxPayloadSize.
+*/
+func (socket *Socket) writePayload(payload *Payload) error {
+	data, err := json.Marshal(payload)
+	if nil != err {
+		return errs.Wrap(err, 0, fmt.Sprintf("could not encode %s payload", payload.Method))
+	}
+
+	if len(data) > MaxPayloadSize {
+		// Connect first to tell whether the connection is the pipe.
+		if _, err = socket.writeConn(); nil != err {
+			return err
+		}
+		if !socket.payloadLimited() {
+			return socket.WriteJSON(payload)
+		}
+
+		strategy, ok := socket.payloadStrategies[payload.Method]
+		if !ok {
+			strategy, ok = defaultPayloadStrategy(payload.Method)
+		}
+		if !ok {
+			return ErrPayloadTooLarge
+		}
+		socket.logger.WithFields(Fields{"command": payload.ID, "method": payload.Method}).Debugf(
+			"payload size %d exceeds the maximum, applying large payload strategy",
+			len(data),
+		)
+		if payload, err = strategy(socket, payload); nil != err {
+			return err
+		}
+		if nil == payload {
+			return nil
+		}
+	}
+
+	return socket.WriteJSON(payload)
+}
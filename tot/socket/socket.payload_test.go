@@ -0,0 +1,295 @@
+package socket
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
+
+type recordedPayload struct {
+	ID     int             `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	size   int
+}
+
+/*
+payloadWebSocket is a WebSocketer that records written payloads and responds
+to each of them. A Runtime.callFunctionOn call of a function containing throw
+responds with an exception.
+*/
+type payloadWebSocket struct {
+	mux       *sync.Mutex
+	payloads  []*recordedPayload
+	responses chan *Response
+	throw     string
+}
+
+func (socket *payloadWebSocket) Close() error {
+	return nil
+}
+
+func (socket *payloadWebSocket) ReadJSON(v interface{}) error {
+	var data interface{}
+	select {
+	case data = <-socket.responses:
+	case <-time.After(10 * time.Millisecond):
+		data = &Response{Method: "Unknown.event"}
+	}
+	jsonBytes, _ := json.Marshal(data)
+	return json.Unmarshal(jsonBytes, &v)
+}
+
+func (socket *payloadWebSocket) WriteJSON(v interface{}) error {
+	jsonBytes, _ := json.Marshal(v)
+	payload := &recordedPayload{size: len(jsonBytes)}
+	json.Unmarshal(jsonBytes, payload)
+	socket.mux.Lock()
+	socket.payloads = append(socket.payloads, payload)
+	socket.mux.Unlock()
+
+	result := `{}`
+	switch payload.Method {
+	case "DOM.resolveNode":
+		result = `{"object":{"objectId":"node"}}`
+	case "Runtime.callFunctionOn":
+		result = `{"result":{"objectId":"input"}}`
+		params := &runtime.CallFunctionOnParams{}
+		json.Unmarshal(payload.Params, params)
+		if "" != socket.throw && strings.Contains(params.FunctionDeclaration, socket.throw) {
+			result = `{"result":{"type":"object"},"exceptionDetails":{"text":"Uncaught","exception":{"type":"object","description":"NoModificationAllowedError: This element has no parent node."}}}`
+		}
+	case "Runtime.evaluate":
+		result = `{"result":{"objectId":"global"}}`
+	}
+	socket.responses <- &Response{ID: payload.ID, Result: []byte(result)}
+	return nil
+}
+
+func newPayloadSocket(name string) (*Socket, *payloadWebSocket) {
+	conn := &payloadWebSocket{
+		mux:       &sync.Mutex{},
+		responses: make(chan *Response, 100),
+	}
+	socketURL, _ := url.Parse("https://test:9222/" + name)
+	mockSocket := NewMock(socketURL)
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		return conn, nil
+	}
+	return mockSocket, conn
+}
+
+func TestLargePayloadStrategy(t *testing.T) {
+	mockSocket, conn := newPayloadSocket("TestLargePayloadStrategy")
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	id := mockSocket.CurCommandID() + 1
+	html := "<div>" + strings.Repeat("größer ", 200*1024) + "</div>"
+	result := <-mockSocket.DOM().SetOuterHTML(&dom.SetOuterHTMLParams{
+		NodeID:    1,
+		OuterHTML: html,
+	})
+	if nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	if "DOM.resolveNode" != conn.payloads[0].Method {
+		t.Errorf("Expected DOM.resolveNode, received %s", conn.payloads[0].Method)
+	}
+	uploaded := ""
+	for _, payload := range conn.payloads[1 : len(conn.payloads)-1] {
+		if payload.size > MaxPayloadSize {
+			t.Errorf("Payload #%d exceeds the maximum size: %d", payload.ID, payload.size)
+		}
+		params := &runtime.CallFunctionOnParams{}
+		json.Unmarshal(payload.Params, params)
+		uploaded += params.Arguments[1].Value.(string)
+	}
+	if html != uploaded {
+		t.Errorf("Uploaded content does not match the original")
+	}
+	final := conn.payloads[len(conn.payloads)-1]
+	params := &runtime.CallFunctionOnParams{}
+	json.Unmarshal(final.Params, params)
+	if id == final.ID || "Runtime.callFunctionOn" != final.Method || !strings.Contains(params.FunctionDeclaration, "this.outerHTML = html") {
+		t.Errorf("Expected the outerHTML to be set by a new command, received #%d %s '%s'", final.ID, final.Method, params.FunctionDeclaration)
+	}
+}
+
+func TestLargePayloadException(t *testing.T) {
+	mockSocket, conn := newPayloadSocket("TestLargePayloadException")
+	conn.throw = "this.outerHTML = html"
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	result := <-mockSocket.DOM().SetOuterHTML(&dom.SetOuterHTMLParams{
+		NodeID:    1,
+		OuterHTML: strings.Repeat("x", 2*MaxPayloadSize),
+	})
+	protocolErr := &Error{}
+	if !errors.As(result.Err, &protocolErr) {
+		t.Fatalf("Expected *Error, received '%v'", result.Err)
+	}
+	if "DOM.setOuterHTML" != protocolErr.Method || !strings.Contains(protocolErr.Message, "NoModificationAllowedError") {
+		t.Errorf("Expected the exception of DOM.setOuterHTML, received '%v'", protocolErr)
+	}
+}
+
+func TestLargePayloadSetDocumentContent(t *testing.T) {
+	mockSocket, conn := newPayloadSocket("TestLargePayloadSetDocumentContent")
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	id := mockSocket.CurCommandID() + 1
+	html := "<html><body>" + strings.Repeat("<p>content</p>", 100*1024) + "</body></html>"
+	result := <-mockSocket.Page().SetDocumentContent(&page.SetDocumentContentParams{
+		FrameID: "frame",
+		HTML:    html,
+	})
+	if nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	if "Page.createIsolatedWorld" != conn.payloads[0].Method {
+		t.Errorf("Expected Page.createIsolatedWorld, received %s", conn.payloads[0].Method)
+	}
+	evaluate := &runtime.EvaluateParams{}
+	json.Unmarshal(conn.payloads[1].Params, evaluate)
+	if "Runtime.evaluate" != conn.payloads[1].Method || "document" != evaluate.Expression {
+		t.Errorf("Expected the document to be evaluated, received %s '%s'", conn.payloads[1].Method, evaluate.Expression)
+	}
+	uploaded := ""
+	for _, payload := range conn.payloads[2 : len(conn.payloads)-1] {
+		if payload.size > MaxPayloadSize {
+			t.Errorf("Payload #%d exceeds the maximum size: %d", payload.ID, payload.size)
+		}
+		params := &runtime.CallFunctionOnParams{}
+		json.Unmarshal(payload.Params, params)
+		uploaded += params.Arguments[1].Value.(string)
+	}
+	if html != uploaded {
+		t.Errorf("Uploaded content does not match the original")
+	}
+	final := conn.payloads[len(conn.payloads)-1]
+	if id == final.ID || "Runtime.callFunctionOn" != final.Method {
+		t.Fatalf("Expected a new Runtime.callFunctionOn command, received #%d %s", final.ID, final.Method)
+	}
+	params := &runtime.CallFunctionOnParams{}
+	json.Unmarshal(final.Params, params)
+	if "global" != string(params.ObjectID) || !strings.Contains(params.FunctionDeclaration, "this.write(html)") {
+		t.Errorf("Expected the document to be written, received '%s' on '%s'", params.FunctionDeclaration, params.ObjectID)
+	}
+}
+
+func TestLargePayloadSetFileInputFiles(t *testing.T) {
+	mockSocket, conn := newPayloadSocket("TestLargePayloadSetFileInputFiles")
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	id := mockSocket.CurCommandID() + 1
+	files := make([]string, 0)
+	for a := 0; a < 100*1024; a++ {
+		files = append(files, "/path/to/some/file"+strconv.Itoa(a))
+	}
+	result := <-mockSocket.DOM().SetFileInputFiles(&dom.SetFileInputFilesParams{
+		Files:  files,
+		NodeID: 1,
+	})
+	if nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	if "DOM.resolveNode" != conn.payloads[0].Method {
+		t.Errorf("Expected DOM.resolveNode, received %s", conn.payloads[0].Method)
+	}
+	set := make([]string, 0)
+	for _, payload := range conn.payloads[1 : len(conn.payloads)-1] {
+		if payload.size > MaxPayloadSize {
+			t.Errorf("Payload #%d exceeds the maximum size: %d", payload.ID, payload.size)
+		}
+		if "DOM.setFileInputFiles" != payload.Method {
+			continue
+		}
+		params := &dom.SetFileInputFilesParams{}
+		json.Unmarshal(payload.Params, params)
+		if "input" != string(params.ObjectID) {
+			t.Errorf("Expected the files to be set on a temporary input, received '%s'", params.ObjectID)
+		}
+		set = append(set, params.Files...)
+	}
+	if strings.Join(files, "\n") != strings.Join(set, "\n") {
+		t.Errorf("Set files do not match the original")
+	}
+	final := conn.payloads[len(conn.payloads)-1]
+	if id == final.ID || "Runtime.callFunctionOn" != final.Method {
+		t.Fatalf("Expected a new Runtime.callFunctionOn command, received #%d %s", final.ID, final.Method)
+	}
+	params := &runtime.CallFunctionOnParams{}
+	json.Unmarshal(final.Params, params)
+	if "node" != string(params.ObjectID) || !strings.Contains(params.FunctionDeclaration, "this.files = transfer.files") {
+		t.Errorf("Expected the files to be moved to the input, received '%s' on '%s'", params.FunctionDeclaration, params.ObjectID)
+	}
+}
+
+func TestLargePayloadEvaluate(t *testing.T) {
+	mockSocket, conn := newPayloadSocket("TestLargePayloadEvaluate")
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	id := mockSocket.CurCommandID() + 1
+	expression := "'" + strings.Repeat("x", 2*MaxPayloadSize) + "'.length"
+	result := <-mockSocket.Runtime().Evaluate(&runtime.EvaluateParams{
+		Expression: expression,
+	})
+	if nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	params := &runtime.EvaluateParams{}
+	json.Unmarshal(conn.payloads[0].Params, params)
+	if "Runtime.evaluate" != conn.payloads[0].Method || "this" != params.Expression {
+		t.Errorf("Expected the global object to be evaluated, received %s '%s'", conn.payloads[0].Method, params.Expression)
+	}
+	final := conn.payloads[len(conn.payloads)-1]
+	if id != final.ID || "Runtime.evaluate" != final.Method {
+		t.Fatalf("Expected #%d Runtime.evaluate, received #%d %s", id, final.ID, final.Method)
+	}
+	json.Unmarshal(final.Params, params)
+	expected := `(0, eval)((function(property) { var expression = this[property].join(""); delete this[property]; return expression; }).call(this, "__goChromePayload` + strconv.Itoa(id) + `"))`
+	if expected != params.Expression {
+		t.Errorf("Expected '%s', received '%s'", expected, params.Expression)
+	}
+}
+
+func TestLargePayloadTooLarge(t *testing.T) {
+	mockSocket, _ := newPayloadSocket("TestLargePayloadTooLarge")
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	result := <-mockSocket.DOM().SetAttributeValue(&dom.SetAttributeValueParams{
+		Name:   "data-value",
+		NodeID: 1,
+		Value:  strings.Repeat("x", 2*MaxPayloadSize),
+	})
+	if !errors.Is(result.Err, ErrPayloadTooLarge) {
+		t.Errorf("Expected ErrPayloadTooLarge, received '%v'", result.Err)
+	}
+}
@@ -0,0 +1,92 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+NewPipe returns a connection to the Chromium remote debugging pipe that
+implements the WebSocketer interface.
+
+When started with the --remote-debugging-pipe flag Chromium reads commands
+from file descriptor 3 and writes responses and events to file descriptor 4,
+each message is JSON terminated by a NUL byte. The reader is the read end of
+the pipe connected to file descriptor 4 and the writer is the write end of the
+pipe connected to file descriptor 3.
+*/
+func NewPipe(reader io.ReadCloser, writer io.WriteCloser) *ChromePipe {
+	return &ChromePipe{
+		buffer:   bufio.NewReader(reader),
+		reader:   reader,
+		writeMux: &sync.Mutex{},
+		writer:   writer,
+	}
+}
+
+/*
+ChromePipe provides a WebSocketer interface for the Chromium remote debugging
+pipe.
+
+ChromePipe represents a WebSocketer interface
+*/
+type ChromePipe struct {
+	buffer   *bufio.Reader
+	reader   io.ReadCloser
+	writeMux *sync.Mutex
+	writer   io.WriteCloser
+}
+
+/*
+Close closes both ends of the pipe. Chromium exits when the pipe is closed.
+
+Close is a WebSocketer implementation.
+*/
+func (pipe *ChromePipe) Close() error {
+	writeErr := pipe.writer.Close()
+	readErr := pipe.reader.Close()
+	if nil != writeErr {
+		return errs.Wrap(writeErr, 0, "could not close pipe writer")
+	}
+	if nil != readErr {
+		return errs.Wrap(readErr, 0, "could not close pipe reader")
+	}
+	return nil
+}
+
+/*
+ReadJSON reads the next message from the pipe and unmarshalls it into the
+provided variable.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (pipe *ChromePipe) ReadJSON(v interface{}) error {
+	data, err := pipe.buffer.ReadBytes(0)
+	if nil != err {
+		return errs.Wrap(err, 0, "pipe read failed")
+	}
+	return json.Unmarshal(data[:len(data)-1], &v)
+}
+
+/*
+WriteJSON marshalls the provided data as JSON and writes it to the pipe.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (pipe *ChromePipe) WriteJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if nil != err {
+		return errs.Wrap(err, 0, "could not encode pipe message")
+	}
+
+	pipe.writeMux.Lock()
+	defer pipe.writeMux.Unlock()
+	if _, err = pipe.writer.Write(append(data, 0)); nil != err {
+		return errs.Wrap(err, 0, "pipe write failed")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/dom"
+)
+
+func TestPipeSendCommand(t *testing.T) {
+	commandReader, commandWriter := io.Pipe()
+	messageReader, messageWriter := io.Pipe()
+
+	// Emulate the browser end of the pipe.
+	go func() {
+		reader := bufio.NewReader(commandReader)
+		for {
+			data, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &Payload{}
+			json.Unmarshal(data[:len(data)-1], payload)
+			response, _ := json.Marshal(&Response{
+				ID:     payload.ID,
+				Result: []byte(`{"product":"HeadlessChrome/1.0"}`),
+			})
+			messageWriter.Write(append(response, 0))
+		}
+	}()
+
+	socketURL, _ := url.Parse("pipe://browser")
+	pipeSocket := New(socketURL, WithWebSocketer(NewPipe(messageReader, commandWriter)))
+	defer pipeSocket.Disconnect()
+
+	select {
+	case result := <-pipeSocket.Browser().GetVersion():
+		if nil != result.Err {
+			t.Fatalf("Expected nil, received error: %v", result.Err)
+		}
+		if "HeadlessChrome/1.0" != result.Product {
+			t.Errorf("Expected 'HeadlessChrome/1.0', received '%s'", result.Product)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Response was not received")
+	}
+}
+
+func TestPipeLargePayload(t *testing.T) {
+	commandReader, commandWriter := io.Pipe()
+	messageReader, messageWriter := io.Pipe()
+
+	// Emulate the browser end of the pipe.
+	methods := make(chan string, 10)
+	go func() {
+		reader := bufio.NewReader(commandReader)
+		for {
+			data, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &Payload{}
+			json.Unmarshal(data[:len(data)-1], payload)
+			methods <- payload.Method
+			response, _ := json.Marshal(&Response{ID: payload.ID, Result: []byte(`{}`)})
+			messageWriter.Write(append(response, 0))
+		}
+	}()
+
+	socketURL, _ := url.Parse("pipe://browser")
+	pipeSocket := New(socketURL, WithWebSocketer(NewPipe(messageReader, commandWriter)))
+	defer pipeSocket.Disconnect()
+
+	// The payload is written as is, without a large payload strategy.
+	select {
+	case result := <-pipeSocket.DOM().SetOuterHTML(&dom.SetOuterHTMLParams{
+		NodeID:    1,
+		OuterHTML: strings.Repeat("x", 2*MaxPayloadSize),
+	}):
+		if nil != result.Err {
+			t.Fatalf("Expected nil, received error: %v", result.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Response was not received")
+	}
+	if method := <-methods; "DOM.setOuterHTML" != method || 0 != len(methods) {
+		t.Errorf("Expected a single DOM.setOuterHTML command, received %s and %d more", method, len(methods))
+	}
+}
+
+func TestPipeReconnect(t *testing.T) {
+	commandReader, commandWriter := io.Pipe()
+	messageReader, messageWriter := io.Pipe()
+	defer commandReader.Close()
+	defer messageWriter.Close()
+
+	socketURL, _ := url.Parse("pipe://browser")
+	newSocket := &Socket{}
+	WithWebSocketer(NewPipe(messageReader, commandWriter))(newSocket)
+	if _, err := newSocket.newSocket(socketURL); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if _, err := newSocket.newSocket(socketURL); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+}
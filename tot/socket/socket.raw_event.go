@@ -0,0 +1,117 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+)
+
+/*
+RawEvent is a protocol event delivered without decoding, for events that don't
+have a generated type yet. See OnRawEvent, RawEvents and WaitForRawEvent.
+*/
+type RawEvent struct {
+	// Err is set if the event contains an error, or if WaitForRawEvent ended
+	// before a matching event was received.
+	Err error
+
+	// Method is the name of the event, for example "Page.loadEventFired".
+	Method string
+
+	// Params are the JSON encoded event parameters.
+	Params json.RawMessage
+}
+
+/*
+newRawEvent returns the RawEvent for an event response.
+*/
+func newRawEvent(response *Response) *RawEvent {
+	event := &RawEvent{
+		Err:    response.Err,
+		Method: response.Method,
+		Params: response.Params,
+	}
+	if nil == event.Err && nil != response.Error && 0 != response.Error.Code {
+		event.Err = response.Error
+	}
+	return event
+}
+
+/*
+Decode unmarshals the event parameters into the provided value. It returns
+the event error, if any.
+*/
+func (event *RawEvent) Decode(v interface{}) error {
+	if nil != event.Err {
+		return event.Err
+	}
+	return json.Unmarshal(event.Params, v)
+}
+
+/*
+OnRawEvent adds a handler for any event by name and returns a Subscription
+that can be used to remove it.
+*/
+func OnRawEvent(socket Socketer, method string, callback func(event *RawEvent)) *Subscription {
+	handler := NewEventHandler(
+		method,
+		func(response *Response) {
+			callback(newRawEvent(response))
+		},
+	)
+	return Subscribe(socket, handler)
+}
+
+/*
+RawEvents returns a channel that receives each event with the specified name.
+The channel is closed when the context is done or the socket stops listening.
+See OnRawEvent.
+*/
+func RawEvents(ctx context.Context, socket Socketer, method string) <-chan *RawEvent {
+	eventChan := make(chan *RawEvent)
+	stream := NewEventStream(ctx, socket, method)
+
+	go func() {
+		for response := range stream.Responses() {
+			select {
+			case eventChan <- newRawEvent(response):
+			case <-stream.Done():
+			}
+		}
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+
+/*
+WaitForRawEvent subscribes to the event with the specified name and returns a
+channel that receives the first event for which the predicate returns true. A
+nil predicate matches any event. If the context is done or the socket stops
+listening first, an event containing an error is delivered instead. See
+Socketer.WaitForEvent.
+*/
+func WaitForRawEvent(
+	ctx context.Context,
+	socket Socketer,
+	method string,
+	predicate func(event *RawEvent) bool,
+) <-chan *RawEvent {
+	eventChan := make(chan *RawEvent, 1)
+	responseChan := socket.WaitForEvent(
+		ctx,
+		method,
+		func(response *Response) bool {
+			if nil == predicate {
+				return true
+			}
+			return predicate(newRawEvent(response))
+		},
+	)
+
+	go func() {
+		eventChan <- newRawEvent(<-responseChan)
+		close(eventChan)
+	}()
+
+	return eventChan
+}
@@ -0,0 +1,106 @@
+package socket
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOnRawEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOnRawEvent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *RawEvent)
+	subscription := OnRawEvent(mockSocket, "Some.newEvent", func(event *RawEvent) {
+		resultChan <- event
+	})
+	defer subscription.Unsubscribe()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Some.newEvent",
+		Params: []byte(`{"value":"Mock Value"}`),
+	})
+
+	select {
+	case event := <-resultChan:
+		params := struct {
+			Value string `json:"value"`
+		}{}
+		if err := event.Decode(&params); nil != err {
+			t.Errorf("Expected nil, received error: %v", err)
+		}
+		if "Mock Value" != params.Value {
+			t.Errorf("Expected 'Mock Value', received '%s'", params.Value)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+}
+
+func TestRawEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestRawEvents")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := RawEvents(ctx, mockSocket, "Some.newEvent")
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Some.newEvent",
+		Params: []byte(`{"value":"Mock Value"}`),
+	})
+
+	select {
+	case event := <-eventChan:
+		if "Some.newEvent" != event.Method {
+			t.Errorf("Expected 'Some.newEvent', received '%s'", event.Method)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+	cancel()
+	for range eventChan {
+	}
+}
+
+func TestWaitForRawEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestWaitForRawEvent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	eventChan := WaitForRawEvent(
+		context.Background(),
+		mockSocket,
+		"Some.newEvent",
+		func(event *RawEvent) bool {
+			return `"match"` == string(event.Params)
+		},
+	)
+	for _, params := range []string{`"no match"`, `"match"`} {
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: "Some.newEvent",
+			Params: []byte(params),
+		})
+	}
+	event := <-eventChan
+	if nil != event.Err {
+		t.Errorf("Expected nil, received error: %v", event.Err)
+	}
+	if `"match"` != string(event.Params) {
+		t.Errorf("Expected 'match', received '%s'", event.Params)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	event = <-WaitForRawEvent(ctx, mockSocket, "Some.newEvent", nil)
+	if !errors.Is(event.Err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, received '%v'", event.Err)
+	}
+}
@@ -0,0 +1,186 @@
+package socket
+
+import (
+	"fmt"
+	"time"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+PendingCommandPolicy defines how commands that are waiting for a response are
+handled when a broken connection is re-established.
+*/
+type PendingCommandPolicy int
+
+const (
+	// FailPendingCommands responds to every pending command with a
+	// TransportError wrapping ErrSocketClosed as soon as the connection is
+	// lost.
+	FailPendingCommands PendingCommandPolicy = iota
+
+	// ResendPendingCommands writes the payload of every pending command to
+	// the new connection once it has been established.
+	ResendPendingCommands
+)
+
+/*
+ReconnectPolicy defines how a Socket recovers from a broken websocket
+connection. Event handlers are stored by the Socket and remain registered
+across reconnections, but any protocol domains that were enabled on the
+previous connection must be enabled again, which can be done in the
+OnReconnect callback.
+*/
+type ReconnectPolicy struct {
+	// Optional. MaxAttempts is the maximum number of connection attempts
+	// before giving up. Defaults to 0, unlimited.
+	MaxAttempts int
+
+	// Optional. Delay is the time to wait before the first connection
+	// attempt. Defaults to 100ms.
+	Delay time.Duration
+
+	// Optional. MaxDelay is the maximum time to wait between connection
+	// attempts. Defaults to 30s.
+	MaxDelay time.Duration
+
+	// Optional. Multiplier is the factor the delay is increased by after
+	// each failed attempt. Defaults to 2.
+	Multiplier float64
+
+	// Optional. Pending defines how commands waiting for a response are
+	// handled. Defaults to FailPendingCommands.
+	Pending PendingCommandPolicy
+
+	// Optional. OnReconnect is called after the connection has been
+	// re-established and any pending commands have been resent.
+	OnReconnect func(socket *Socket)
+}
+
+/*
+delay returns the backoff delay before the specified connection attempt.
+*/
+func (policy *ReconnectPolicy) delay(attempt int) time.Duration {
+	delay := policy.Delay
+	if delay <= 0 {
+		delay = 100 * time.Millisecond
+	}
+	maxDelay := policy.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 30 * time.Second
+	}
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	for a := 1; a < attempt; a++ {
+		delay = time.Duration(float64(delay) * multiplier)
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return delay
+}
+
+/*
+failCommands responds to each of the provided commands with an error wrapping
+the specified cause and removes them from the command stack. Target crashes
+and detached sessions are reported as a TargetError, anything else as a
+TransportError. Commands that are no longer in the stack have been failed
+already and are skipped.
+*/
+func (socket *Socket) failCommands(commands []Commander, cause error) {
+	socket.failMux.Lock()
+	defer socket.failMux.Unlock()
+	for _, command := range commands {
+		if _, err := socket.commands.Get(command.ID()); nil != err {
+			continue
+		}
+		socket.commands.Delete(command.ID())
+		var err error = &TransportError{Err: cause, Method: command.Method()}
+		if ErrTargetCrashed == cause || ErrTargetDetached == cause {
+			err = &TargetError{Err: cause, Method: command.Method()}
+		}
+		command.SetError(err)
+		command.Respond(&Response{
+			Err: err,
+			ID:  command.ID(),
+		})
+	}
+}
+
+/*
+reconnect closes the broken websocket connection and attempts to establish a
+new one according to the reconnect policy. Pending commands are failed or
+resent as specified by the policy. Writes wait until the reconnect finishes
+and fail with ErrSocketClosed if it fails.
+*/
+func (socket *Socket) reconnect() error {
+	policy := socket.reconnectPolicy
+	pending := socket.commands.List()
+
+	reconnecting := make(chan struct{})
+	socket.mux.Lock()
+	if nil != socket.conn {
+		socket.conn.Close()
+	}
+	socket.conn = nil
+	socket.connected = false
+	socket.reconnecting = reconnecting
+	socket.mux.Unlock()
+	socket.closeSessions()
+
+	if FailPendingCommands == policy.Pending {
+		socket.failCommands(pending, ErrSocketClosed)
+		pending = nil
+	}
+
+	var err error
+	for attempt := 1; 0 == policy.MaxAttempts || attempt <= policy.MaxAttempts; attempt++ {
+		time.Sleep(policy.delay(attempt))
+		if !socket.isListening() {
+			err = errs.New(0, "socket stopped while reconnecting")
+			break
+		}
+		socket.logger.Infof("reconnecting to %s, attempt #%d", socket.URL(), attempt)
+		socket.mux.Lock()
+		err = socket.dial()
+		socket.mux.Unlock()
+		if nil == err {
+			break
+		}
+		socket.logger.Warnf("reconnect attempt #%d failed: %v", attempt, err)
+	}
+	if nil != err {
+		close(reconnecting)
+		socket.failCommands(pending, ErrSocketClosed)
+		err = errs.Wrap(err, 0, fmt.Sprintf("socket #%d - reconnect failed", socket.socketID))
+		return err
+	}
+	socket.mux.Lock()
+	socket.reconnecting = nil
+	socket.mux.Unlock()
+	close(reconnecting)
+	socket.logger.Infof("reconnected to %s", socket.URL())
+
+	// Resend from a new goroutine, a large payload strategy waits for
+	// responses that are delivered by the socket read loop.
+	go func() {
+		for _, command := range pending {
+			socket.commandLogger(command).Debugf("resending command")
+			payload := &Payload{
+				ID:     command.ID(),
+				Method: command.Method(),
+				Params: command.Params(),
+			}
+			if err := socket.send(payload); nil != err {
+				socket.failCommands([]Commander{command}, err)
+			}
+		}
+		if nil != policy.OnReconnect {
+			policy.OnReconnect(socket)
+		}
+	}()
+
+	return nil
+}
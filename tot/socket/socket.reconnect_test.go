@@ -0,0 +1,276 @@
+package socket
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReconnectPolicyDelay(t *testing.T) {
+	policy := &ReconnectPolicy{}
+	if 100*time.Millisecond != policy.delay(1) {
+		t.Errorf("Expected 100ms, got %s", policy.delay(1))
+	}
+	if 400*time.Millisecond != policy.delay(3) {
+		t.Errorf("Expected 400ms, got %s", policy.delay(3))
+	}
+	if 30*time.Second != policy.delay(100) {
+		t.Errorf("Expected 30s, got %s", policy.delay(100))
+	}
+
+	policy = &ReconnectPolicy{
+		Delay:      time.Second,
+		MaxDelay:   5 * time.Second,
+		Multiplier: 3,
+	}
+	if 3*time.Second != policy.delay(2) {
+		t.Errorf("Expected 3s, got %s", policy.delay(2))
+	}
+	if 5*time.Second != policy.delay(3) {
+		t.Errorf("Expected 5s, got %s", policy.delay(3))
+	}
+}
+
+func TestReconnectFailPendingCommands(t *testing.T) {
+	reconnected := make(chan bool)
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectFailPendingCommands")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		Delay:       10 * time.Millisecond,
+		OnReconnect: func(socket *Socket) { reconnected <- true },
+	}))
+	dials := 0
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		dials++
+		return NewMockWebsocket(socketURL)
+	}
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).ReadError(fmt.Errorf("mock read error"))
+	result := <-resultChan
+	var transportErr *TransportError
+	if !errors.As(result.Err, &transportErr) {
+		t.Errorf("Expected TransportError, received '%v'", result.Err)
+	}
+	if !errors.Is(command.Error(), ErrSocketClosed) {
+		t.Errorf("Expected ErrSocketClosed, received '%v'", command.Error())
+	}
+
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Socket did not reconnect")
+	}
+	if 2 != dials {
+		t.Errorf("Expected 2 connections, got %d", dials)
+	}
+
+	command = NewCommand(mockSocket, "Some.method", nil)
+	resultChan = mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	result = <-resultChan
+	if `"Mock Command Result"` != string(result.Result) {
+		t.Errorf("Invalid result: expected 'Mock Command Result', received '%s'", result.Result)
+	}
+}
+
+func TestReconnectResendPendingCommands(t *testing.T) {
+	reconnected := make(chan bool)
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectResendPendingCommands")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		Delay:       10 * time.Millisecond,
+		Pending:     ResendPendingCommands,
+		OnReconnect: func(socket *Socket) { reconnected <- true },
+	}))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).ReadError(fmt.Errorf("mock read error"))
+
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Socket did not reconnect")
+	}
+	if _, err := mockSocket.commands.Get(command.ID()); nil != err {
+		t.Errorf("Expected command #%d to remain pending, got error: %v", command.ID(), err)
+	}
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	result := <-resultChan
+	if `"Mock Command Result"` != string(result.Result) {
+		t.Errorf("Invalid result: expected 'Mock Command Result', received '%s'", result.Result)
+	}
+}
+
+func TestReconnectMaxAttempts(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectMaxAttempts")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		MaxAttempts: 2,
+		Delay:       10 * time.Millisecond,
+		Pending:     ResendPendingCommands,
+	}))
+	mux := &sync.Mutex{}
+	dials := 0
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		mux.Lock()
+		defer mux.Unlock()
+		dials++
+		if dials > 1 {
+			return nil, fmt.Errorf("mock dial error")
+		}
+		return NewMockWebsocket(socketURL)
+	}
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).ReadError(fmt.Errorf("mock read error"))
+
+	select {
+	case result := <-resultChan:
+		if !errors.Is(result.Err, ErrSocketClosed) {
+			t.Errorf("Expected ErrSocketClosed, received '%v'", result.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Pending command was not failed")
+	}
+	mux.Lock()
+	if 3 != dials {
+		t.Errorf("Expected 3 connection attempts, got %d", dials)
+	}
+	mux.Unlock()
+}
+
+/*
+waitReconnecting waits until the socket has started reconnecting.
+*/
+func waitReconnecting(t *testing.T, socket *Socket) {
+	for a := 0; a < 500; a++ {
+		socket.mux.Lock()
+		reconnecting := socket.reconnecting
+		socket.mux.Unlock()
+		if nil != reconnecting {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("Socket did not start reconnecting")
+}
+
+func TestReconnectWaitingWrites(t *testing.T) {
+	reconnected := make(chan bool)
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectWaitingWrites")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		Delay:       200 * time.Millisecond,
+		OnReconnect: func(socket *Socket) { reconnected <- true },
+	}))
+	mux := &sync.Mutex{}
+	dials := 0
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		mux.Lock()
+		defer mux.Unlock()
+		dials++
+		return NewMockWebsocket(socketURL)
+	}
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockSocket.Conn().(*MockChromeWebSocket).ReadError(fmt.Errorf("mock read error"))
+	waitReconnecting(t, mockSocket)
+
+	// The command is written once the reconnect delay has passed instead of
+	// connecting on its own.
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	time.Sleep(50 * time.Millisecond)
+	mux.Lock()
+	if 1 != dials {
+		t.Errorf("Expected 1 connection while reconnecting, got %d", dials)
+	}
+	mux.Unlock()
+
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Socket did not reconnect")
+	}
+	mux.Lock()
+	if 2 != dials {
+		t.Errorf("Expected 2 connections, got %d", dials)
+	}
+	mux.Unlock()
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	select {
+	case result := <-resultChan:
+		if `"Mock Command Result"` != string(result.Result) {
+			t.Errorf("Invalid result: expected 'Mock Command Result', received '%s'", result.Result)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Command sent while reconnecting did not receive a response")
+	}
+}
+
+func TestReconnectFailedWrites(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectFailedWrites")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		MaxAttempts: 1,
+		Delay:       100 * time.Millisecond,
+	}))
+	mux := &sync.Mutex{}
+	dials := 0
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		mux.Lock()
+		defer mux.Unlock()
+		dials++
+		if dials > 1 {
+			return nil, fmt.Errorf("mock dial error")
+		}
+		return NewMockWebsocket(socketURL)
+	}
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockSocket.Conn().(*MockChromeWebSocket).ReadError(fmt.Errorf("mock read error"))
+	waitReconnecting(t, mockSocket)
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	select {
+	case result := <-mockSocket.SendCommand(command):
+		var transportErr *TransportError
+		if !errors.As(result.Err, &transportErr) || !errors.Is(result.Err, ErrSocketClosed) {
+			t.Errorf("Expected TransportError wrapping ErrSocketClosed, received '%v'", result.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Command sent while reconnecting was not failed")
+	}
+	mux.Lock()
+	if 2 != dials {
+		t.Errorf("Expected 2 connection attempts, got %d", dials)
+	}
+	mux.Unlock()
+}
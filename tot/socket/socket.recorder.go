@@ -0,0 +1,189 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+RecordDirection identifies whether a recorded message was sent to or received
+from the browser.
+*/
+type RecordDirection string
+
+const (
+	// RecordSend is the direction of command payloads written to the
+	// connection.
+	RecordSend RecordDirection = "send"
+
+	// RecordReceive is the direction of command responses and events read
+	// from the connection.
+	RecordReceive RecordDirection = "receive"
+)
+
+/*
+RecordEntry is a single message in a protocol recording. Each entry is written
+as one line of JSON.
+*/
+type RecordEntry struct {
+	// Direction is the direction of the message.
+	Direction RecordDirection `json:"direction"`
+
+	// ID is the command ID of a command payload or response, 0 for events.
+	ID int `json:"id,omitempty"`
+
+	// Message is the message as it was written to or read from the
+	// connection, with insignificant whitespace removed.
+	Message json.RawMessage `json:"message"`
+
+	// Method is the method of a command payload or event. Command responses
+	// don't include the method.
+	Method string `json:"method,omitempty"`
+
+	// SessionID is the flattened session the message belongs to, if any.
+	SessionID string `json:"sessionId,omitempty"`
+
+	// Socket is the ID of the socket that recorded the message.
+	Socket int `json:"socket"`
+
+	// Time is the time the message was written or read.
+	Time time.Time `json:"time"`
+}
+
+/*
+Domain returns the protocol domain of the entry method, or an empty string for
+command responses.
+*/
+func (entry *RecordEntry) Domain() string {
+	if i := strings.Index(entry.Method, "."); i > 0 {
+		return entry.Method[:i]
+	}
+	return entry.Method
+}
+
+/*
+NewRecorder returns a Recorder that writes newline-delimited JSON entries to
+the provided writer. See WithRecorder.
+*/
+func NewRecorder(writer io.Writer) *Recorder {
+	return &Recorder{
+		mux:    &sync.Mutex{},
+		writer: writer,
+	}
+}
+
+/*
+Recorder writes the messages exchanged by one or more sockets to a protocol
+recording. It is safe for concurrent use. Recordings can be read back with
+NewRecordReader.
+*/
+type Recorder struct {
+	mux    *sync.Mutex
+	writer io.Writer
+}
+
+/*
+Record writes a message to the recording. The message is encoded as JSON, a
+json.RawMessage is recorded as is.
+*/
+func (recorder *Recorder) Record(socketID int, direction RecordDirection, message interface{}) error {
+	data, err := json.Marshal(message)
+	if nil != err {
+		return errs.Wrap(err, 0, "could not encode recorded message")
+	}
+
+	header := struct {
+		ID        int    `json:"id"`
+		Method    string `json:"method"`
+		SessionID string `json:"sessionId"`
+	}{}
+	json.Unmarshal(data, &header)
+
+	line, err := json.Marshal(&RecordEntry{
+		Direction: direction,
+		ID:        header.ID,
+		Message:   data,
+		Method:    header.Method,
+		SessionID: header.SessionID,
+		Socket:    socketID,
+		Time:      time.Now(),
+	})
+	if nil != err {
+		return errs.Wrap(err, 0, "could not encode record entry")
+	}
+
+	recorder.mux.Lock()
+	defer recorder.mux.Unlock()
+	if _, err = recorder.writer.Write(append(line, '\n')); nil != err {
+		return errs.Wrap(err, 0, "could not write record entry")
+	}
+	return nil
+}
+
+/*
+NewRecordReader returns a RecordReader that reads the entries of a protocol
+recording.
+*/
+func NewRecordReader(reader io.Reader) *RecordReader {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	return &RecordReader{scanner: scanner}
+}
+
+/*
+RecordReader reads the entries of a protocol recording.
+*/
+type RecordReader struct {
+	line    int
+	scanner *bufio.Scanner
+}
+
+/*
+Next returns the next entry of the recording. It returns io.EOF when there
+are no more entries.
+*/
+func (reader *RecordReader) Next() (*RecordEntry, error) {
+	for reader.scanner.Scan() {
+		reader.line++
+		line := reader.scanner.Bytes()
+		if 0 == len(strings.TrimSpace(string(line))) {
+			continue
+		}
+		entry := &RecordEntry{}
+		if err := json.Unmarshal(line, entry); nil != err {
+			return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid record entry on line %d", reader.line))
+		}
+		return entry, nil
+	}
+	if err := reader.scanner.Err(); nil != err {
+		return nil, errs.Wrap(err, 0, "could not read recording")
+	}
+	return nil, io.EOF
+}
+
+/*
+recording returns whether the socket records messages. Session sockets don't
+record messages, they are recorded by the socket that owns the connection.
+*/
+func (socket *Socket) recording() bool {
+	return nil != socket.recorder && nil == socket.root
+}
+
+/*
+record writes a message to the recorder, if any.
+*/
+func (socket *Socket) record(direction RecordDirection, message interface{}) {
+	if !socket.recording() {
+		return
+	}
+	if err := socket.recorder.Record(socket.socketID, direction, message); nil != err {
+		socket.logger.Warnf("%v", err)
+	}
+}
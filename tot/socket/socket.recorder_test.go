@@ -0,0 +1,122 @@
+package socket
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRecorder(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	socketURL, _ := url.Parse("https://test:9222/TestRecorder")
+	mockSocket := NewMock(socketURL, WithRecorder(NewRecorder(buffer)))
+	mockSocket.Listen()
+
+	command := NewCommand(mockSocket, "Some.method", map[string]string{"param": "value"})
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Result: []byte(`"Mock Command Result"`),
+	})
+	<-resultChan
+	mockSocket.Stop()
+
+	var send, receive *RecordEntry
+	reader := NewRecordReader(bytes.NewReader(buffer.Bytes()))
+	for {
+		entry, err := reader.Next()
+		if io.EOF == err {
+			break
+		}
+		if nil != err {
+			t.Fatalf("Expected nil, received error: %v", err)
+		}
+		if command.ID() != entry.ID {
+			continue
+		}
+		if RecordSend == entry.Direction {
+			send = entry
+		} else {
+			receive = entry
+		}
+	}
+
+	if nil == send || "Some.method" != send.Method || "Some" != send.Domain() {
+		t.Fatalf("Expected the command payload to be recorded, received %v", send)
+	}
+	if mockSocket.socketID != send.Socket {
+		t.Errorf("Expected socket %d, received %d", mockSocket.socketID, send.Socket)
+	}
+	if !bytes.Contains(send.Message, []byte(`"param":"value"`)) {
+		t.Errorf("Expected the payload params to be recorded, received %s", send.Message)
+	}
+	if nil == receive || !bytes.Contains(receive.Message, []byte(`"Mock Command Result"`)) {
+		t.Fatalf("Expected the response to be recorded, received %v", receive)
+	}
+	if receive.Time.Before(send.Time) {
+		t.Errorf("Expected the response to be recorded after the payload")
+	}
+}
+
+func TestRecorderRawMessage(t *testing.T) {
+	commandReader, commandWriter := io.Pipe()
+	messageReader, messageWriter := io.Pipe()
+	defer commandReader.Close()
+
+	buffer := &safeBuffer{}
+	events := make(chan *Response, 1)
+	socketURL, _ := url.Parse("pipe://browser")
+	pipeSocket := New(
+		socketURL,
+		WithWebSocketer(NewPipe(messageReader, commandWriter)),
+		WithRecorder(NewRecorder(buffer)),
+	)
+	defer pipeSocket.Disconnect()
+	pipeSocket.AddEventHandler(NewEventHandler("Custom.event", func(response *Response) {
+		events <- response
+	}))
+
+	// Unknown fields and the key order are recorded as read.
+	message := `{"method":"Custom.event","params":{"z":1,"a":2},"unknown":true}`
+	messageWriter.Write(append([]byte(message), 0))
+	select {
+	case event := <-events:
+		if `{"z":1,"a":2}` != string(event.Params) {
+			t.Errorf("Expected the event params, received %s", event.Params)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+
+	entry, err := NewRecordReader(bytes.NewReader(buffer.Bytes())).Next()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if RecordReceive != entry.Direction || "Custom.event" != entry.Method || message != string(entry.Message) {
+		t.Errorf("Expected '%s' to be recorded, received %s '%s'", message, entry.Direction, entry.Message)
+	}
+}
+
+/*
+safeBuffer is a bytes.Buffer that is safe for concurrent use.
+*/
+type safeBuffer struct {
+	buffer bytes.Buffer
+	mux    sync.Mutex
+}
+
+func (buffer *safeBuffer) Bytes() []byte {
+	buffer.mux.Lock()
+	defer buffer.mux.Unlock()
+	return append([]byte{}, buffer.buffer.Bytes()...)
+}
+
+func (buffer *safeBuffer) Write(data []byte) (int, error) {
+	buffer.mux.Lock()
+	defer buffer.mux.Unlock()
+	return buffer.buffer.Write(data)
+}
@@ -0,0 +1,256 @@
+package socket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+)
+
+/*
+replayMismatchCode is the error code of the response to a payload that doesn't
+match a recorded command, the JSON-RPC "method not found" code.
+*/
+const replayMismatchCode = -32601
+
+/*
+NewReplay reads a protocol recording created with WithRecorder and returns a
+WebSocketer that replays it, see ReplayWebSocket. Only the messages recorded
+by the specified socket are replayed, if socketID is 0 the socket of the first
+entry in the recording is used.
+
+	file, _ := os.Open("testdata/navigate.ndjson")
+	replay, err := socket.NewReplay(file, 0)
+	...
+	tab := socket.New(socketURL, socket.WithWebSocketer(replay))
+*/
+func NewReplay(reader io.Reader, socketID int) (*ReplayWebSocket, error) {
+	replay := &ReplayWebSocket{
+		held:     make(map[string][]*Response),
+		ids:      make(map[string]int),
+		messages: newMessageQueue(),
+		mux:      &sync.Mutex{},
+	}
+
+	var command *replayCommand
+	recording := NewRecordReader(reader)
+	for {
+		entry, err := recording.Next()
+		if io.EOF == err {
+			break
+		}
+		if nil != err {
+			return nil, err
+		}
+		if 0 == socketID {
+			socketID = entry.Socket
+		}
+		if socketID != entry.Socket {
+			continue
+		}
+
+		if RecordSend == entry.Direction {
+			payload := &Payload{}
+			if err := json.Unmarshal(entry.Message, payload); nil != err {
+				return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid recorded payload #%d", entry.ID))
+			}
+			command = &replayCommand{payload: payload}
+			replay.commands = append(replay.commands, command)
+			continue
+		}
+
+		response := &Response{}
+		if err := json.Unmarshal(entry.Message, response); nil != err {
+			return nil, errs.Wrap(err, 0, "invalid recorded message")
+		}
+		if nil == command {
+			replay.emit(response)
+		} else {
+			command.messages = append(command.messages, response)
+		}
+	}
+
+	return replay, nil
+}
+
+/*
+ReplayWebSocket is a WebSocketer that replays a protocol recording, so code
+built on this package can be tested without a browser.
+
+Each command payload written to the connection is matched with the first
+recorded command that has the same method, session and parameters and has
+not been replayed yet. The messages recorded after that command, up to the
+next recorded command, are then read from the connection: the command
+response with the ID of the new payload and any events in the order they were
+recorded. Messages recorded before the first command are read as soon as the
+connection is used. A payload that doesn't match a recorded command is
+answered with an error response, as the browser does for unknown methods.
+
+ReplayWebSocket represents a WebSocketer interface
+*/
+type ReplayWebSocket struct {
+	commands []*replayCommand
+	held     map[string][]*Response
+	ids      map[string]int
+	messages *messageQueue
+	mux      *sync.Mutex
+}
+
+/*
+replayCommand is a recorded command payload and the messages recorded after
+it.
+*/
+type replayCommand struct {
+	messages []*Response
+	payload  *Payload
+	replayed bool
+}
+
+/*
+Close closes the connection, any pending ReadJSON call returns
+ErrSocketClosed.
+
+Close is a WebSocketer implementation.
+*/
+func (replay *ReplayWebSocket) Close() error {
+	replay.messages.close()
+	return nil
+}
+
+/*
+ReadJSON waits for the next replayed message and unmarshalls it into the
+provided variable.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (replay *ReplayWebSocket) ReadJSON(v interface{}) error {
+	return replay.messages.read(v)
+}
+
+/*
+Remaining returns the number of recorded commands that have not been
+replayed.
+*/
+func (replay *ReplayWebSocket) Remaining() int {
+	replay.mux.Lock()
+	defer replay.mux.Unlock()
+	remaining := 0
+	for _, command := range replay.commands {
+		if !command.replayed {
+			remaining++
+		}
+	}
+	return remaining
+}
+
+/*
+WriteJSON matches the command payload with a recorded command and queues the
+messages recorded after it, or an error response if no recorded command
+matches.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (replay *ReplayWebSocket) WriteJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if nil != err {
+		return errs.Wrap(err, 0, "could not encode payload")
+	}
+	payload := &Payload{}
+	if err := json.Unmarshal(data, payload); nil != err {
+		return errs.Wrap(err, 0, "could not decode payload")
+	}
+
+	replay.mux.Lock()
+	defer replay.mux.Unlock()
+	select {
+	case <-replay.messages.done():
+		return ErrSocketClosed
+	default:
+	}
+
+	for _, command := range replay.commands {
+		if command.replayed || !matchPayload(command.payload, payload) {
+			continue
+		}
+		command.replayed = true
+		key := replayKey(command.payload.SessionID, command.payload.ID)
+		replay.ids[key] = payload.ID
+		for _, message := range command.messages {
+			replay.emit(message)
+		}
+		for _, response := range replay.held[key] {
+			replay.emit(response)
+		}
+		delete(replay.held, key)
+		return nil
+	}
+
+	params, _ := json.Marshal(payload.Params)
+	replay.messages.push(&Response{
+		Error: &Error{
+			Code:    replayMismatchCode,
+			Message: fmt.Sprintf("no recorded command matches %s %s", payload.Method, params),
+		},
+		ID:        payload.ID,
+		SessionID: payload.SessionID,
+	})
+	return nil
+}
+
+/*
+emit queues a recorded message to be read. Command responses are given the ID
+of the payload that replayed the command, responses to commands that have not
+been replayed yet are held until they are.
+*/
+func (replay *ReplayWebSocket) emit(recorded *Response) {
+	response := *recorded
+	if response.ID > 0 {
+		key := replayKey(response.SessionID, response.ID)
+		id, ok := replay.ids[key]
+		if !ok {
+			replay.held[key] = append(replay.held[key], recorded)
+			return
+		}
+		response.ID = id
+	}
+	replay.messages.push(&response)
+}
+
+/*
+matchPayload returns whether a payload matches a recorded payload. Parameters
+are compared after decoding, so the order of the JSON keys doesn't matter.
+*/
+func matchPayload(recorded *Payload, payload *Payload) bool {
+	if recorded.Method != payload.Method || recorded.SessionID != payload.SessionID {
+		return false
+	}
+	return reflect.DeepEqual(normalizeParams(recorded.Params), normalizeParams(payload.Params))
+}
+
+/*
+normalizeParams decodes command parameters into generic values, empty
+parameters are nil.
+*/
+func normalizeParams(params interface{}) interface{} {
+	data, err := json.Marshal(params)
+	if nil != err {
+		return params
+	}
+	var normalized interface{}
+	json.Unmarshal(data, &normalized)
+	if object, ok := normalized.(map[string]interface{}); ok && 0 == len(object) {
+		return nil
+	}
+	return normalized
+}
+
+/*
+replayKey returns the key used to map a recorded command ID to the ID of the
+payload that replayed it.
+*/
+func replayKey(sessionID string, id int) string {
+	return fmt.Sprintf("%s/%d", sessionID, id)
+}
@@ -0,0 +1,66 @@
+package socket
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReplay(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	recorder := NewRecorder(buffer)
+	recorder.Record(7, RecordReceive, &Response{Method: "Target.targetCreated", Params: []byte(`{}`)})
+	recorder.Record(7, RecordSend, &Payload{ID: 3, Method: "Page.navigate", Params: map[string]string{"url": "https://example.com"}})
+	recorder.Record(7, RecordReceive, &Response{Method: "Page.frameStartedLoading", Params: []byte(`{"frameId":"F1"}`)})
+	recorder.Record(7, RecordReceive, &Response{ID: 3, Result: []byte(`{"frameId":"F1"}`)})
+	recorder.Record(8, RecordSend, &Payload{ID: 1, Method: "Other.socket"})
+
+	replay, err := NewReplay(bytes.NewReader(buffer.Bytes()), 0)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 1 != replay.Remaining() {
+		t.Errorf("Expected 1 remaining command, received %d", replay.Remaining())
+	}
+
+	socketURL, _ := url.Parse("replay://TestReplay")
+	replaySocket := New(socketURL, WithWebSocketer(replay))
+	defer replaySocket.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := RawEvents(ctx, replaySocket, "Page.frameStartedLoading")
+	replaySocket.Listen()
+
+	result := struct {
+		FrameID string `json:"frameId"`
+	}{}
+	err = replaySocket.Call(ctx, "Page.navigate", map[string]string{"url": "https://example.com"}, &result)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "F1" != result.FrameID {
+		t.Errorf("Expected 'F1', received '%s'", result.FrameID)
+	}
+	select {
+	case event := <-events:
+		if nil == event || "Page.frameStartedLoading" != event.Method {
+			t.Errorf("Expected the recorded event, received %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+	if 0 != replay.Remaining() {
+		t.Errorf("Expected 0 remaining commands, received %d", replay.Remaining())
+	}
+
+	// Commands are replayed once.
+	err = replaySocket.Call(ctx, "Page.navigate", map[string]string{"url": "https://example.com"}, &result)
+	protocolErr, ok := err.(*Error)
+	if !ok || !strings.Contains(protocolErr.Message, "no recorded command matches Page.navigate") {
+		t.Errorf("Expected a replay mismatch error, received %v", err)
+	}
+}
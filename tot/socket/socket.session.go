@@ -0,0 +1,312 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+AttachToTarget attaches to a target using a flattened session and returns a
+Socket for the session. See AttachToTargetContext.
+*/
+func (socket *Socket) AttachToTarget(targetID target.ID, options ...Option) (*Socket, error) {
+	return socket.AttachToTargetContext(context.Background(), targetID, options...)
+}
+
+/*
+AttachToTargetContext attaches to a target using a flattened session and
+returns a Socket for the session. The session shares the connection of this
+socket: its commands are tagged with the session ID and the responses and
+events of the session are routed to it, so any number of targets can be
+driven over a single browser connection. The session Socket implements the
+full Protocoller API and is configured with the provided options, a
+reconnect policy has no effect on a session.
+
+Attaching from a session socket creates a nested session, for example for an
+iframe or a worker of the session target.
+
+When the browser detaches the session, commands waiting for a response fail
+with a TargetError wrapping ErrTargetDetached and the session stops
+listening. Disconnecting the session socket detaches it from the target.
+
+The browser may send messages for the session before the attach command
+completes, they are held until the session is created.
+*/
+func (socket *Socket) AttachToTargetContext(
+	ctx context.Context,
+	targetID target.ID,
+	options ...Option,
+) (*Socket, error) {
+	root := socket.sessionRoot()
+	root.sessionMux.Lock()
+	root.attaching++
+	root.sessionMux.Unlock()
+
+	result := &target.AttachToTargetResult{}
+	err := socket.Call(ctx, "Target.attachToTarget", &target.AttachToTargetParams{
+		ID:      targetID,
+		Flatten: true,
+	}, result)
+	if nil != err {
+		root.endAttach(nil)
+		return nil, errs.Wrap(err, 0, fmt.Sprintf("could not attach to target %s", targetID))
+	}
+
+	conn := &sessionConn{
+		messages:  newMessageQueue(),
+		mux:       &sync.Mutex{},
+		owner:     socket,
+		root:      root,
+		sessionID: string(result.SessionID),
+	}
+	root.endAttach(conn)
+
+	sessionURL := *root.url
+	sessionURL.Fragment = conn.sessionID
+	options = append(
+		[]Option{WithLogger(root.logger.WithFields(Fields{"session": conn.sessionID}))},
+		options...,
+	)
+	options = append(options, WithWebSocketer(conn), func(session *Socket) {
+		session.root = root
+		session.sessionID = conn.sessionID
+	})
+
+	conn.mux.Lock()
+	conn.socket = New(&sessionURL, options...)
+	conn.mux.Unlock()
+	return conn.socket, nil
+}
+
+/*
+SessionID returns the ID of the flattened session, or an empty string if the
+socket is not a session. See AttachToTarget.
+*/
+func (socket *Socket) SessionID() string {
+	return socket.sessionID
+}
+
+/*
+Sessions returns the flattened sessions attached over the connection of this
+socket, including nested sessions. See AttachToTarget.
+*/
+func (socket *Socket) Sessions() []*Socket {
+	root := socket.sessionRoot()
+	root.sessionMux.Lock()
+	defer root.sessionMux.Unlock()
+	sessions := make([]*Socket, 0, len(root.sessions))
+	for _, conn := range root.sessions {
+		if session := conn.session(); nil != session {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions
+}
+
+/*
+closeSessions closes every session attached over the connection of this
+socket. The connection the sessions shared has been lost so the targets are
+not detached.
+*/
+func (socket *Socket) closeSessions() {
+	socket.sessionMux.Lock()
+	conns := make([]*sessionConn, 0, len(socket.sessions))
+	for _, conn := range socket.sessions {
+		conns = append(conns, conn)
+	}
+	socket.sessionMux.Unlock()
+
+	for _, conn := range conns {
+		conn.close(false)
+		if session := conn.session(); nil != session {
+			go session.Stop()
+		}
+	}
+}
+
+/*
+endAttach ends an attach started with AttachToTargetContext. The session
+connection, unless the attach failed, is added to the sessions and receives
+the messages held for it. Messages held for other sessions are dropped once
+no attach is in progress.
+*/
+func (socket *Socket) endAttach(conn *sessionConn) {
+	socket.sessionMux.Lock()
+	defer socket.sessionMux.Unlock()
+	socket.attaching--
+	if nil != conn {
+		socket.sessions[conn.sessionID] = conn
+		conn.messages.push(socket.heldMessages[conn.sessionID]...)
+		delete(socket.heldMessages, conn.sessionID)
+	}
+	if 0 == socket.attaching {
+		for sessionID := range socket.heldMessages {
+			socket.logger.WithFields(Fields{"session": sessionID}).Debugf("messages for unknown session dropped")
+		}
+		socket.heldMessages = nil
+	}
+}
+
+/*
+handleDetachedSession ends a flattened session when the browser detaches it.
+*/
+func (socket *Socket) handleDetachedSession(response *Response) {
+	if "Target.detachedFromTarget" != response.Method {
+		return
+	}
+	event := &target.DetachedFromTargetEvent{}
+	json.Unmarshal([]byte(response.Params), event)
+
+	root := socket.sessionRoot()
+	root.sessionMux.Lock()
+	conn, ok := root.sessions[string(event.SessionID)]
+	root.sessionMux.Unlock()
+	if !ok {
+		return
+	}
+
+	session := conn.session()
+	if nil != session {
+		session.logger.Debugf("session detached from target")
+		session.endSession(&LifecycleEvent{
+			Err:    ErrTargetDetached,
+			Reason: "session detached from target",
+			Type:   TargetDetached,
+		})
+	}
+	conn.close(false)
+	if nil != session {
+		go session.Stop()
+	}
+}
+
+/*
+routeSession delivers a message that belongs to a flattened session to the
+session socket. It returns false if the message does not belong to a session
+or was read by the session socket itself.
+*/
+func (socket *Socket) routeSession(response *Response) bool {
+	if "" == response.SessionID || socket.sessionID == response.SessionID {
+		return false
+	}
+	socket.sessionMux.Lock()
+	conn, ok := socket.sessions[response.SessionID]
+	if !ok && socket.attaching > 0 {
+		// The message may belong to a session being attached.
+		if nil == socket.heldMessages {
+			socket.heldMessages = make(map[string][]*Response)
+		}
+		socket.heldMessages[response.SessionID] = append(socket.heldMessages[response.SessionID], response)
+		socket.sessionMux.Unlock()
+		return true
+	}
+	socket.sessionMux.Unlock()
+	if !ok {
+		socket.logger.WithFields(Fields{"session": response.SessionID}).Debugf("message for unknown session dropped")
+		return true
+	}
+	conn.messages.push(response)
+	return true
+}
+
+/*
+sessionRoot returns the socket that owns the connection shared by flattened
+sessions.
+*/
+func (socket *Socket) sessionRoot() *Socket {
+	if nil != socket.root {
+		return socket.root
+	}
+	return socket
+}
+
+/*
+sessionConn is the WebSocketer used by a flattened session. Payloads are
+tagged with the session ID and written to the root socket connection, and
+messages routed by the root socket are queued until they are read.
+*/
+type sessionConn struct {
+	messages  *messageQueue
+	mux       *sync.Mutex
+	owner     *Socket
+	root      *Socket
+	sessionID string
+	socket    *Socket
+}
+
+/*
+Close closes the session and detaches it from the target.
+
+Close is a WebSocketer implementation.
+*/
+func (conn *sessionConn) Close() error {
+	return conn.close(true)
+}
+
+/*
+ReadJSON waits for the next message routed to the session and unmarshalls it
+into the provided variable.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (conn *sessionConn) ReadJSON(v interface{}) error {
+	return conn.messages.read(v)
+}
+
+/*
+WriteJSON tags the payload with the session ID and writes it to the root
+socket connection.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (conn *sessionConn) WriteJSON(v interface{}) error {
+	payload, ok := v.(*Payload)
+	if !ok {
+		return errs.New(0, fmt.Sprintf("unsupported session message type %T", v))
+	}
+	select {
+	case <-conn.messages.done():
+		return ErrSocketClosed
+	default:
+	}
+
+	sessionPayload := *payload
+	sessionPayload.SessionID = conn.sessionID
+	return conn.root.WriteJSON(&sessionPayload)
+}
+
+/*
+close closes the session connection and removes it from the root socket. If
+detach is true and the root socket is still listening the session is detached
+from the target.
+*/
+func (conn *sessionConn) close(detach bool) error {
+	if !conn.messages.close() {
+		return nil
+	}
+
+	conn.root.sessionMux.Lock()
+	delete(conn.root.sessions, conn.sessionID)
+	conn.root.sessionMux.Unlock()
+
+	if !detach || !conn.owner.isListening() {
+		return nil
+	}
+	return conn.owner.Call(context.Background(), "Target.detachFromTarget", &target.DetachFromTargetParams{
+		SessionID: target.SessionID(conn.sessionID),
+	}, nil)
+}
+
+/*
+session returns the session socket, if it has been created.
+*/
+func (conn *sessionConn) session() *Socket {
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	return conn.socket
+}
@@ -0,0 +1,189 @@
+package socket
+
+import (
+	"encoding/json"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+/*
+sessionWebSocket is a WebSocketer that emulates a browser connection with
+flattened sessions. The attached messages are sent before the response to
+Target.attachToTarget.
+*/
+type sessionWebSocket struct {
+	attached  []*Response
+	mux       *sync.Mutex
+	payloads  []*Payload
+	responses chan *Response
+}
+
+func (socket *sessionWebSocket) Close() error {
+	return nil
+}
+
+func (socket *sessionWebSocket) ReadJSON(v interface{}) error {
+	var data interface{}
+	select {
+	case data = <-socket.responses:
+	case <-time.After(10 * time.Millisecond):
+		data = &Response{Method: "Unknown.event"}
+	}
+	jsonBytes, _ := json.Marshal(data)
+	return json.Unmarshal(jsonBytes, &v)
+}
+
+func (socket *sessionWebSocket) WriteJSON(v interface{}) error {
+	jsonBytes, _ := json.Marshal(v)
+	payload := &Payload{}
+	json.Unmarshal(jsonBytes, payload)
+	socket.mux.Lock()
+	socket.payloads = append(socket.payloads, payload)
+	socket.mux.Unlock()
+
+	result := `{}`
+	switch payload.Method {
+	case "Target.attachToTarget":
+		result = `{"sessionId":"session-1"}`
+		for _, message := range socket.attached {
+			socket.responses <- message
+		}
+	case "Browser.getVersion":
+		result = `{"product":"session-product"}`
+	}
+	socket.responses <- &Response{
+		ID:        payload.ID,
+		Result:    []byte(result),
+		SessionID: payload.SessionID,
+	}
+	return nil
+}
+
+func TestSessionAttachToTarget(t *testing.T) {
+	conn := &sessionWebSocket{
+		mux:       &sync.Mutex{},
+		responses: make(chan *Response, 100),
+	}
+	socketURL, _ := url.Parse("https://test:9222/TestSessionAttachToTarget")
+	mockSocket := NewMock(socketURL)
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		return conn, nil
+	}
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	session, err := mockSocket.AttachToTarget("target-1")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "session-1" != session.SessionID() {
+		t.Errorf("Expected 'session-1', received '%s'", session.SessionID())
+	}
+	if 1 != len(mockSocket.Sessions()) {
+		t.Errorf("Expected 1 session, received %d", len(mockSocket.Sessions()))
+	}
+
+	result := <-session.Browser().GetVersion()
+	if nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+	if "session-product" != result.Product {
+		t.Errorf("Expected 'session-product', received '%s'", result.Product)
+	}
+	conn.mux.Lock()
+	payload := conn.payloads[len(conn.payloads)-1]
+	conn.mux.Unlock()
+	if "session-1" != payload.SessionID {
+		t.Errorf("Expected 'session-1', received '%s'", payload.SessionID)
+	}
+
+	// Events are routed to the session only.
+	events := make(chan string, 2)
+	mockSocket.AddEventHandler(NewEventHandler("Page.loadEventFired", func(response *Response) {
+		events <- "root"
+	}))
+	session.AddEventHandler(NewEventHandler("Page.loadEventFired", func(response *Response) {
+		events <- "session"
+	}))
+	conn.responses <- &Response{
+		Method:    "Page.loadEventFired",
+		Params:    []byte(`{"timestamp":1}`),
+		SessionID: "session-1",
+	}
+	select {
+	case name := <-events:
+		if "session" != name {
+			t.Errorf("Expected the session handler to be called, received '%s'", name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+
+	conn.responses <- &Response{
+		Method: "Target.detachedFromTarget",
+		Params: []byte(`{"sessionId":"session-1"}`),
+	}
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Session did not stop")
+	}
+	if ErrTargetDetached != session.Err() {
+		t.Errorf("Expected ErrTargetDetached, received '%v'", session.Err())
+	}
+	if 0 != len(mockSocket.Sessions()) {
+		t.Errorf("Expected 0 sessions, received %d", len(mockSocket.Sessions()))
+	}
+}
+
+func TestSessionMessagesBeforeAttach(t *testing.T) {
+	conn := &sessionWebSocket{
+		attached: []*Response{
+			{Method: "Runtime.executionContextCreated", Params: []byte(`{"context":{"id":1}}`), SessionID: "session-1"},
+			{Method: "Runtime.executionContextCreated", Params: []byte(`{"context":{"id":2}}`), SessionID: "session-2"},
+		},
+		mux:       &sync.Mutex{},
+		responses: make(chan *Response, 100),
+	}
+	socketURL, _ := url.Parse("https://test:9222/TestSessionMessagesBeforeAttach")
+	mockSocket := NewMock(socketURL)
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		return conn, nil
+	}
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	events := make(chan *Response, 10)
+	session, err := mockSocket.AttachToTarget("target-1", WithResponseMiddleware(func(next DispatchFunc) DispatchFunc {
+		return func(response *Response) {
+			if "" != response.Method && "Unknown.event" != response.Method {
+				events <- response
+			}
+			next(response)
+		}
+	}))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer session.Stop()
+
+	// The event sent before the attach response is delivered to the session.
+	select {
+	case event := <-events:
+		if "Runtime.executionContextCreated" != event.Method || `{"context":{"id":1}}` != string(event.Params) {
+			t.Errorf("Expected the session-1 event, received %s %s", event.Method, event.Params)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+
+	// Messages held for other sessions are dropped.
+	mockSocket.sessionMux.Lock()
+	held := len(mockSocket.heldMessages)
+	mockSocket.sessionMux.Unlock()
+	if 0 != held {
+		t.Errorf("Expected no held messages, found %d", held)
+	}
+}
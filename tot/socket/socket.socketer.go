@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -13,18 +14,25 @@ import (
 
 /*
 New returns a pointer to a websocket struct that implements Socketer interface
-listening to the specified URL.
+listening to the specified URL. Any provided options are applied before the
+socket starts listening.
 */
-func New(url *url.URL) *Socket {
+func New(url *url.URL, options ...Option) *Socket {
 	socket := &Socket{
+		abandoned:    make(map[int]time.Time),
+		abandonedMux: &sync.Mutex{},
 		commandIDMux: &sync.Mutex{},
 		commands:     NewCommandMap(),
+		failMux:      &sync.Mutex{},
 		handlers:     NewEventHandlerMap(),
 		mux:          &sync.Mutex{},
 		newSocket:    NewWebsocket,
+		sessionMux:   &sync.Mutex{},
+		sessions:     make(map[string]*sessionConn),
 		socketID:     NextSocketID(),
 		url:          url,
 	}
+	socket.logger = NewLogrusLogger(log.StandardLogger())
 
 	// Init the protocol interfaces for the API.
 	socket.accessibility = &AccessibilityProtocol{Socket: socket}
@@ -47,6 +55,7 @@ func New(url *url.URL) *Socket {
 	socket.heapProfiler = &HeapProfilerProtocol{Socket: socket}
 	socket.indexedDB = &IndexedDBProtocol{Socket: socket}
 	socket.input = &InputProtocol{Socket: socket}
+	socket.inspector = &InspectorProtocol{Socket: socket}
 	socket.io = &IOProtocol{Socket: socket}
 	socket.layerTree = &LayerTreeProtocol{Socket: socket}
 	socket.log = &LogProtocol{Socket: socket}
@@ -66,8 +75,13 @@ func New(url *url.URL) *Socket {
 	socket.tethering = &TetheringProtocol{Socket: socket}
 	socket.tracing = &TracingProtocol{Socket: socket}
 
+	for _, option := range options {
+		option(socket)
+	}
+	socket.logger = socket.logger.WithFields(Fields{"socket": socket.socketID})
+
 	socket.Listen()
-	log.Infof("socket #%d - New socket connection listening on %s", socket.socketID, socket.url)
+	socket.logger.Debugf("listening on %s", socket.url)
 
 	return socket
 }
@@ -96,6 +110,8 @@ type Socket struct {
 	commands     CommandMapper
 	conn         WebSocketer
 	connected    bool
+	done         chan struct{}
+	failMux      *sync.Mutex
 	handlers     EventHandlerMapper
 	listenCh     chan bool
 	listenErr    errs.Err
@@ -105,6 +121,65 @@ type Socket struct {
 	socketID     int
 	url          *url.URL
 
+	// Optional. reconnectPolicy enables automatic reconnection when the
+	// websocket connection is lost.
+	reconnectPolicy *ReconnectPolicy
+
+	// reconnecting is set while the connection is re-established, see
+	// reconnect. It is closed when the reconnect finishes and remains set if
+	// the reconnect failed.
+	reconnecting chan struct{}
+
+	// Optional. Middleware chains, see WithCommandMiddleware and
+	// WithResponseMiddleware.
+	commandMiddleware  []CommandMiddleware
+	responseMiddleware []ResponseMiddleware
+
+	// Optional. payloadStrategies are the large payload strategies added with
+	// WithPayloadStrategy.
+	payloadStrategies map[string]PayloadStrategy
+
+	// Flattened sessions, see AttachToTarget. root is the socket that owns
+	// the connection of a session socket, sessions are the sessions routed by
+	// a root socket. Messages for unknown sessions are held while attaching.
+	attaching    int
+	heldMessages map[string][]*Response
+	root         *Socket
+	sessionID    string
+	sessionMux   *sync.Mutex
+	sessions     map[string]*sessionConn
+
+	// Optional. recorder records the messages exchanged over the connection,
+	// see WithRecorder.
+	recorder *Recorder
+
+	// Optional. Default command timeouts, see WithCommandTimeout. Abandoned
+	// commands are remembered to discard late responses, abandonedOrder
+	// holds them in the order they expire.
+	abandoned       map[int]time.Time
+	abandonedMux    *sync.Mutex
+	abandonedOrder  []int
+	commandTimeouts map[string]time.Duration
+	defaultTimeout  time.Duration
+
+	// Optional. Connection health monitoring, see WithHeartbeat.
+	health          Health
+	heartbeatPolicy *HeartbeatPolicy
+
+	// logger is the structured logger for the socket, see WithLogger.
+	logger Logger
+
+	// Debugging session state, see Err and WithLifecycleHandler.
+	lifecycleHandler func(socket *Socket, event *LifecycleEvent)
+	targetErr        error
+
+	// Optional. Ordered event delivery, see WithOrderedEvents.
+	droppedEvents  uint64
+	eventOverflow  OverflowPolicy
+	eventQueueMux  *sync.Mutex
+	eventQueueSize int
+	eventQueues    map[EventHandler]*eventQueue
+
 	// Protocol interfaces for the API.
 	accessibility        *AccessibilityProtocol
 	animation            *AnimationProtocol
@@ -126,6 +201,7 @@ type Socket struct {
 	heapProfiler         *HeapProfilerProtocol
 	indexedDB            *IndexedDBProtocol
 	input                *InputProtocol
+	inspector            *InspectorProtocol
 	io                   *IOProtocol
 	layerTree            *LayerTreeProtocol
 	log                  *LogProtocol
@@ -157,6 +233,46 @@ func (socket *Socket) AddEventHandler(
 	socket.handlers.Add(handler)
 }
 
+/*
+Call sends a command for any protocol method and waits for the result. It is
+an escape hatch for methods that don't have a generated wrapper yet.
+
+The params are encoded as JSON and may be nil. The result of the command is
+decoded into result unless it is nil. Errors are reported the same way as the
+generated methods: a protocol error is returned as an *Error, a failure to
+deliver the command as a TransportError and an exceeded context deadline as a
+TimeoutError.
+
+	result := struct {
+		Product string `json:"product"`
+	}{}
+	err := socket.Call(ctx, "Browser.getVersion", nil, &result)
+
+Call is a Socketer implementation.
+*/
+func (socket *Socket) Call(
+	ctx context.Context,
+	method string,
+	params interface{},
+	result interface{},
+) error {
+	command := NewCommand(socket, method, params)
+	response := <-socket.SendCommandContext(ctx, command)
+	if nil != response.Err {
+		return response.Err
+	}
+	if nil != response.Error && 0 != response.Error.Code {
+		return response.Error
+	}
+	if nil == result || 0 == len(response.Result) {
+		return nil
+	}
+	if err := json.Unmarshal(response.Result, result); nil != err {
+		return errs.Wrap(err, 0, fmt.Sprintf("could not decode %s result", method))
+	}
+	return nil
+}
+
 /*
 CurCommandID returns the latest command ID.
 
@@ -169,42 +285,45 @@ func (socket *Socket) CurCommandID() int {
 	return id
 }
 
+/*
+Done returns a channel that is closed when the socket stops listening.
+
+Done is a Socketer implementation.
+*/
+func (socket *Socket) Done() <-chan struct{} {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	return socket.done
+}
+
 /*
 handleResponse receives the responses to requests sent to the websocket
 connection.
 */
 func (socket *Socket) handleResponse(response *Response) {
-	// Log a message on error
+	// Log a message on error, late responses to abandoned commands are
+	// expected.
 	if command, err := socket.commands.Get(response.ID); nil != err {
-		err = errs.Wrap(err, 0, fmt.Sprintf("command #%d not found", response.ID))
+		if socket.isAbandoned(response.ID) {
+			return
+		}
 		errorMessage := ""
 		if nil != response.Error && 0 != response.Error.Code {
 			errorMessage = response.Error.Error()
 		}
-		log.Debugf(
-			"socket #%d - socket.handleResponse(): %v - result=%s err='%s'",
-			socket.socketID,
-			err,
+		socket.logger.WithFields(Fields{"command": response.ID}).Debugf(
+			"response discarded, command not found: result=%s err='%s'",
 			response.Result,
 			errorMessage,
 		)
 
 	} else {
-		log.Debugf(
-			"socket #%d - socket.handleResponse(): executing handler for command #%d - %s",
-			socket.socketID,
-			command.ID(),
-			command.Method(),
-		)
+		if nil != response.Error {
+			response.Error.Method = command.Method()
+		}
 		command.Respond(response)
 		socket.commands.Delete(command.ID())
-		log.Debugf(
-			"socket #%d - Command #%d complete: %s{%s}",
-			socket.socketID,
-			command.ID(),
-			socket.URL().String(),
-			command.Method(),
-		)
+		socket.commandLogger(command).Debugf("command complete")
 	}
 }
 
@@ -215,24 +334,29 @@ connection.
 func (socket *Socket) handleEvent(
 	response *Response,
 ) {
-	log.Debugf(
-		"socket #%d - socket.handleEvent(): handling event %s:{%s}",
-		socket.socketID,
-		socket.URL(),
-		response.Method,
-	)
-
-	if response.Method == "Inspector.targetCrashed" {
-		log.Errorf("socket #%d - Chrome has crashed!", socket.socketID)
+	if nil != response.Error {
+		response.Error.Method = response.Method
 	}
 
-	if handlers, err := socket.handlers.Get(response.Method); nil != err {
-		log.Errorf("socket #%d - %v", socket.socketID, err)
+	socket.handleLifecycle(response)
+	socket.handleDetachedSession(response)
+
+	// Handlers may be removed while the event is delivered, the stack is
+	// replaced rather than modified so the snapshot stays valid.
+	socket.handlers.Lock()
+	handlers, err := socket.handlers.Get(response.Method)
+	socket.handlers.Unlock()
+	if nil != err {
+		socket.logger.WithFields(Fields{"method": response.Method}).Debugf("no handlers for event")
 
 	} else {
-		for a, event := range handlers {
-			log.Infof("socket #%d - Executing handler #%d for event %s", socket.socketID, a, response.Method)
-			go event.Handle(response)
+		socket.logger.WithFields(Fields{"method": response.Method}).Debugf("executing %d event handlers", len(handlers))
+		for _, event := range handlers {
+			if socket.eventQueueSize > 0 {
+				socket.enqueueEvent(event, response)
+			} else {
+				go event.Handle(response)
+			}
 		}
 	}
 }
@@ -243,40 +367,48 @@ handleUnknown receives all other socket responses.
 func (socket *Socket) handleUnknown(
 	response *Response,
 ) {
-	log.Debugf(
-		"socket #%d - socket.handleUnknown(): handling unexpected data from %s",
-		socket.socketID,
-		socket.URL(),
-	)
-	var command Commander
-	var err error
-
 	// Check for a command listening for ID 0
-	if command, err = socket.commands.Get(response.ID); nil != err {
-		err = errs.Wrap(err, 0, fmt.Sprintf("command #%d not found", response.ID))
-		if nil != response.Error && 0 != response.Error.Code {
-			err = err.(errs.Err).With(response.Error, err.Error())
-		}
-		log.Debugf(
-			"socket #%d - socket.handleUnknown(): result='%s' err='%v'",
-			socket.socketID,
+	command, err := socket.commands.Get(response.ID)
+	if nil != err {
+		socket.logger.Debugf(
+			"unknown message discarded: result='%s' err='%v'",
 			response.Result,
-			err,
+			response.Error,
 		)
 		return
 	}
 
 	command.Respond(response)
-	log.Debugf(
-		"socket #%d - socket.handleUnknown(): %v - Unrecognised socket message sent to command #%d - method: '%s' - Error: %v",
-		socket.socketID,
-		err,
-		command.ID(),
-		command.Method(),
+	socket.commandLogger(command).Debugf(
+		"unknown message sent to command: err='%v'",
 		response.Error,
 	)
 }
 
+/*
+handleMessage delivers a message read from the websocket connection to
+handleResponse(), handleEvent() or handleUnknown() as appropriate.
+*/
+func (socket *Socket) handleMessage(response *Response) {
+	if response.ID > 0 {
+		socket.handleResponse(response)
+
+	} else if "" != response.Method {
+		socket.handleEvent(response)
+
+	} else {
+		tmp, _ := json.Marshal(response)
+		socket.logger.WithFields(Fields{"data": string(tmp)}).Warnf("unknown response from websocket")
+
+		if nil == response.Error {
+			response.Error = &Error{
+				Message: "Unknown response from web socket",
+			}
+		}
+		socket.handleUnknown(response)
+	}
+}
+
 /*
 Listen starts the socket read loop and delivers messages to handleResponse() and
 handleEvent() as appropriate.
@@ -284,13 +416,29 @@ handleEvent() as appropriate.
 Listen is a Socketer implementation.
 */
 func (socket *Socket) Listen() {
+	done := make(chan struct{})
+	socket.mux.Lock()
+	socket.done = done
+	socket.health = Health{Healthy: true}
+	socket.reconnecting = nil
+	socket.targetErr = nil
 	socket.listenCh = make(chan bool)
 	socket.listening = true
-	go socket.listen()
+	socket.mux.Unlock()
+	go socket.listen(done)
+	if nil != socket.heartbeatPolicy {
+		go socket.heartbeat(done)
+	}
 }
 
-func (socket *Socket) listen() error {
+func (socket *Socket) listen(done chan struct{}) error {
 	var err error
+	defer close(done)
+	defer socket.endSession(&LifecycleEvent{
+		Err:  ErrSocketClosed,
+		Type: SocketClosed,
+	})
+	defer socket.closeSessions()
 
 	err = socket.Connect()
 	if nil != err {
@@ -302,58 +450,36 @@ func (socket *Socket) listen() error {
 		response := &Response{}
 		err = socket.ReadJSON(&response)
 		if nil != err {
-			log.Errorf("socket #%d - %v", socket.socketID, err)
-			socket.listenErr.With(err, fmt.Sprintf("socket #%d - socket read failed", socket.socketID))
+			socket.logger.Errorf("%v", err)
+			if nil != socket.reconnectPolicy && socket.isListening() {
+				if err = socket.reconnect(); nil == err {
+					continue
+				}
+				socket.addListenErr(err, fmt.Sprintf("socket #%d - socket reconnect failed", socket.socketID))
+				break
+			}
+			socket.addListenErr(err, fmt.Sprintf("socket #%d - socket read failed", socket.socketID))
+			break
 		}
 		if 0 == response.ID &&
 			"" == response.Method &&
 			0 == len(response.Params) &&
 			0 == len(response.Result) {
-			log.Errorf("socket #%d - nil response from socket", socket.socketID)
+			socket.logger.Errorf("nil response from socket")
 		}
 
-		if response.ID > 0 {
-			log.Debugf(
-				"socket #%d - socket.Listen(): Response ID #%d, sending to command handler",
-				socket.socketID,
-				response.ID,
-			)
-			socket.handleResponse(response)
-
-		} else if "" != response.Method {
-			log.Debugf(
-				"socket #%d - socket.Listen(): Response method %s, sending to event handler",
-				socket.socketID,
-				response.Method,
-			)
-			socket.handleEvent(response)
-
-		} else {
-			tmp, _ := json.Marshal(response)
-			log.WithFields(log.Fields{
-				"socket": socket.socketID,
-				"method": "socket.handleUnknown()",
-				"data":   string(tmp),
-			}).Errorf(
-				"socket #%d - Unknown response from web socket: id='%d', method='%s'",
-				socket.socketID,
-				response.ID,
-				response.Method,
-			)
-
-			if nil == response.Error {
-				response.Error = &Error{
-					Message: "Unknown response from web socket",
-				}
-			}
-			socket.handleUnknown(response)
+		if !socket.routeSession(response) {
+			socket.dispatch(response)
 		}
 
-		if !socket.listening {
-			log.Infof("socket #%d - %s: Socket shutting down", socket.socketID, socket.URL().String())
+		if !socket.isListening() {
+			socket.logger.Debugf("shutting down")
+			socket.mux.Lock()
+			listenCh := socket.listenCh
+			socket.mux.Unlock()
 			go func() {
 				select {
-				case socket.listenCh <- true:
+				case listenCh <- true:
 				case <-time.After(10 * time.Second):
 				}
 			}()
@@ -364,10 +490,43 @@ func (socket *Socket) listen() error {
 	if nil != err {
 		err = errs.Wrap(err, 0, "socket read failed")
 	}
+	socket.stopEventQueues()
+	socket.mux.Lock()
 	socket.listening = false
+	socket.mux.Unlock()
 	return err
 }
 
+/*
+isListening returns whether the read loop is running.
+*/
+func (socket *Socket) isListening() bool {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	return socket.listening
+}
+
+/*
+addListenErr adds an error to the errors returned by Stop.
+*/
+func (socket *Socket) addListenErr(err error, message string) {
+	socket.mux.Lock()
+	socket.listenErr = socket.listenErr.With(err, message)
+	socket.mux.Unlock()
+}
+
+/*
+listenError returns the errors collected by the read loop, or nil.
+*/
+func (socket *Socket) listenError() error {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if 0 == len(socket.listenErr) {
+		return nil
+	}
+	return socket.listenErr
+}
+
 /*
 NextCommandID generates and returns the next command ID.
 
@@ -394,20 +553,25 @@ func (socket *Socket) RemoveEventHandler(
 
 	handlers, err := socket.handlers.Get(handler.Name())
 	if nil != err {
-		log.Warnf("socket #%d - RemoveEventHandler(): Could not remove handler: %s", socket.socketID, err.Error())
+		socket.logger.WithFields(Fields{"method": handler.Name()}).Warnf("could not remove handler: %s", err.Error())
 		return errs.Wrap(err, 0, fmt.Sprintf("failed to remove event handler '%s'", handler.Name()))
 	}
 
 	for i, hndlr := range handlers {
 		if hndlr == handler {
-			handlers = append(handlers[:i], handlers[i+1:]...)
-			socket.handlers.Set(handler.Name(), handlers)
-			log.Infof("socket #%d - RemoveEventHandler(): Removed event handler %d from %s", socket.socketID, i, handler.Name())
+			// Copy the stack, handleEvent may be iterating over the current
+			// one.
+			remaining := make([]EventHandler, 0, len(handlers)-1)
+			remaining = append(remaining, handlers[:i]...)
+			remaining = append(remaining, handlers[i+1:]...)
+			socket.handlers.Set(handler.Name(), remaining)
+			socket.stopEventQueue(handler)
+			socket.logger.WithFields(Fields{"method": handler.Name()}).Debugf("removed event handler %d", i)
 			return nil
 		}
 	}
 
-	log.Warnf("socket #%d - RemoveEventHandler(): handler not found", socket.socketID)
+	socket.logger.WithFields(Fields{"method": handler.Name()}).Warnf("could not remove handler: handler not found")
 	return nil
 }
 
@@ -417,44 +581,103 @@ SendCommand delivers a command payload to the websocket connection.
 SendCommand is a Socketer implementation.
 
 Workflow:
-	1. The socket's command mutex is locked.
-	2. The command counter is incremented.
-	3. The payload is sent to the socket connection and the mutex is unlocked.
-	4. The command is stored using the generated ID.
-	5. When the command has been executed and the socket responds,
-	socket.HandleCmd() is triggered from the command instance to generate the
-	response and the command unlocks itself.
+	1. The command is stored using its ID.
+	2. The payload is sent to the socket connection. If the target crashed or
+	was detached, see Err, or the write fails the command is removed from the
+	stack and a response containing a TargetError or TransportError is
+	returned.
+	3. When the command has been executed and the socket responds,
+	socket.handleResponse() is triggered to deliver the response to the
+	command's response channel and remove it from the stack.
+
+If a default timeout applies to the command method, see WithCommandTimeout,
+the command is sent with SendCommandContext and a new response channel is
+returned.
 */
 func (socket *Socket) SendCommand(command Commander) chan *Response {
-	log.Debugf(
-		"socket #%d - socket.SendCommand(): sending command #%d ('%+v') payload to socket",
-		socket.socketID,
-		command.ID(),
-		command.Method(),
-	)
+	if socket.commandTimeout(command.Method()) > 0 {
+		return socket.SendCommandContext(context.Background(), command)
+	}
+	return socket.sendCommand(command)
+}
+
+/*
+sendCommand delivers a command payload to the websocket connection, see
+SendCommand.
+*/
+func (socket *Socket) sendCommand(command Commander) chan *Response {
+	socket.commandLogger(command).Debugf("sending command payload")
+
+	// Store the command before writing the payload so a fast response can't
+	// arrive before the command is available to handle it.
+	socket.commands.Set(command)
+
 	go func() {
+		if err := socket.commandErr(command.Method()); nil != err {
+			socket.failCommands([]Commander{command}, err)
+			return
+		}
+
 		payload := &Payload{
 			ID:     command.ID(),
 			Method: command.Method(),
 			Params: command.Params(),
 		}
 
-		if err := socket.WriteJSON(payload); err != nil {
-			err = errs.Wrap(err, 0, "write failed: could not write data to websocket")
-			command.Respond(&Response{Error: &Error{
-				Code:    1,
-				Data:    []byte(fmt.Sprintf(`"%#v"`, err)),
-				Message: "Failed to send command payload to socket connection",
-			}})
-			return
+		if err := socket.send(payload); err != nil {
+			socket.failCommands([]Commander{command}, err)
 		}
-
-		socket.commands.Set(command)
 	}()
 
 	return command.Response()
 }
 
+/*
+SendCommandContext delivers a command payload to the websocket connection and
+abandons the command if the context is done before a response is received.
+
+When the context ends first the command is removed from the stack and a
+response containing the error is delivered. The error is also stored on the
+command and can be retrieved with command.Error(). An exceeded deadline is
+reported as a TimeoutError, cancellation as context.Canceled. Any response
+that arrives later is discarded. If the context has no deadline the default
+timeout of the command method applies, see WithCommandTimeout.
+
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *Socket) SendCommandContext(
+	ctx context.Context,
+	command Commander,
+) chan *Response {
+	responseChan := make(chan *Response, 1)
+
+	if err := ctx.Err(); nil != err {
+		err = contextError(command.Method(), err)
+		command.SetError(err)
+		responseChan <- &Response{Err: err, ID: command.ID()}
+		close(responseChan)
+		return responseChan
+	}
+
+	ctx, cancel := socket.withCommandTimeout(ctx, command)
+	go func() {
+		defer cancel()
+		select {
+		case response := <-socket.sendCommand(command):
+			responseChan <- response
+		case <-ctx.Done():
+			socket.abandon(command)
+			err := contextError(command.Method(), ctx.Err())
+			command.SetError(err)
+			socket.commandLogger(command).Debugf("command abandoned: %v", ctx.Err())
+			responseChan <- &Response{Err: err, ID: command.ID()}
+		}
+		close(responseChan)
+	}()
+
+	return responseChan
+}
+
 /*
 Stop signals the socket read loop to stop listening for data and close the
 websocket connection.
@@ -462,19 +685,25 @@ websocket connection.
 Stop is a Socketer implementation.
 */
 func (socket *Socket) Stop() error {
-	if socket.listening {
-		socket.listening = false
+	socket.mux.Lock()
+	listening := socket.listening
+	socket.listening = false
+	listenCh := socket.listenCh
+	socket.mux.Unlock()
+
+	if listening {
 		select {
-		case <-socket.listenCh:
+		case <-listenCh:
 		case <-time.After(1 * time.Second):
-			socket.conn.Close()
+			socket.mux.Lock()
+			if nil != socket.conn {
+				socket.conn.Close()
+			}
+			socket.mux.Unlock()
 		}
-		log.Debugf("socket #%d: socket stopped", socket.socketID)
+		socket.logger.Debugf("socket stopped")
 	}
-	if 0 == len(socket.listenErr) {
-		return nil
-	}
-	return socket.listenErr
+	return socket.listenError()
 }
 
 /*
@@ -485,3 +714,52 @@ URL is a Socketer implementation.
 func (socket *Socket) URL() *url.URL {
 	return socket.url
 }
+
+/*
+WaitForEvent subscribes to an event and returns a channel that receives the
+first event for which the predicate returns true. A nil predicate matches any
+event. The subscription is created before WaitForEvent returns, so the command
+that triggers the event can be sent afterwards without missing it:
+
+	loaded := socket.WaitForEvent(ctx, "Page.loadEventFired", nil)
+	<-socket.Page().Navigate(&page.NavigateParams{URL: "https://example.com"})
+	response := <-loaded
+
+If the context is done or the socket stops listening before a matching event
+is received, a response is delivered with Err set to a TimeoutError, the
+context error or a TransportError wrapping ErrSocketClosed.
+
+WaitForEvent is a Socketer implementation.
+*/
+func (socket *Socket) WaitForEvent(
+	ctx context.Context,
+	method string,
+	predicate func(response *Response) bool,
+) chan *Response {
+	responseChan := make(chan *Response, 1)
+	stream := NewEventStream(ctx, socket, method)
+
+	go func() {
+		defer close(responseChan)
+		defer stream.Close()
+
+		for response := range stream.Responses() {
+			if nil == predicate || predicate(response) {
+				stream.Close()
+				responseChan <- response
+				return
+			}
+		}
+
+		var err error = &TransportError{Err: ErrSocketClosed, Method: method}
+		if nil != ctx.Err() {
+			err = contextError(method, ctx.Err())
+		}
+		responseChan <- &Response{
+			Err:    err,
+			Method: method,
+		}
+	}()
+
+	return responseChan
+}
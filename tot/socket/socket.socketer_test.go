@@ -1,6 +1,8 @@
 package socket
 
 import (
+	"context"
+	"errors"
 	"net/url"
 	"reflect"
 	"testing"
@@ -39,6 +41,31 @@ func TestSocketStop(t *testing.T) {
 	}
 }
 
+func TestSocketStopConcurrent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketStopConcurrent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+
+	// Stop is called from several goroutines while the read loop runs.
+	errChan := make(chan error, 3)
+	for a := 0; a < 3; a++ {
+		go func() { errChan <- mockSocket.Stop() }()
+	}
+	for a := 0; a < 3; a++ {
+		if err := <-errChan; nil != err {
+			t.Errorf("Expected nil, got error: %v", err)
+		}
+	}
+	select {
+	case <-mockSocket.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Socket did not stop listening")
+	}
+	if mockSocket.isListening() {
+		t.Errorf("Expected the socket to stop listening")
+	}
+}
+
 func TestSocketDisconnect(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestSocketDisconnect")
 	mockSocket := NewMock(socketURL)
@@ -80,6 +107,87 @@ func TestListenCommand(t *testing.T) {
 	}
 }
 
+func TestCall(t *testing.T) {
+	var mockSocket *Socket
+	respond := func(next SendFunc) SendFunc {
+		return func(payload *Payload) error {
+			err := next(payload)
+			response := &Response{
+				ID:     payload.ID,
+				Error:  &Error{},
+				Result: []byte(`{"product":"Mock Product"}`),
+			}
+			if "Some.unknownMethod" == payload.Method {
+				response.Error = &Error{Code: -32601, Message: "'Some.unknownMethod' wasn't found"}
+			}
+			mockSocket.Conn().(*MockChromeWebSocket).AddMockData(response)
+			return err
+		}
+	}
+	socketURL, _ := url.Parse("https://test:9222/TestCall")
+	mockSocket = NewMock(socketURL, WithCommandMiddleware(respond))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	result := struct {
+		Product string `json:"product"`
+	}{}
+	if err := mockSocket.Call(context.Background(), "Browser.getVersion", nil, &result); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if "Mock Product" != result.Product {
+		t.Errorf("Expected 'Mock Product', received '%s'", result.Product)
+	}
+
+	err := mockSocket.Call(context.Background(), "Some.unknownMethod", map[string]int{"param": 1}, nil)
+	if !errors.Is(err, ErrMethodNotFound) {
+		t.Errorf("Expected ErrMethodNotFound, received '%v'", err)
+	}
+}
+
+func TestSendCommandContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandContext")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommandContext(ctx, command)
+	cancel()
+	<-resultChan
+	if context.Canceled != command.Error() {
+		t.Errorf("Expected context.Canceled, received '%v'", command.Error())
+	}
+	if _, err := mockSocket.commands.Get(command.ID()); nil == err {
+		t.Errorf("Expected command #%d to be removed from the command map", command.ID())
+	}
+
+	// A late response must be discarded without blocking the read loop.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+
+	command = NewCommand(mockSocket, "Some.method", nil)
+	resultChan = mockSocket.SendCommandContext(context.Background(), command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	result := <-resultChan
+	if nil != command.Error() {
+		t.Errorf("Expected nil, received error: %v", command.Error())
+	}
+	if `"Mock Command Result"` != string(result.Result) {
+		t.Errorf("Invalid result: expected 'Mock Command Result', received '%s'", result.Result)
+	}
+}
+
 func TestListenCommandError(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestListenCommandError")
 	mockSocket := NewMock(socketURL)
@@ -120,6 +228,46 @@ func TestListenCommandUnknown(t *testing.T) {
 	}
 }
 
+func TestWaitForEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestWaitForEvent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := mockSocket.WaitForEvent(
+		context.Background(),
+		"Test.event",
+		func(response *Response) bool {
+			return `"match"` == string(response.Params)
+		},
+	)
+	for _, params := range []string{`"no match"`, `"match"`} {
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: "Test.event",
+			Params: []byte(params),
+		})
+	}
+	result := <-resultChan
+	if nil != result.Error && 0 != result.Error.Code {
+		t.Errorf("Expected nil, received error: %v", result.Error)
+	}
+	if `"match"` != string(result.Params) {
+		t.Errorf("Expected 'match', received '%s'", result.Params)
+	}
+	handlers, _ := mockSocket.handlers.Get("Test.event")
+	if 0 != len(handlers) {
+		t.Errorf("Expected no handlers, found %d", len(handlers))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result = <-mockSocket.WaitForEvent(ctx, "Test.event", nil)
+	if !errors.Is(result.Err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, received '%v'", result.Err)
+	}
+}
+
 func TestRemoveEventHandler(t *testing.T) {
 	var err error
 	socketURL, _ := url.Parse("https://test:9222/TestRemoveEventHandler")
@@ -154,6 +302,22 @@ func TestRemoveEventHandler(t *testing.T) {
 	if nil != err {
 		t.Errorf("Expected nil, received error: %s", err.Error())
 	}
+
+	// A stack being delivered isn't changed by the removal
+	mockSocket.AddEventHandler(handler1)
+	mockSocket.AddEventHandler(handler2)
+	delivering, _ := mockSocket.handlers.Get("Test.event")
+	err = mockSocket.RemoveEventHandler(handler1)
+	if nil != err {
+		t.Errorf("Expected nil, received error: %s", err.Error())
+	}
+	if 2 != len(delivering) || handler1 != delivering[0] || handler2 != delivering[1] {
+		t.Errorf("Expected the delivered stack to be unchanged, received %v", delivering)
+	}
+	handlers, _ := mockSocket.handlers.Get("Test.event")
+	if 1 != len(handlers) || handler2 != handlers[0] {
+		t.Errorf("Expected only handler2, received %v", handlers)
+	}
 }
 
 //func TestReadJSONError(t *testing.T) {
@@ -0,0 +1,90 @@
+package socket
+
+import (
+	"sync/atomic"
+)
+
+/*
+Subscribe adds an event handler to the socket and returns a Subscription that
+can be used to remove it.
+*/
+func Subscribe(socket Socketer, handler EventHandler) *Subscription {
+	subscription := &Subscription{
+		handler: handler,
+		socket:  socket,
+	}
+	socket.AddEventHandler(subscription)
+	return subscription
+}
+
+/*
+SubscribeOnce adds an event handler to the socket that is removed after the
+first event is received and returns a Subscription that can be used to remove
+it before then.
+*/
+func SubscribeOnce(socket Socketer, handler EventHandler) *Subscription {
+	subscription := &Subscription{
+		handler: handler,
+		once:    true,
+		socket:  socket,
+	}
+	socket.AddEventHandler(subscription)
+	return subscription
+}
+
+/*
+Subscription provides an EventHandler interface that wraps an event handler
+registered with a socket so it can be removed later.
+*/
+type Subscription struct {
+	// fired is set when a one-time subscription has received its event.
+	fired int32
+
+	// handler is the wrapped event handler.
+	handler EventHandler
+
+	// once marks the subscription for removal after the first event.
+	once bool
+
+	// socket is the Socketer the handler is registered with.
+	socket Socketer
+}
+
+/*
+Handle executes the wrapped event handler. One-time subscriptions are removed
+before the handler is executed and ignore any subsequent events.
+
+Handle is an EventHandler implementation.
+*/
+func (subscription *Subscription) Handle(response *Response) {
+	if subscription.once {
+		if !atomic.CompareAndSwapInt32(&subscription.fired, 0, 1) {
+			return
+		}
+		subscription.Unsubscribe()
+	}
+	subscription.handler.Handle(response)
+}
+
+/*
+Handler returns the wrapped event handler.
+*/
+func (subscription *Subscription) Handler() EventHandler {
+	return subscription.handler
+}
+
+/*
+Name returns the name of the event the handler is assigned to.
+
+Name is an EventHandler implementation.
+*/
+func (subscription *Subscription) Name() string {
+	return subscription.handler.Name()
+}
+
+/*
+Unsubscribe removes the event handler from the socket.
+*/
+func (subscription *Subscription) Unsubscribe() error {
+	return subscription.socket.RemoveEventHandler(subscription)
+}
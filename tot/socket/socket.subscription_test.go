@@ -0,0 +1,76 @@
+package socket
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscribe")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *Response, 10)
+	subscription := Subscribe(mockSocket, NewEventHandler(
+		"Test.event",
+		func(response *Response) { resultChan <- response },
+	))
+	if "Test.event" != subscription.Name() {
+		t.Errorf("Expected 'Test.event', received '%s'", subscription.Name())
+	}
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Test.event",
+	})
+	select {
+	case <-resultChan:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+
+	if err := subscription.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	handlers, _ := mockSocket.handlers.Get("Test.event")
+	if 0 != len(handlers) {
+		t.Errorf("Expected no handlers, found %d", len(handlers))
+	}
+}
+
+func TestSubscribeOnce(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscribeOnce")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *Response, 10)
+	SubscribeOnce(mockSocket, NewEventHandler(
+		"Test.event",
+		func(response *Response) { resultChan <- response },
+	))
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Test.event",
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Test.event",
+	})
+	select {
+	case <-resultChan:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event was not received")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if 0 != len(resultChan) {
+		t.Errorf("Expected a single event, received %d more", len(resultChan))
+	}
+	handlers, _ := mockSocket.handlers.Get("Test.event")
+	if 0 != len(handlers) {
+		t.Errorf("Expected no handlers, found %d", len(handlers))
+	}
+}
@@ -0,0 +1,96 @@
+package socket
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+/*
+abandonedTTL is how long the ID of an abandoned command is remembered to
+discard its late response.
+*/
+const abandonedTTL = 10 * time.Minute
+
+/*
+commandTimeout returns the default timeout for a command method, see
+WithCommandTimeout. A method timeout takes precedence over a domain timeout,
+which takes precedence over the global timeout. It returns 0 if no timeout
+applies.
+*/
+func (socket *Socket) commandTimeout(method string) time.Duration {
+	if timeout, ok := socket.commandTimeouts[method]; ok {
+		return timeout
+	}
+	if i := strings.Index(method, "."); i > 0 {
+		if timeout, ok := socket.commandTimeouts[method[:i]]; ok {
+			return timeout
+		}
+	}
+	return socket.defaultTimeout
+}
+
+/*
+setCommandTimeout sets the default timeout of a domain or method.
+*/
+func (socket *Socket) setCommandTimeout(name string, timeout time.Duration) {
+	if nil == socket.commandTimeouts {
+		socket.commandTimeouts = make(map[string]time.Duration)
+	}
+	socket.commandTimeouts[name] = timeout
+}
+
+/*
+withCommandTimeout returns a context for the command with the default timeout
+of its method applied. A deadline set by the caller is left as is.
+*/
+func (socket *Socket) withCommandTimeout(
+	ctx context.Context,
+	command Commander,
+) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	timeout := socket.commandTimeout(command.Method())
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+/*
+abandon removes a command that is no longer waited for from the stack and
+remembers its ID, so a late response is discarded quietly. Commands abandoned
+longer than abandonedTTL ago are forgotten.
+*/
+func (socket *Socket) abandon(command Commander) {
+	socket.commands.Delete(command.ID())
+
+	now := time.Now()
+	socket.abandonedMux.Lock()
+	defer socket.abandonedMux.Unlock()
+	for len(socket.abandonedOrder) > 0 {
+		id := socket.abandonedOrder[0]
+		if abandoned, ok := socket.abandoned[id]; ok && now.Sub(abandoned) <= abandonedTTL {
+			break
+		}
+		delete(socket.abandoned, id)
+		socket.abandonedOrder = socket.abandonedOrder[1:]
+	}
+	socket.abandoned[command.ID()] = now
+	socket.abandonedOrder = append(socket.abandonedOrder, command.ID())
+}
+
+/*
+isAbandoned returns whether a response is for an abandoned command and forgets
+the command.
+*/
+func (socket *Socket) isAbandoned(commandID int) bool {
+	socket.abandonedMux.Lock()
+	defer socket.abandonedMux.Unlock()
+	if _, ok := socket.abandoned[commandID]; ok {
+		delete(socket.abandoned, commandID)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package socket
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCommandTimeout(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestCommandTimeout")
+	mockSocket := NewMock(
+		socketURL,
+		WithCommandTimeout(time.Hour),
+		WithDomainTimeout("Page", 20*time.Millisecond),
+		WithMethodTimeout("Page.printToPDF", time.Minute),
+	)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	for method, expected := range map[string]time.Duration{
+		"DOM.querySelector": time.Hour,
+		"Page.navigate":     20 * time.Millisecond,
+		"Page.printToPDF":   time.Minute,
+	} {
+		if timeout := mockSocket.commandTimeout(method); expected != timeout {
+			t.Errorf("Expected %s for %s, received %s", expected, method, timeout)
+		}
+	}
+
+	command := NewCommand(mockSocket, "Page.navigate", nil)
+	response := <-mockSocket.SendCommandContext(context.Background(), command)
+	var timeoutErr *TimeoutError
+	if !errors.As(response.Err, &timeoutErr) {
+		t.Fatalf("Expected TimeoutError, received '%v'", response.Err)
+	}
+	if 0 != len(mockSocket.commands.List()) {
+		t.Errorf("Expected the command to be removed, received %d commands", len(mockSocket.commands.List()))
+	}
+
+	// The late response is discarded and the command forgotten.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Result: []byte(`{}`),
+	})
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		mockSocket.abandonedMux.Lock()
+		abandoned := len(mockSocket.abandoned)
+		mockSocket.abandonedMux.Unlock()
+		if 0 == abandoned {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if mockSocket.isAbandoned(command.ID()) {
+		t.Errorf("Expected the late response to be discarded")
+	}
+
+	// A deadline set by the caller takes precedence.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	<-mockSocket.SendCommandContext(ctx, NewCommand(mockSocket, "Page.reload", nil))
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("Expected the caller deadline to be used, command ended after %s", elapsed)
+	}
+}
+
+func TestSendCommandTimeout(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandTimeout")
+	mockSocket := NewMock(socketURL, WithDomainTimeout("Page", 20*time.Millisecond))
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	select {
+	case response := <-mockSocket.SendCommand(NewCommand(mockSocket, "Page.navigate", nil)):
+		var timeoutErr *TimeoutError
+		if !errors.As(response.Err, &timeoutErr) {
+			t.Errorf("Expected TimeoutError, received '%v'", response.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Command did not time out")
+	}
+}
+
+func TestAbandonExpired(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestAbandonExpired")
+	mockSocket := NewMock(socketURL)
+
+	expired := NewCommand(mockSocket, "Page.navigate", nil)
+	answered := NewCommand(mockSocket, "Page.navigate", nil)
+	mockSocket.abandon(expired)
+	mockSocket.abandon(answered)
+	mockSocket.abandonedMux.Lock()
+	mockSocket.abandoned[expired.ID()] = time.Now().Add(-2 * abandonedTTL)
+	mockSocket.abandonedMux.Unlock()
+	if !mockSocket.isAbandoned(answered.ID()) {
+		t.Errorf("Expected command #%d to be abandoned", answered.ID())
+	}
+
+	current := NewCommand(mockSocket, "Page.navigate", nil)
+	mockSocket.abandon(current)
+	mockSocket.abandonedMux.Lock()
+	defer mockSocket.abandonedMux.Unlock()
+	if 1 != len(mockSocket.abandoned) || 1 != len(mockSocket.abandonedOrder) {
+		t.Errorf("Expected only command #%d to be remembered, received %v", current.ID(), mockSocket.abandonedOrder)
+	}
+}
@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 
 	errs "github.com/bdlm/errors"
 	"github.com/gorilla/websocket"
-	log "github.com/sirupsen/logrus"
 )
 
 /*
@@ -25,16 +25,14 @@ func NewWebsocket(socketURL *url.URL) (WebSocketer, error) {
 	}
 	header := http.Header{"Origin": []string{}}
 
-	websocket, response, err := dialer.Dial(socketURL.String(), header)
+	websocket, _, err := dialer.Dial(socketURL.String(), header)
 	if err != nil {
 		return nil, errs.Wrap(err, 0, fmt.Sprintf(
 			"%s websocket connection failed",
 			socketURL.String(),
 		))
 	}
-	log.Infof("Websocket connection to %s established: %s", socketURL.String(), response.Status)
-
-	return &ChromeWebSocket{conn: websocket}, nil
+	return &ChromeWebSocket{conn: websocket, writeMux: &sync.Mutex{}}, nil
 }
 
 /*
@@ -46,6 +44,10 @@ ChromeWebSocket represents a WebSocketer interface
 type ChromeWebSocket struct {
 	conn          *websocket.Conn
 	mockResponses []*Response
+
+	// writeMux serializes writes, flattened sessions write to the connection
+	// concurrently.
+	writeMux *sync.Mutex
 }
 
 /*
@@ -88,8 +90,10 @@ func (socket *ChromeWebSocket) WriteJSON(v interface{}) error {
 		return errs.New(0, "not connected")
 	}
 	tmp, _ := json.Marshal(v)
-	if len(tmp) > 1*1024*1024 {
-		return fmt.Errorf("payload too large. chrome supports a maximum payload size of 1MB. See https://github.com/gorilla/websocket/issues/245")
+	if len(tmp) > MaxPayloadSize {
+		return ErrPayloadTooLarge
 	}
+	socket.writeMux.Lock()
+	defer socket.writeMux.Unlock()
 	return socket.conn.WriteJSON(v)
 }
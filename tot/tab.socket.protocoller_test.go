@@ -2,12 +2,19 @@ package chrome
 
 import (
 	"testing"
+
+	"github.com/mkenney/go-chrome/tot/chrometest"
 )
 
 func TestProtocoller1(t *testing.T) {
 	var err error
-	browser := NewMock(
-		&Flags{},
+	server := chrometest.NewServer()
+	defer server.Close()
+	browser := New(
+		&Flags{
+			"addr": server.Address(),
+			"port": server.Port(),
+		},
 		"", //"path/to/chrome",
 		"", //"path/to/stderr",
 		"", //"path/to/stdout",
@@ -72,8 +79,13 @@ func TestProtocoller1(t *testing.T) {
 }
 
 func TestProtocoller2(t *testing.T) {
-	browser := NewMock(
-		&Flags{},
+	server := chrometest.NewServer()
+	defer server.Close()
+	browser := New(
+		&Flags{
+			"addr": server.Address(),
+			"port": server.Port(),
+		},
 		"", //"path/to/chrome",
 		"", //"path/to/stderr",
 		"", //"path/to/stdout",
@@ -108,6 +120,10 @@ func TestProtocoller2(t *testing.T) {
 		t.Errorf("Expected struct, received nil")
 	}
 
+	if testVal := tab.Inspector(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
 	if testVal := tab.IO(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
@@ -138,8 +154,13 @@ func TestProtocoller2(t *testing.T) {
 }
 
 func TestProtocoller3(t *testing.T) {
-	browser := NewMock(
-		&Flags{},
+	server := chrometest.NewServer()
+	defer server.Close()
+	browser := New(
+		&Flags{
+			"addr": server.Address(),
+			"port": server.Port(),
+		},
 		"", //"path/to/chrome",
 		"", //"path/to/stderr",
 		"", //"path/to/stdout",
@@ -1,6 +1,8 @@
 package chrome
 
 import (
+	"context"
+
 	"github.com/mkenney/go-chrome/tot/socket"
 )
 
@@ -11,6 +13,32 @@ func (tab *Tab) AddEventHandler(handler socket.EventHandler) {
 	tab.Socket().AddEventHandler(handler)
 }
 
+/*
+Batch implements Socketer
+*/
+func (tab *Tab) Batch() *socket.Batch {
+	return tab.Socket().Batch()
+}
+
+/*
+Call implements Socketer
+*/
+func (tab *Tab) Call(
+	ctx context.Context,
+	method string,
+	params interface{},
+	result interface{},
+) error {
+	return tab.Socket().Call(ctx, method, params, result)
+}
+
+/*
+Done implements Socketer
+*/
+func (tab *Tab) Done() <-chan struct{} {
+	return tab.Socket().Done()
+}
+
 /*
 RemoveEventHandler implements Socketer
 */
@@ -24,3 +52,21 @@ SendCommand implements Socketer
 func (tab *Tab) SendCommand(command socket.Commander) chan *socket.Response {
 	return tab.Socket().SendCommand(command)
 }
+
+/*
+SendCommandContext implements Socketer
+*/
+func (tab *Tab) SendCommandContext(ctx context.Context, command socket.Commander) chan *socket.Response {
+	return tab.Socket().SendCommandContext(ctx, command)
+}
+
+/*
+WaitForEvent implements Socketer
+*/
+func (tab *Tab) WaitForEvent(
+	ctx context.Context,
+	method string,
+	predicate func(response *socket.Response) bool,
+) chan *socket.Response {
+	return tab.Socket().WaitForEvent(ctx, method, predicate)
+}
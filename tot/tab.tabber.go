@@ -3,10 +3,11 @@ package chrome
 import (
 	"fmt"
 	"net/url"
+	"sync"
 
 	errs "github.com/bdlm/errors"
 	"github.com/mkenney/go-chrome/tot/socket"
-	log "github.com/sirupsen/logrus"
+	"github.com/mkenney/go-chrome/tot/target"
 )
 
 /*
@@ -22,41 +23,125 @@ func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
 	if nil != err {
 		return nil, errs.Wrap(err, 0, "invalid URL")
 	}
-
-	tab := &Tab{
-		chrome: chrome,
-		data:   &TabData{},
-		url:    targetURL,
+	if chrome.Flags().Has("remote-debugging-pipe") {
+		return chrome.newSessionTab(targetURL)
 	}
 
-	_, err = tab.Chromium().Query(
+	data := &TabData{}
+	_, err = chrome.Query(
 		fmt.Sprintf("/json/new?%s", url.QueryEscape(uri)),
 		url.Values{},
-		tab.data,
+		data,
 	)
 	if nil != err {
 		return nil, errs.Wrap(err, 0, fmt.Sprintf("/new?%s query failed", url.QueryEscape(uri)))
 	}
 
-	websocketURL, err := url.Parse(tab.Data().WebSocketDebuggerURL)
+	return chrome.newTab(targetURL, data)
+}
+
+/*
+newTab adds a tab for a target listed by the developer tools endpoints and
+connects a socket to its websocket URL.
+*/
+func (chrome *Chrome) newTab(targetURL *url.URL, data *TabData) (*Tab, error) {
+	websocketURL, err := url.Parse(data.WebSocketDebuggerURL)
+	if nil != err {
+		return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid websocket URL '%s'", data.WebSocketDebuggerURL))
+	}
+
+	if tab := chrome.tab(data.ID); nil != tab {
+		return tab, nil
+	}
+	socket := socket.New(websocketURL, chrome.tabOptions(data.ID)...)
+	tab := &Tab{
+		chrome:   chrome,
+		data:     data,
+		protocol: socket,
+		socket:   socket,
+		url:      targetURL,
+	}
+	if added := chrome.addTab(tab); tab != added {
+		go socket.Stop()
+		return added, nil
+	}
+
+	return tab, nil
+}
+
+/*
+newSessionTab creates a new target with the browser socket and attaches to it
+with a flattened session, which is used as the tab socket.
+*/
+func (chrome *Chrome) newSessionTab(targetURL *url.URL) (*Tab, error) {
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, 0, "browser socket not available")
+	}
+
+	result := <-browser.Target().CreateTarget(&target.CreateTargetParams{
+		URL: targetURL.String(),
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, 0, fmt.Sprintf("could not create target for '%s'", targetURL))
+	}
+
+	return chrome.newSessionTabFor(targetURL, &TabData{
+		ID:   string(result.ID),
+		Type: "page",
+		URL:  targetURL.String(),
+	})
+}
+
+/*
+newSessionTabFor attaches to an existing target with a flattened session over
+the browser socket, which is used as the tab socket.
+*/
+func (chrome *Chrome) newSessionTabFor(targetURL *url.URL, data *TabData) (*Tab, error) {
+	if tab := chrome.tab(data.ID); nil != tab {
+		return tab, nil
+	}
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, 0, "browser socket not available")
+	}
+	session, err := browser.AttachToTarget(target.ID(data.ID), chrome.tabOptions(data.ID)...)
 	if nil != err {
-		return nil, errs.Wrap(err, 0, fmt.Sprintf("invalid websocket URL '%s'", tab.Data().WebSocketDebuggerURL))
+		return nil, err
 	}
 
-	socket := socket.New(websocketURL)
-	tab.socket = socket
-	tab.protocol = socket
-	chrome.tabs = append(chrome.tabs, tab)
+	tab := &Tab{
+		chrome:   chrome,
+		data:     data,
+		protocol: session,
+		socket:   session,
+		url:      targetURL,
+	}
+	if added := chrome.addTab(tab); tab != added {
+		go session.Disconnect()
+		return added, nil
+	}
 
 	return tab, nil
 }
 
+/*
+tabOptions returns the options used to configure the socket of a tab.
+*/
+func (chrome *Chrome) tabOptions(targetID string) []socket.Option {
+	return append(
+		[]socket.Option{socket.WithLogger(chrome.Logger().WithFields(socket.Fields{"target": targetID}))},
+		chrome.socketOptions...,
+	)
+}
+
 /*
 Tab is a struct representing an individual Chrome tab
 */
 type Tab struct {
 	chrome   Chromium
 	data     *TabData
+	dataMux  sync.Mutex
 	protocol socket.Protocoller
 	socket   socket.Socketer
 	url      *url.URL
@@ -75,24 +160,71 @@ Close implements Tabber.
 func (tab *Tab) Close() (interface{}, error) {
 	var err error
 	var result interface{}
+	if tab.Chromium().Flags().Has("remote-debugging-pipe") {
+		return tab.closeTarget()
+	}
 	tab.Socket().Stop()
 	_, err = tab.Chromium().Query(fmt.Sprintf("/json/close/%s", tab.Data().ID), url.Values{}, &result)
-	log.Debugf("Close result: %v - %v", result, err)
+	logger := tab.Chromium().Logger().WithFields(socket.Fields{"target": tab.Data().ID})
+	logger.Debugf("Close result: %v - %v", result, err)
 	if nil != err {
-		log.Warnf("%s: %s", result, err)
+		logger.Warnf("%s: %s", result, err)
 		return nil, errs.Wrap(err, 0, fmt.Sprintf("close/%s query failed", tab.Data().ID))
 	}
 	tab.Chromium().RemoveTab(tab)
 	return result, nil
 }
 
+/*
+closeTarget closes the tab target with the browser socket. The browser
+detaches the tab session when the target is closed.
+*/
+func (tab *Tab) closeTarget() (interface{}, error) {
+	browser, err := tab.Chromium().Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, 0, "browser socket not available")
+	}
+	result := <-browser.Target().CloseTarget(&target.CloseTargetParams{
+		ID: target.ID(tab.Data().ID),
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, 0, fmt.Sprintf("could not close target %s", tab.Data().ID))
+	}
+	tab.Chromium().RemoveTab(tab)
+	return result, nil
+}
+
 /*
 Data implements Tabber.
+
+The returned data isn't modified, SyncTabs replaces it when the title or URL
+of the target changes.
 */
 func (tab *Tab) Data() *TabData {
+	tab.dataMux.Lock()
+	defer tab.dataMux.Unlock()
 	return tab.data
 }
 
+/*
+updateData replaces the title and URL in the tab data.
+*/
+func (tab *Tab) updateData(title, targetURL string) {
+	tab.dataMux.Lock()
+	defer tab.dataMux.Unlock()
+	data := *tab.data
+	data.Title = title
+	data.URL = targetURL
+	tab.data = &data
+}
+
+/*
+Err implements Tabber.
+*/
+func (tab *Tab) Err() error {
+	return tab.Socket().Err()
+}
+
 /*
 Protocol implements Tabber.
 */
@@ -107,6 +239,13 @@ func (tab *Tab) Socket() socket.Socketer {
 	return tab.socket
 }
 
+/*
+Type implements Tabber.
+*/
+func (tab *Tab) Type() string {
+	return tab.Data().Type
+}
+
 /*
 URL implements Tabber.
 */
@@ -28,6 +28,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToTa
 type AttachToTargetParams struct {
 	// Target ID.
 	ID ID `json:"targetId"`
+
+	// Optional. Enables "flat" access to the session via specifying sessionId
+	// attribute in the commands. EXPERIMENTAL.
+	Flatten bool `json:"flatten,omitempty"`
 }
 
 /*
@@ -200,6 +204,9 @@ GetTargetsResult represents the result of calls to Target.getTargets.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargets
 */
 type GetTargetsResult struct {
+	// The list of targets.
+	Infos []*Info `json:"targetInfos"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }